@@ -5,12 +5,16 @@ go 1.15
 require (
 	github.com/Netflix/go-expect v0.0.0-20201125194554-85d881c3777e
 	github.com/blang/semver v3.5.1+incompatible
+	github.com/containerd/containerd v1.4.3
+	github.com/deislabs/oras v0.8.1
 	github.com/devfile/api/v2 v2.1.0
 	github.com/devfile/library v1.0.0
 	github.com/devfile/registry-support/index/generator v0.0.0-20210407161420-cd279527f873
 	github.com/devfile/registry-support/registry-library v0.0.0-20210407161420-cd279527f873
 	github.com/docker/docker v17.12.0-ce-rc1.0.20200916142827-bd33bbf0497b+incompatible
 	github.com/docker/go-connections v0.4.1-0.20200120150455-7dc0a2d6ddce
+	github.com/docker/go-units v0.4.0
+	github.com/evanphx/json-patch v4.9.0+incompatible
 	github.com/fatih/color v1.10.0
 	github.com/frapposelli/wwhrd v0.4.0
 	github.com/fsnotify/fsnotify v1.4.9
@@ -56,7 +60,9 @@ require (
 	gopkg.in/AlecAivazis/survey.v1 v1.8.8
 	gopkg.in/segmentio/analytics-go.v3 v3.1.0
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.0-20200615113413-eeeca48fe776
 	k8s.io/api v0.20.1
+	k8s.io/apiextensions-apiserver v0.20.0
 	k8s.io/apimachinery v0.20.1
 	k8s.io/cli-runtime v0.20.1
 	k8s.io/client-go v0.20.1
@@ -64,7 +70,6 @@ require (
 	k8s.io/klog/v2 v2.4.0
 	k8s.io/kubectl v0.20.1
 	sigs.k8s.io/yaml v1.2.0
-
 )
 
 replace (
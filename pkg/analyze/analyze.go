@@ -0,0 +1,280 @@
+// Package analyze detects the languages, frameworks and build tools of a project from the files of its directory,
+// to suggest the devfile stacks matching the project
+package analyze
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+const (
+	// maxAnalyzedFiles is the number of files of the project counted for the language ratios
+	maxAnalyzedFiles = 10000
+	// markerScore is the weight of a language detected by a marker file, over the ratio of the files of the language
+	markerScore = 1.0
+)
+
+// errStopWalk stops the walk of the directory once maxAnalyzedFiles files have been counted
+var errStopWalk = errors.New("too many files")
+
+// skippedDirs are the directories of dependencies, build outputs and tools, which don't reflect the project sources
+var skippedDirs = map[string]bool{
+	".git": true, ".odo": true, ".idea": true, ".vscode": true, "node_modules": true, "vendor": true, "target": true,
+	"build": true, "dist": true, "bin": true, "obj": true, "venv": true, ".venv": true, "__pycache__": true, ".gradle": true,
+}
+
+// languageExtensions maps the extensions of the source files to their language
+var languageExtensions = map[string]string{
+	".java": "java", ".kt": "java", ".scala": "java", ".groovy": "java",
+	".js": "javascript", ".jsx": "javascript", ".mjs": "javascript", ".vue": "javascript",
+	".ts": "typescript", ".tsx": "typescript",
+	".py":    "python",
+	".go":    "go",
+	".rb":    "ruby",
+	".php":   "php",
+	".cs":    "dotnet",
+	".rs":    "rust",
+	".c":     "c",
+	".cpp":   "c++",
+	".swift": "swift",
+}
+
+// Language is a language detected in a project
+type Language struct {
+	Name string `json:"name"`
+	// Ratio is the ratio of the source files of the project written in the language
+	Ratio float64 `json:"ratio"`
+	// Markers are the files which indicate the language, like a build file
+	Markers []string `json:"markers,omitempty"`
+}
+
+// weight returns the weight of the language in the project, the languages with markers first
+func (l Language) weight() float64 {
+	if len(l.Markers) > 0 {
+		return markerScore + l.Ratio
+	}
+	return l.Ratio
+}
+
+// Analysis is the result of the analysis of a project
+type Analysis struct {
+	// Languages are sorted from the main language of the project
+	Languages  []Language `json:"languages"`
+	Frameworks []string   `json:"frameworks"`
+	Tools      []string   `json:"tools"`
+}
+
+// MainLanguage returns the main language of the project, or an empty string if no language has been detected
+func (a Analysis) MainLanguage() string {
+	if len(a.Languages) == 0 {
+		return ""
+	}
+	return a.Languages[0].Name
+}
+
+// marker is a file indicating the language, the build tool and the frameworks of a project
+type marker struct {
+	file     string
+	language string
+	tool     string
+	// frameworks maps the frameworks to the strings indicating them in the content of the file
+	frameworks map[string][]string
+}
+
+var javaFrameworks = map[string][]string{
+	"springboot":  {"spring-boot", "org.springframework.boot"},
+	"quarkus":     {"io.quarkus"},
+	"vertx":       {"io.vertx"},
+	"micronaut":   {"io.micronaut"},
+	"openliberty": {"liberty-maven-plugin", "io.openliberty"},
+	"wildfly":     {"org.wildfly"},
+}
+
+var pythonFrameworks = map[string][]string{
+	"django": {"django"},
+	"flask":  {"flask"},
+}
+
+var markers = []marker{
+	{file: "pom.xml", language: "java", tool: "maven", frameworks: javaFrameworks},
+	{file: "build.gradle", language: "java", tool: "gradle", frameworks: javaFrameworks},
+	{file: "build.gradle.kts", language: "java", tool: "gradle", frameworks: javaFrameworks},
+	{file: "go.mod", language: "go", tool: "go", frameworks: map[string][]string{
+		"gin":   {"github.com/gin-gonic/gin"},
+		"echo":  {"github.com/labstack/echo"},
+		"fiber": {"github.com/gofiber/fiber"},
+	}},
+	{file: "requirements.txt", language: "python", tool: "pip", frameworks: pythonFrameworks},
+	{file: "Pipfile", language: "python", tool: "pipenv", frameworks: pythonFrameworks},
+	{file: "pyproject.toml", language: "python", tool: "pip", frameworks: pythonFrameworks},
+	{file: "setup.py", language: "python", tool: "pip", frameworks: pythonFrameworks},
+	{file: "manage.py", language: "python", frameworks: map[string][]string{"django": {"django"}}},
+	{file: "Gemfile", language: "ruby", tool: "bundler", frameworks: map[string][]string{"rails": {"rails"}}},
+	{file: "composer.json", language: "php", tool: "composer", frameworks: map[string][]string{"laravel": {"laravel/framework"}}},
+}
+
+// packageJSONFrameworks maps the frameworks to the npm packages indicating them
+var packageJSONFrameworks = map[string][]string{
+	"express": {"express"},
+	"nestjs":  {"@nestjs/core"},
+	"nextjs":  {"next"},
+	"react":   {"react"},
+	"angular": {"@angular/core"},
+	"vue":     {"vue"},
+}
+
+// packageJSON is the part of a package.json file used to detect the frameworks of a Node.js project
+type packageJSON struct {
+	Dependencies    map[string]string `json:"dependencies"`
+	DevDependencies map[string]string `json:"devDependencies"`
+}
+
+// Analyze analyzes the project in the directory, from its marker files and the extensions of its source files
+func Analyze(dir string) (Analysis, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return Analysis{}, errors.Wrapf(err, "unable to analyze the directory %s", dir)
+	}
+	if !info.IsDir() {
+		return Analysis{}, errors.Errorf("%s is not a directory", dir)
+	}
+
+	languages := map[string]*Language{}
+	frameworks := map[string]bool{}
+	tools := map[string]bool{}
+	getLanguage := func(name string) *Language {
+		if languages[name] == nil {
+			languages[name] = &Language{Name: name}
+		}
+		return languages[name]
+	}
+
+	for _, m := range markers {
+		content, err := ioutil.ReadFile(filepath.Join(dir, m.file))
+		if err != nil {
+			continue
+		}
+		language := getLanguage(m.language)
+		language.Markers = append(language.Markers, m.file)
+		if m.tool != "" {
+			tools[m.tool] = true
+		}
+		for framework, indicators := range m.frameworks {
+			if containsAny(strings.ToLower(string(content)), indicators) {
+				frameworks[framework] = true
+			}
+		}
+	}
+
+	if content, err := ioutil.ReadFile(filepath.Join(dir, "package.json")); err == nil {
+		name := "javascript"
+		if _, err := os.Stat(filepath.Join(dir, "tsconfig.json")); err == nil {
+			name = "typescript"
+		}
+		language := getLanguage(name)
+		language.Markers = append(language.Markers, "package.json")
+		tools["npm"] = true
+		if _, err := os.Stat(filepath.Join(dir, "yarn.lock")); err == nil {
+			tools["yarn"] = true
+		}
+		var pkg packageJSON
+		if err = json.Unmarshal(content, &pkg); err != nil {
+			klog.V(4).Infof("Unable to parse the package.json file: %v", err)
+		}
+		for framework, packages := range packageJSONFrameworks {
+			for _, p := range packages {
+				_, dep := pkg.Dependencies[p]
+				_, devDep := pkg.DevDependencies[p]
+				if dep || devDep {
+					frameworks[framework] = true
+				}
+			}
+		}
+	}
+
+	if matches, _ := filepath.Glob(filepath.Join(dir, "*.csproj")); len(matches) > 0 {
+		language := getLanguage("dotnet")
+		language.Markers = append(language.Markers, filepath.Base(matches[0]))
+		tools["dotnet"] = true
+	}
+
+	counts, total, err := countSourceFiles(dir)
+	if err != nil {
+		return Analysis{}, err
+	}
+	for name, count := range counts {
+		getLanguage(name).Ratio = float64(count) / float64(total)
+	}
+
+	analysis := Analysis{
+		Languages:  []Language{},
+		Frameworks: sortedKeys(frameworks),
+		Tools:      sortedKeys(tools),
+	}
+	for _, language := range languages {
+		analysis.Languages = append(analysis.Languages, *language)
+	}
+	sort.SliceStable(analysis.Languages, func(i, j int) bool {
+		if analysis.Languages[i].weight() != analysis.Languages[j].weight() {
+			return analysis.Languages[i].weight() > analysis.Languages[j].weight()
+		}
+		return analysis.Languages[i].Name < analysis.Languages[j].Name
+	})
+	return analysis, nil
+}
+
+// countSourceFiles counts the source files of the directory per language, and returns the total number of source files
+func countSourceFiles(dir string) (map[string]int, int, error) {
+	counts := map[string]int{}
+	total := 0
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			if path != dir && skippedDirs[info.Name()] {
+				return filepath.SkipDir
+			}
+			return nil
+		}
+		if total >= maxAnalyzedFiles {
+			return errStopWalk
+		}
+		if language, ok := languageExtensions[strings.ToLower(filepath.Ext(path))]; ok {
+			counts[language]++
+			total++
+		}
+		return nil
+	})
+	if err != nil && err != errStopWalk {
+		return nil, 0, errors.Wrapf(err, "unable to analyze the directory %s", dir)
+	}
+	return counts, total, nil
+}
+
+// containsAny indicates if the content contains one of the strings
+func containsAny(content string, values []string) bool {
+	for _, value := range values {
+		if strings.Contains(content, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// sortedKeys returns the sorted keys of the set
+func sortedKeys(set map[string]bool) []string {
+	keys := []string{}
+	for key := range set {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
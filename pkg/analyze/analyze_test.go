@@ -0,0 +1,177 @@
+package analyze
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"github.com/openshift/odo/pkg/catalog"
+)
+
+// mockProject creates a project with the files in a temporary directory, removed at the end of the test
+func mockProject(t *testing.T, files map[string]string) string {
+	dir, err := ioutil.TempDir("", "odo-analyze")
+	if err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() {
+		os.RemoveAll(dir)
+	})
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err = os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestAnalyze(t *testing.T) {
+	tests := []struct {
+		name           string
+		files          map[string]string
+		wantLanguages  []string
+		wantFrameworks []string
+		wantTools      []string
+	}{
+		{
+			name: "Spring Boot project built with Maven",
+			files: map[string]string{
+				"pom.xml": "<project><parent><groupId>org.springframework.boot</groupId><artifactId>spring-boot-starter-parent</artifactId></parent></project>",
+				"src/main/java/App.java":           "class App {}",
+				"src/main/resources/static/app.js": "",
+			},
+			wantLanguages:  []string{"java", "javascript"},
+			wantFrameworks: []string{"springboot"},
+			wantTools:      []string{"maven"},
+		},
+		{
+			name: "Quarkus project built with Gradle",
+			files: map[string]string{
+				"build.gradle":           "implementation 'io.quarkus:quarkus-resteasy'",
+				"src/main/java/App.java": "class App {}",
+			},
+			wantLanguages:  []string{"java"},
+			wantFrameworks: []string{"quarkus"},
+			wantTools:      []string{"gradle"},
+		},
+		{
+			name: "Express project, dependencies ignored",
+			files: map[string]string{
+				"package.json":                  `{"dependencies": {"express": "^4.17.1"}, "devDependencies": {"mocha": "^8.0.0"}}`,
+				"yarn.lock":                     "",
+				"server.js":                     "",
+				"node_modules/express/index.js": "",
+				"node_modules/express/a.py":     "",
+			},
+			wantLanguages:  []string{"javascript"},
+			wantFrameworks: []string{"express"},
+			wantTools:      []string{"npm", "yarn"},
+		},
+		{
+			name: "Django project",
+			files: map[string]string{
+				"requirements.txt": "Django==3.1\npsycopg2",
+				"manage.py":        "",
+				"app/views.py":     "",
+			},
+			wantLanguages:  []string{"python"},
+			wantFrameworks: []string{"django"},
+			wantTools:      []string{"pip"},
+		},
+		{
+			name: "the language of the most source files comes first without marker",
+			files: map[string]string{
+				"main.go":   "",
+				"util.go":   "",
+				"script.py": "",
+			},
+			wantLanguages:  []string{"go", "python"},
+			wantFrameworks: []string{},
+			wantTools:      []string{},
+		},
+		{
+			name: "Go module",
+			files: map[string]string{
+				"go.mod":  "module example.com/app\n\nrequire github.com/gin-gonic/gin v1.6.3",
+				"main.go": "",
+			},
+			wantLanguages:  []string{"go"},
+			wantFrameworks: []string{"gin"},
+			wantTools:      []string{"go"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := mockProject(t, tt.files)
+			defer os.RemoveAll(dir)
+
+			analysis, err := Analyze(dir)
+			if err != nil {
+				t.Fatalf("Analyze() unexpected error: %v", err)
+			}
+			var languages []string
+			for _, language := range analysis.Languages {
+				languages = append(languages, language.Name)
+			}
+			if !reflect.DeepEqual(languages, tt.wantLanguages) {
+				t.Errorf("Analyze() languages = %v, want %v", languages, tt.wantLanguages)
+			}
+			if !reflect.DeepEqual(analysis.Frameworks, tt.wantFrameworks) {
+				t.Errorf("Analyze() frameworks = %v, want %v", analysis.Frameworks, tt.wantFrameworks)
+			}
+			if !reflect.DeepEqual(analysis.Tools, tt.wantTools) {
+				t.Errorf("Analyze() tools = %v, want %v", analysis.Tools, tt.wantTools)
+			}
+		})
+	}
+}
+
+func TestMatchStacks(t *testing.T) {
+	registry := catalog.Registry{Name: "DefaultDevfileRegistry"}
+	stacks := []catalog.DevfileComponentType{
+		{Name: "java-maven", Language: "java", ProjectType: "maven", Tags: []string{"Java", "Maven"}, Registry: registry},
+		{Name: "java-springboot", Language: "java", ProjectType: "spring", Tags: []string{"Java", "Spring"}, Registry: registry},
+		{Name: "java-quarkus", Language: "java", ProjectType: "quarkus", Tags: []string{"Java", "Quarkus"}, Registry: registry},
+		{Name: "nodejs", Language: "nodejs", ProjectType: "nodejs", Tags: []string{"NodeJS", "Express"}, Registry: registry},
+		{Name: "python-django", Language: "python", ProjectType: "django", Tags: []string{"Python", "Django"}, Registry: registry},
+	}
+
+	tests := []struct {
+		name     string
+		analysis Analysis
+		want     []string
+	}{
+		{
+			name:     "Spring Boot project built with Maven",
+			analysis: Analysis{Languages: []Language{{Name: "java"}}, Frameworks: []string{"springboot"}, Tools: []string{"maven"}},
+			want:     []string{"java-springboot", "java-maven", "java-quarkus"},
+		},
+		{
+			name:     "the stacks of the main language come first",
+			analysis: Analysis{Languages: []Language{{Name: "typescript"}, {Name: "python"}}, Frameworks: []string{}, Tools: []string{"npm"}},
+			want:     []string{"nodejs", "python-django"},
+		},
+		{
+			name:     "no matching language",
+			analysis: Analysis{Languages: []Language{{Name: "ruby"}}, Frameworks: []string{"django"}},
+			want:     []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, match := range MatchStacks(tt.analysis, stacks) {
+				got = append(got, match.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("MatchStacks() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
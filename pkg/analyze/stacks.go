@@ -0,0 +1,129 @@
+package analyze
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/openshift/odo/pkg/catalog"
+)
+
+// scores of the matches of a stack with the analysis of a project
+const (
+	mainLanguageScore = 40
+	languageScore     = 20
+	frameworkScore    = 40
+	toolScore         = 10
+)
+
+// languageAliases are the other names of the detected languages, in the languages and the tags of the stacks
+var languageAliases = map[string][]string{
+	"javascript": {"nodejs", "node", "node.js"},
+	"typescript": {"nodejs", "node", "node.js", "javascript"},
+	"go":         {"golang"},
+	"dotnet":     {"csharp", "c#", ".net", "net"},
+}
+
+// frameworkAliases are the other names of the detected frameworks, in the names, the project types and the tags of the stacks
+var frameworkAliases = map[string][]string{
+	"springboot":  {"spring"},
+	"openliberty": {"liberty"},
+	"nextjs":      {"next"},
+}
+
+// StackMatch is a devfile stack matching the analysis of a project
+type StackMatch struct {
+	Name     string `json:"name"`
+	Registry string `json:"registry"`
+	Score    int    `json:"score"`
+	// Reasons are the detected languages, frameworks and tools matching the stack
+	Reasons []string `json:"reasons"`
+}
+
+// MatchStacks ranks the devfile stacks matching the languages of the project, from the best match to the worst one.
+// The stacks matching equally keep the order of the list
+func MatchStacks(analysis Analysis, stacks []catalog.DevfileComponentType) []StackMatch {
+	matches := []StackMatch{}
+	for _, stack := range stacks {
+		match := StackMatch{Name: stack.Name, Registry: stack.Registry.Name, Reasons: []string{}}
+		for i, language := range analysis.Languages {
+			if !matchesLanguage(stack, language.Name) {
+				continue
+			}
+			score := languageScore
+			if i == 0 {
+				score = mainLanguageScore
+			}
+			if score > match.Score {
+				match.Score = score
+				match.Reasons = []string{fmt.Sprintf("language %s", language.Name)}
+			}
+		}
+		// the stacks of other languages don't match the project, whatever their frameworks and tools
+		if match.Score == 0 {
+			continue
+		}
+		for _, framework := range analysis.Frameworks {
+			if matchesName(stack, framework, frameworkAliases[framework]) {
+				match.Score += frameworkScore
+				match.Reasons = append(match.Reasons, fmt.Sprintf("framework %s", framework))
+			}
+		}
+		for _, tool := range analysis.Tools {
+			if matchesName(stack, tool, nil) {
+				match.Score += toolScore
+				match.Reasons = append(match.Reasons, fmt.Sprintf("tool %s", tool))
+			}
+		}
+		matches = append(matches, match)
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].Score > matches[j].Score
+	})
+	return matches
+}
+
+// matchesLanguage indicates if the language, or one of its aliases, is the language or a tag of the stack
+func matchesLanguage(stack catalog.DevfileComponentType, language string) bool {
+	names := append([]string{language}, languageAliases[language]...)
+	values := append([]string{stack.Language}, stack.Tags...)
+	for _, name := range names {
+		for _, value := range values {
+			if normalize(value) == normalize(name) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// matchesName indicates if the name, or one of its aliases, is the project type or a tag of the stack,
+// or a part of the name of the stack
+func matchesName(stack catalog.DevfileComponentType, name string, aliases []string) bool {
+	names := append([]string{name}, aliases...)
+	for _, n := range names {
+		if normalize(stack.ProjectType) == normalize(n) {
+			return true
+		}
+		for _, tag := range stack.Tags {
+			if normalize(tag) == normalize(n) {
+				return true
+			}
+		}
+		for _, part := range strings.FieldsFunc(strings.ToLower(stack.Name), isSeparator) {
+			if part == normalize(n) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// normalize returns the lower case value without the separators, so that Spring Boot matches springboot
+func normalize(value string) string {
+	return strings.Join(strings.FieldsFunc(strings.ToLower(value), isSeparator), "")
+}
+
+func isSeparator(r rune) bool {
+	return r == ' ' || r == '-' || r == '_'
+}
@@ -0,0 +1,232 @@
+package catalog
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net"
+	"net/url"
+	"os"
+	"path/filepath"
+	"sort"
+	"time"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/preference"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+const (
+	cacheEntrySuffix = ".json"
+	cacheDataSuffix  = ".data"
+)
+
+// registryCacheDir is the directory where odo caches the files of the devfile registries, in a directory per registry
+var registryCacheDir = getDefaultRegistryCacheDir()
+
+func getDefaultRegistryCacheDir() string {
+	dir, err := os.UserCacheDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, "odo", "registries")
+}
+
+// RegistryCacheEntry describes a file of a devfile registry cached by odo
+type RegistryCacheEntry struct {
+	Registry     string    `json:"registry"`
+	URL          string    `json:"url"`
+	ETag         string    `json:"etag,omitempty"`
+	LastModified string    `json:"lastModified,omitempty"`
+	Size         int64     `json:"size"`
+	FetchedAt    time.Time `json:"fetchedAt"`
+	ValidatedAt  time.Time `json:"validatedAt"`
+}
+
+// getRegistryCacheEntryPath returns the path of the cache entry of a file of the registry, without suffix
+func getRegistryCacheEntryPath(registryName, fileURL string) string {
+	return filepath.Join(registryCacheDir, url.PathEscape(registryName), fmt.Sprintf("%x", sha256.Sum256([]byte(fileURL))))
+}
+
+// readRegistryCache returns the cache entry of a file of the registry with the cached content, or nil if the file is not cached
+func readRegistryCache(registryName, fileURL string) (*RegistryCacheEntry, []byte) {
+	entryPath := getRegistryCacheEntryPath(registryName, fileURL)
+	entryData, err := ioutil.ReadFile(entryPath + cacheEntrySuffix)
+	if err != nil {
+		return nil, nil
+	}
+	var entry RegistryCacheEntry
+	if err = json.Unmarshal(entryData, &entry); err != nil || entry.URL != fileURL {
+		klog.V(4).Infof("Ignoring the invalid cache entry %s", entryPath)
+		return nil, nil
+	}
+	data, err := ioutil.ReadFile(entryPath + cacheDataSuffix)
+	if err != nil {
+		return nil, nil
+	}
+	return &entry, data
+}
+
+// writeRegistryCache writes the cache entry of a file of the registry, with its content if not nil
+func writeRegistryCache(entry RegistryCacheEntry, data []byte) error {
+	entryPath := getRegistryCacheEntryPath(entry.Registry, entry.URL)
+	err := os.MkdirAll(filepath.Dir(entryPath), 0750)
+	if err != nil {
+		return err
+	}
+	if data != nil {
+		err = ioutil.WriteFile(entryPath+cacheDataSuffix, data, 0600)
+		if err != nil {
+			return err
+		}
+	}
+	entryData, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(entryPath+cacheEntrySuffix, entryData, 0600)
+}
+
+// invalidateRegistryCache removes the cache entry of a file of the registry
+func invalidateRegistryCache(registryName, fileURL string) {
+	entryPath := getRegistryCacheEntryPath(registryName, fileURL)
+	for _, suffix := range []string{cacheEntrySuffix, cacheDataSuffix} {
+		if err := os.Remove(entryPath + suffix); err != nil && !os.IsNotExist(err) {
+			klog.V(4).Infof("Unable to remove the cache entry %s: %v", entryPath, err)
+		}
+	}
+}
+
+// fetchRegistryFile returns the content of a file of the registry from the cache of the registry, as long as the cache
+// is valid or odo is offline. Expired entries are revalidated with their ETag and Last-Modified validators, and served
+// with a warning when the registry can't be reached
+func fetchRegistryFile(registry Registry, request util.HTTPRequestParams) ([]byte, error) {
+	cfg, err := preference.New()
+	if err != nil {
+		return nil, err
+	}
+	entry, data := readRegistryCache(registry.Name, request.URL)
+
+	if cfg.GetRegistryOffline() {
+		if entry == nil {
+			return nil, errors.Errorf("%s is not cached and odo is offline, set the %s preference to false to connect to the registry %s", request.URL, preference.RegistryOfflineSetting, registry.Name)
+		}
+		klog.V(4).Infof("Using the cached %s, odo is offline", request.URL)
+		return data, nil
+	}
+
+	cacheTime := time.Duration(cfg.GetRegistryCacheTime()) * time.Minute
+	if entry != nil && time.Since(entry.ValidatedAt) < cacheTime {
+		klog.V(4).Infof("Using the cached %s, validated at %s", request.URL, entry.ValidatedAt)
+		return data, nil
+	}
+
+	var validators util.HTTPCacheValidators
+	if entry != nil {
+		validators = util.HTTPCacheValidators{ETag: entry.ETag, LastModified: entry.LastModified}
+	}
+	newData, newValidators, notModified, err := util.HTTPConditionalGetRequest(request, validators)
+	if err != nil {
+		if entry == nil || !isStaleIfError(err) {
+			return nil, err
+		}
+		log.Warningf("Unable to reach the registry %s, using the information cached %s ago: %v", registry.Name, time.Since(entry.FetchedAt).Round(time.Second), err)
+		return data, nil
+	}
+
+	now := time.Now()
+	if notModified {
+		entry.ValidatedAt = now
+		if err = writeRegistryCache(*entry, nil); err != nil {
+			klog.V(4).Infof("Unable to update the cache entry of %s: %v", request.URL, err)
+		}
+		return data, nil
+	}
+
+	newEntry := RegistryCacheEntry{
+		Registry:     registry.Name,
+		URL:          request.URL,
+		ETag:         newValidators.ETag,
+		LastModified: newValidators.LastModified,
+		Size:         int64(len(newData)),
+		FetchedAt:    now,
+		ValidatedAt:  now,
+	}
+	if err = writeRegistryCache(newEntry, newData); err != nil {
+		klog.V(4).Infof("Unable to cache %s: %v", request.URL, err)
+	}
+	return newData, nil
+}
+
+// isStaleIfError indicates if a cached file can be served in place of the error, which is the case
+// for the network errors and the server errors only
+func isStaleIfError(err error) bool {
+	switch cause := errors.Cause(err).(type) {
+	case util.HTTPStatusError:
+		return cause.StatusCode >= 500
+	case *url.Error, net.Error:
+		return true
+	}
+	return false
+}
+
+// checkRegistryOnline returns an error if odo is offline, as the registry needs to be reached for the operation
+func checkRegistryOnline(registry Registry, operation string) error {
+	cfg, err := preference.New()
+	if err != nil {
+		return err
+	}
+	if cfg.GetRegistryOffline() {
+		return errors.Errorf("unable to %s, odo is offline, set the %s preference to false to connect to the registry %s", operation, preference.RegistryOfflineSetting, registry.Name)
+	}
+	return nil
+}
+
+// ListRegistryCache lists the files cached for the registry, or for all the registries if registryName is empty
+func ListRegistryCache(registryName string) ([]RegistryCacheEntry, error) {
+	pattern := filepath.Join(registryCacheDir, "*", "*"+cacheEntrySuffix)
+	if registryName != "" {
+		pattern = filepath.Join(registryCacheDir, url.PathEscape(registryName), "*"+cacheEntrySuffix)
+	}
+	paths, err := filepath.Glob(pattern)
+	if err != nil {
+		return nil, err
+	}
+
+	var entries []RegistryCacheEntry
+	for _, path := range paths {
+		entryData, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the cache entry %s", path)
+		}
+		var entry RegistryCacheEntry
+		if err = json.Unmarshal(entryData, &entry); err != nil {
+			klog.V(4).Infof("Ignoring the invalid cache entry %s", path)
+			continue
+		}
+		entries = append(entries, entry)
+	}
+	sort.Slice(entries, func(i, j int) bool {
+		if entries[i].Registry != entries[j].Registry {
+			return entries[i].Registry < entries[j].Registry
+		}
+		return entries[i].URL < entries[j].URL
+	})
+	return entries, nil
+}
+
+// ClearRegistryCache removes the files cached for the registry, or for all the registries if registryName is empty
+func ClearRegistryCache(registryName string) error {
+	dir := registryCacheDir
+	if registryName != "" {
+		dir = filepath.Join(registryCacheDir, url.PathEscape(registryName))
+	}
+	err := os.RemoveAll(dir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to clear the cache directory %s", dir)
+	}
+	return nil
+}
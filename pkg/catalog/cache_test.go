@@ -0,0 +1,143 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/openshift/odo/pkg/preference"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+)
+
+// setRegistryCachePreference writes the RegistryCacheTime and RegistryOffline preferences into the preference file
+func setRegistryCachePreference(t *testing.T, preferenceFile string, offline bool) {
+	content := "kind: Preference\napiversion: odo.openshift.io/v1alpha1\nOdoSettings:\n  RegistryCacheTime: 0\n"
+	if offline {
+		content += "  RegistryOffline: true\n"
+	}
+	err := ioutil.WriteFile(preferenceFile, []byte(content), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+}
+
+func TestFetchRegistryFile(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-registry-cache")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defaultCacheDir := registryCacheDir
+	registryCacheDir = filepath.Join(dir, "registries")
+	defer func() { registryCacheDir = defaultCacheDir }()
+
+	preferenceFile := filepath.Join(dir, "preference.yaml")
+	setRegistryCachePreference(t, preferenceFile, false)
+	os.Setenv(preference.GlobalConfigEnvName, preferenceFile)
+	defer os.Unsetenv(preference.GlobalConfigEnvName)
+
+	index := `[{"name": "nodejs"}]`
+	status := http.StatusOK
+	requests, notModified := 0, 0
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		if status != http.StatusOK {
+			w.WriteHeader(status)
+			return
+		}
+		if r.Header.Get("If-None-Match") == `"v1"` {
+			notModified++
+			w.WriteHeader(http.StatusNotModified)
+			return
+		}
+		w.Header().Set("ETag", `"v1"`)
+		_, _ = w.Write([]byte(index))
+	}))
+	registry := Registry{Name: "CachedRegistry", URL: server.URL}
+	request := util.HTTPRequestParams{URL: server.URL + "/index"}
+
+	tests := []struct {
+		name            string
+		status          int
+		offline         bool
+		stopServer      bool
+		wantRequests    int
+		wantNotModified int
+		wantErr         bool
+	}{
+		{name: "the file is fetched and cached", status: http.StatusOK, wantRequests: 1},
+		{name: "the expired cache is revalidated with the ETag", status: http.StatusOK, wantRequests: 2, wantNotModified: 1},
+		{name: "the stale cache is served on a server error", status: http.StatusInternalServerError, wantRequests: 3, wantNotModified: 1},
+		{name: "the stale cache is not served on a client error", status: http.StatusNotFound, wantRequests: 4, wantNotModified: 1, wantErr: true},
+		{name: "the cache is served without request when offline", status: http.StatusOK, offline: true, wantRequests: 4, wantNotModified: 1},
+		{name: "the stale cache is served when the registry is unreachable", stopServer: true, wantRequests: 4, wantNotModified: 1},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			status = tt.status
+			setRegistryCachePreference(t, preferenceFile, tt.offline)
+			if tt.stopServer {
+				server.Close()
+			}
+
+			data, err := fetchRegistryFile(registry, request)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("fetchRegistryFile() unexpected error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if !tt.wantErr && string(data) != index {
+				t.Errorf("fetchRegistryFile() = %q, want %q", data, index)
+			}
+			if requests != tt.wantRequests || notModified != tt.wantNotModified {
+				t.Errorf("got %d requests and %d not modified responses, want %d and %d", requests, notModified, tt.wantRequests, tt.wantNotModified)
+			}
+		})
+	}
+
+	// a file which is not cached can't be fetched when offline
+	setRegistryCachePreference(t, preferenceFile, true)
+	if _, err = fetchRegistryFile(registry, util.HTTPRequestParams{URL: server.URL + "/devfiles/nodejs"}); err == nil {
+		t.Errorf("fetchRegistryFile() expected an error for a file not cached when offline")
+	}
+
+	entries, err := ListRegistryCache("")
+	if err != nil {
+		t.Fatalf("ListRegistryCache() unexpected error: %v", err)
+	}
+	if len(entries) != 1 || entries[0].Registry != registry.Name || entries[0].URL != request.URL || entries[0].ETag != `"v1"` || entries[0].Size != int64(len(index)) {
+		t.Errorf("ListRegistryCache() = %+v", entries)
+	}
+
+	if err = ClearRegistryCache(registry.Name); err != nil {
+		t.Fatalf("ClearRegistryCache() unexpected error: %v", err)
+	}
+	if entries, err = ListRegistryCache(registry.Name); err != nil || len(entries) != 0 {
+		t.Errorf("ListRegistryCache() = %+v, %v, want no entry after clearing the cache", entries, err)
+	}
+}
+
+func TestIsStaleIfError(t *testing.T) {
+	tests := []struct {
+		name string
+		err  error
+		want bool
+	}{
+		{name: "server error", err: util.HTTPStatusError{URL: "https://registry", StatusCode: http.StatusBadGateway}, want: true},
+		{name: "client error", err: util.HTTPStatusError{URL: "https://registry", StatusCode: http.StatusUnauthorized}, want: false},
+		{name: "network error", err: &url.Error{Op: "Get", URL: "https://registry", Err: errors.New("connection refused")}, want: true},
+		{name: "wrapped network error", err: errors.Wrap(&net.DNSError{Err: "no such host", Name: "registry"}, "unable to fetch"), want: true},
+		{name: "other error", err: errors.New("unable to read the response"), want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isStaleIfError(tt.err); got != tt.want {
+				t.Errorf("isStaleIfError() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
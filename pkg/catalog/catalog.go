@@ -16,8 +16,6 @@ import (
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/occlient"
 
-	indexSchema "github.com/devfile/registry-support/index/generator/schema"
-	registryLibrary "github.com/devfile/registry-support/registry-library/library"
 	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
 	"github.com/openshift/odo/pkg/util"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
@@ -124,9 +122,35 @@ const indexPath = "/devfiles/index.json"
 
 // getRegistryDevfiles retrieves the registry's index devfile entries
 func getRegistryDevfiles(registry Registry) (registryDevfiles []DevfileComponentType, err error) {
-	var devfileIndex []indexSchema.Schema
+	devfileIndex, err := getRegistryIndex(registry)
+	if err != nil {
+		return nil, err
+	}
 
-	if strings.Contains(registry.URL, "github") {
+	for _, devfileIndexEntry := range devfileIndex {
+		stackDevfile := DevfileComponentType{
+			Name:        devfileIndexEntry.Name,
+			DisplayName: devfileIndexEntry.DisplayName,
+			Description: devfileIndexEntry.Description,
+			Link:        devfileIndexEntry.Links["self"],
+			Registry:    registry,
+			Language:    devfileIndexEntry.Language,
+			Tags:        devfileIndexEntry.Tags,
+			ProjectType: devfileIndexEntry.ProjectType,
+			Provider:    devfileIndexEntry.Provider,
+		}
+		registryDevfiles = append(registryDevfiles, stackDevfile)
+	}
+
+	return registryDevfiles, nil
+}
+
+// getRegistryIndex retrieves the index of the stacks of the registry
+func getRegistryIndex(registry Registry) (devfileIndex []registryStack, err error) {
+	if registryUtil.IsLocalRegistry(registry.URL) {
+		// Local registry, laid out like the registry repository
+		return getLocalRegistryIndex(registry)
+	} else if strings.Contains(registry.URL, "github") {
 		// Github-based registry
 		URL, err := convertURL(registry.URL)
 		if err != nil {
@@ -145,23 +169,16 @@ func getRegistryDevfiles(registry Registry) (registryDevfiles []DevfileComponent
 			request.Token = token
 		}
 
-		cfg, err := preference.New()
-		if err != nil {
-			return nil, err
-		}
-
-		jsonBytes, err := util.HTTPGetRequest(request, cfg.GetRegistryCacheTime())
+		jsonBytes, err := fetchRegistryFile(registry, request)
 		if err != nil {
 			return nil, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
 		}
 
 		err = json.Unmarshal(jsonBytes, &devfileIndex)
 		if err != nil {
-			if err := util.CleanDefaultHTTPCacheDir(); err != nil {
-				log.Warning("Error while cleaning up cache dir.")
-			}
+			invalidateRegistryCache(registry.Name, indexLink)
 			// we try once again
-			jsonBytes, err := util.HTTPGetRequest(request, cfg.GetRegistryCacheTime())
+			jsonBytes, err := fetchRegistryFile(registry, request)
 			if err != nil {
 				return nil, errors.Wrapf(err, "unable to download the devfile index.json from %s", indexLink)
 			}
@@ -172,27 +189,20 @@ func getRegistryDevfiles(registry Registry) (registryDevfiles []DevfileComponent
 			}
 		}
 	} else {
-		// OCI-based registry
-		devfileIndex, err = registryLibrary.GetRegistryStacks(registry.URL)
+		// OCI-based registry, the index is read directly to get the versions of the stacks
+		indexLink := strings.TrimSuffix(registry.URL, "/") + "/index"
+		jsonBytes, err := fetchRegistryFile(registry, util.HTTPRequestParams{URL: indexLink})
 		if err != nil {
-			return nil, err
+			return nil, errors.Wrapf(err, "unable to download the devfile index from %s", indexLink)
 		}
-	}
-
-	for _, devfileIndexEntry := range devfileIndex {
-		stackDevfile := DevfileComponentType{
-			Name:        devfileIndexEntry.Name,
-			DisplayName: devfileIndexEntry.DisplayName,
-			Description: devfileIndexEntry.Description,
-			Link:        devfileIndexEntry.Links["self"],
-			Registry:    registry,
-			Language:    devfileIndexEntry.Language,
-			Tags:        devfileIndexEntry.Tags,
+		err = json.Unmarshal(jsonBytes, &devfileIndex)
+		if err != nil {
+			invalidateRegistryCache(registry.Name, indexLink)
+			return nil, errors.Wrapf(err, "unable to unmarshal the devfile index from %s", indexLink)
 		}
-		registryDevfiles = append(registryDevfiles, stackDevfile)
 	}
 
-	return registryDevfiles, nil
+	return devfileIndex, nil
 }
 
 // ListDevfileComponents lists all the available devfile components
@@ -269,6 +279,19 @@ func ListComponents(client *occlient.Client) (ComponentTypeList, error) {
 // SearchComponent searches for the component
 func SearchComponent(client *occlient.Client, name string) ([]string, error) {
 	var result []string
+	components, err := SearchComponentTypes(client, name)
+	if err != nil {
+		return nil, err
+	}
+	for _, component := range components {
+		result = append(result, component.ObjectMeta.Name)
+	}
+	return result, nil
+}
+
+// SearchComponentTypes searches for the component types whose name contains the search term
+func SearchComponentTypes(client *occlient.Client, name string) ([]ComponentType, error) {
+	var result []ComponentType
 	componentList, err := ListComponents(client)
 	if err != nil {
 		return nil, errors.Wrap(err, "unable to list components")
@@ -279,7 +302,7 @@ func SearchComponent(client *occlient.Client, name string) ([]string, error) {
 		// we only show components that contain the search term and that have at least non-hidden tag
 		// since a component with all hidden tags is not shown in the odo catalog list components either
 		if strings.Contains(component.ObjectMeta.Name, name) && len(component.Spec.NonHiddenTags) > 0 {
-			result = append(result, component.ObjectMeta.Name)
+			result = append(result, component)
 		}
 	}
 
@@ -0,0 +1,132 @@
+package catalog
+
+import (
+	"encoding/json"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+)
+
+const (
+	// localRegistryStacksDir is the directory of a local registry containing a directory per stack
+	localRegistryStacksDir = "stacks"
+	// localStarterProjectsDir is the directory of a stack of a local registry containing the archives of its starter projects
+	localStarterProjectsDir = "starter-projects"
+	// stackDevfile is the devfile of a stack
+	stackDevfile = "devfile.yaml"
+)
+
+// getLocalRegistryIndex reads the index of the stacks of a local registry
+func getLocalRegistryIndex(registry Registry) ([]registryStack, error) {
+	indexPath := filepath.Join(registryUtil.GetLocalRegistryPath(registry.URL), registryUtil.LocalRegistryIndex)
+	jsonBytes, err := ioutil.ReadFile(indexPath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the devfile index.json of the registry %s", registry.Name)
+	}
+
+	var devfileIndex []registryStack
+	err = json.Unmarshal(jsonBytes, &devfileIndex)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to unmarshal the devfile index.json from %s", indexPath)
+	}
+	return devfileIndex, nil
+}
+
+// WriteLocalRegistryIndex writes the index of the stacks of the local registry in the directory
+func WriteLocalRegistryIndex(dir string, devfileIndex []indexSchema.Schema) error {
+	jsonBytes, err := json.MarshalIndent(devfileIndex, "", "  ")
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(filepath.Join(dir, registryUtil.LocalRegistryIndex), jsonBytes, 0644) // #nosec G306
+}
+
+// getLocalStackDir returns the directory of the stack in the directory of a local registry
+func getLocalStackDir(dir, stackName string) string {
+	return filepath.Join(dir, localRegistryStacksDir, stackName)
+}
+
+// GetLocalStackDevfilePath returns the path of the devfile of the stack of a local registry
+func GetLocalStackDevfilePath(registry Registry, stackName string) string {
+	return filepath.Join(getLocalStackDir(registryUtil.GetLocalRegistryPath(registry.URL), stackName), stackDevfile)
+}
+
+// GetLocalStarterProjectArchivePath returns the path of the archive of the starter project of the stack,
+// in the directory of a local registry
+func GetLocalStarterProjectArchivePath(dir, stackName, starterProjectName string) string {
+	return filepath.Join(getLocalStackDir(dir, stackName), localStarterProjectsDir, starterProjectName+".zip")
+}
+
+// GetLocalStarterProjectArchives returns the file:// URLs of the archives of the starter projects of the stack
+// provided by a local registry, indexed by the names of the starter projects
+func GetLocalStarterProjectArchives(registry Registry, stackName string) (map[string]string, error) {
+	archives := map[string]string{}
+	if !registryUtil.IsLocalRegistry(registry.URL) {
+		return archives, nil
+	}
+
+	dir := filepath.Join(getLocalStackDir(registryUtil.GetLocalRegistryPath(registry.URL), stackName), localStarterProjectsDir)
+	files, err := ioutil.ReadDir(dir)
+	if os.IsNotExist(err) {
+		return archives, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	for _, file := range files {
+		if file.IsDir() || filepath.Ext(file.Name()) != ".zip" {
+			continue
+		}
+		url, err := registryUtil.GetLocalRegistryURL(filepath.Join(dir, file.Name()))
+		if err != nil {
+			return nil, err
+		}
+		archives[file.Name()[:len(file.Name())-len(".zip")]] = url
+	}
+	return archives, nil
+}
+
+// getLocalStackVersionDir returns the directory of the version of the stack of a local registry.
+// The versions of the stacks listed by the index are in sub-directories of the directories of the stacks
+func getLocalStackVersionDir(registry Registry, stackName string, version StackVersion) string {
+	stackDir := getLocalStackDir(registryUtil.GetLocalRegistryPath(registry.URL), stackName)
+	if version.versioned {
+		return filepath.Join(stackDir, version.Version)
+	}
+	return stackDir
+}
+
+// PullStackFromLocalRegistry copies the resources of the default version of the stack of a local registry into the destination directory
+func PullStackFromLocalRegistry(registry Registry, stackName, destDir string) error {
+	version, err := GetStackVersion(registry, stackName, "")
+	if err != nil {
+		return err
+	}
+	return copyLocalStackResources(getLocalStackVersionDir(registry, stackName, version), version.Resources, destDir)
+}
+
+// copyLocalStackResources copies the resources of a stack of a local registry into the destination directory,
+// the stack only contains its devfile when its resources are not listed
+func copyLocalStackResources(stackDir string, resources []string, destDir string) error {
+	if len(resources) == 0 {
+		resources = []string{stackDevfile}
+	}
+	for _, resource := range resources {
+		src := filepath.Join(stackDir, filepath.FromSlash(resource))
+		dst := filepath.Join(destDir, filepath.FromSlash(resource))
+		err := os.MkdirAll(filepath.Dir(dst), os.ModePerm)
+		if err != nil {
+			return err
+		}
+		err = util.CopyFileWithFs(src, dst)
+		if err != nil {
+			return errors.Wrapf(err, "unable to copy the resource %s from %s", resource, stackDir)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
+)
+
+// mockLocalRegistry creates a local registry with a nodejs stack in a temporary directory
+func mockLocalRegistry(t *testing.T) (Registry, string) {
+	dir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	err = WriteLocalRegistryIndex(dir, []indexSchema.Schema{
+		{
+			Name:        "nodejs",
+			DisplayName: "NodeJS Angular Web Application",
+			Description: "Stack for developing NodeJS Angular Web Application",
+			Language:    "nodejs",
+			Tags:        []string{"NodeJS", "Angular", "Alpine"},
+			Links:       map[string]string{"self": "devfile-catalog/nodejs:latest"},
+			Resources:   []string{"devfile.yaml", "config/settings.json"},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	files := map[string]string{
+		"stacks/nodejs/devfile.yaml":                        "schemaVersion: 2.0.0",
+		"stacks/nodejs/config/settings.json":                "{}",
+		"stacks/nodejs/starter-projects/nodejs-starter.zip": "",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	url, err := registryUtil.GetLocalRegistryURL(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Registry{Name: "LocalRegistry", URL: url}, dir
+}
+
+func TestGetRegistryDevfilesFromLocalRegistry(t *testing.T) {
+	registry, dir := mockLocalRegistry(t)
+	defer os.RemoveAll(dir)
+
+	got, err := getRegistryDevfiles(registry)
+	if err != nil {
+		t.Fatalf("getRegistryDevfiles() unexpected error: %v", err)
+	}
+	want := []DevfileComponentType{
+		{
+			Name:        "nodejs",
+			DisplayName: "NodeJS Angular Web Application",
+			Description: "Stack for developing NodeJS Angular Web Application",
+			Link:        "devfile-catalog/nodejs:latest",
+			Registry:    registry,
+			Language:    "nodejs",
+			Tags:        []string{"NodeJS", "Angular", "Alpine"},
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("getRegistryDevfiles() = %+v, want %+v", got, want)
+	}
+}
+
+func TestPullStackFromLocalRegistry(t *testing.T) {
+	registry, dir := mockLocalRegistry(t)
+	defer os.RemoveAll(dir)
+
+	dest, err := ioutil.TempDir("", "odo-component")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dest)
+
+	err = PullStackFromLocalRegistry(registry, "nodejs", dest)
+	if err != nil {
+		t.Fatalf("PullStackFromLocalRegistry() unexpected error: %v", err)
+	}
+	for _, resource := range []string{"devfile.yaml", filepath.Join("config", "settings.json")} {
+		if _, err := os.Stat(filepath.Join(dest, resource)); err != nil {
+			t.Errorf("the resource %s has not been copied: %v", resource, err)
+		}
+	}
+	// the archives of the starter projects are not part of the resources of the stack
+	if _, err := os.Stat(filepath.Join(dest, "starter-projects")); !os.IsNotExist(err) {
+		t.Errorf("the starter projects should not be copied")
+	}
+
+	err = PullStackFromLocalRegistry(registry, "java", dest)
+	if err == nil {
+		t.Errorf("PullStackFromLocalRegistry() expected an error for a stack not in the registry")
+	}
+}
+
+func TestGetLocalStarterProjectArchives(t *testing.T) {
+	registry, dir := mockLocalRegistry(t)
+	defer os.RemoveAll(dir)
+
+	got, err := GetLocalStarterProjectArchives(registry, "nodejs")
+	if err != nil {
+		t.Fatalf("GetLocalStarterProjectArchives() unexpected error: %v", err)
+	}
+	wantURL, err := registryUtil.GetLocalRegistryURL(GetLocalStarterProjectArchivePath(dir, "nodejs", "nodejs-starter"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, map[string]string{"nodejs-starter": wantURL}) {
+		t.Errorf("GetLocalStarterProjectArchives() = %v", got)
+	}
+
+	got, err = GetLocalStarterProjectArchives(Registry{Name: "DefaultDevfileRegistry", URL: "https://registry.devfile.io"}, "nodejs")
+	if err != nil || len(got) != 0 {
+		t.Errorf("GetLocalStarterProjectArchives() = %v, %v, want no archives for a remote registry", got, err)
+	}
+}
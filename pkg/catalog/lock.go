@@ -0,0 +1,110 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v2"
+)
+
+const (
+	// stackLockDir is the directory of a component containing the stack lock, which is not ignored by git
+	// so that the lock is shared with the sources of the component
+	stackLockDir = ".odo"
+	// stackLockFile is the file recording the stack a component has been created from
+	stackLockFile = "devfile.lock"
+	// stackLockDevfile is the copy of the devfile of the stack a component has been created from,
+	// used as the base of the three-way merges of the devfile of the component with newer versions of the stack
+	stackLockDevfile = "devfile.base.yaml"
+)
+
+// StackLock records the registry, the stack and the version of the stack a component has been created from,
+// with the digest of the devfile of the stack
+type StackLock struct {
+	Registry    string `yaml:"registry" json:"registry"`
+	RegistryURL string `yaml:"registryURL" json:"registryURL"`
+	Stack       string `yaml:"stack" json:"stack"`
+	Version     string `yaml:"version,omitempty" json:"version,omitempty"`
+	Digest      string `yaml:"digest" json:"digest"`
+}
+
+// NewStackLock returns the lock of a component created from the version of the stack provided by the registry
+func NewStackLock(registry Registry, stackName string, version StackVersion, devfileData []byte) StackLock {
+	return StackLock{
+		Registry:    registry.Name,
+		RegistryURL: registry.URL,
+		Stack:       stackName,
+		Version:     version.Version,
+		Digest:      DevfileDigest(devfileData),
+	}
+}
+
+// DevfileDigest returns the digest of the content of a devfile
+func DevfileDigest(devfileData []byte) string {
+	return digest(devfileData)
+}
+
+// WriteStackLock writes the lock and the devfile of the stack into the .odo directory of the component
+func WriteStackLock(contextDir string, lock StackLock, devfileData []byte) error {
+	dir := filepath.Join(contextDir, stackLockDir)
+	err := os.MkdirAll(dir, os.ModePerm)
+	if err != nil {
+		return err
+	}
+	lockData, err := yaml.Marshal(lock)
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, stackLockFile), lockData, 0644) // #nosec G306
+	if err != nil {
+		return errors.Wrap(err, "unable to write the lock of the stack")
+	}
+	err = ioutil.WriteFile(filepath.Join(dir, stackLockDevfile), devfileData, 0644) // #nosec G306
+	if err != nil {
+		return errors.Wrap(err, "unable to write the devfile of the stack")
+	}
+	return nil
+}
+
+// GetStackLock returns the lock of the stack of the component, or nil if the component has no lock
+func GetStackLock(contextDir string) (*StackLock, error) {
+	lockData, err := ioutil.ReadFile(filepath.Join(contextDir, stackLockDir, stackLockFile))
+	if os.IsNotExist(err) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the lock of the stack")
+	}
+	var lock StackLock
+	err = yaml.Unmarshal(lockData, &lock)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to parse the lock of the stack")
+	}
+	return &lock, nil
+}
+
+// GetStackLockDevfile returns the devfile of the stack the component has been created from,
+// from the copy saved with the lock or else from the registry. An error is returned if the devfile
+// doesn't match the digest recorded by the lock
+func GetStackLockDevfile(contextDir string, lock StackLock) ([]byte, error) {
+	devfileData, err := ioutil.ReadFile(filepath.Join(contextDir, stackLockDir, stackLockDevfile))
+	if err == nil && DevfileDigest(devfileData) == lock.Digest {
+		return devfileData, nil
+	}
+
+	registry := Registry{Name: lock.Registry, URL: lock.RegistryURL}
+	version, err := GetStackVersion(registry, lock.Stack, lock.Version)
+	if err != nil {
+		return nil, err
+	}
+	devfileData, err = GetStackVersionDevfile(registry, lock.Stack, version)
+	if err != nil {
+		return nil, err
+	}
+	if DevfileDigest(devfileData) != lock.Digest {
+		return nil, errors.Errorf("the devfile of the version %s of the stack %s provided by the registry %s has changed since the creation of the component", lock.Version, lock.Stack, lock.Registry)
+	}
+	return devfileData, nil
+}
@@ -0,0 +1,94 @@
+package catalog
+
+import (
+	"os"
+	"path/filepath"
+
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+)
+
+// MirrorRegistryStacks copies the stacks of the registry into the directory, laid out as a local registry.
+// It returns the index of the mirrored stacks, which is written with WriteLocalRegistryIndex once the
+// starter projects of the stacks have been mirrored as well
+func MirrorRegistryStacks(registry Registry, dir string) ([]indexSchema.Schema, error) {
+	devfileIndex, err := getRegistryIndex(registry)
+	if err != nil {
+		return nil, err
+	}
+
+	var mirrorIndex []indexSchema.Schema
+	for _, stack := range devfileIndex {
+		stackDir := getLocalStackDir(dir, stack.Name)
+		// remove the files of a previous mirror of the stack
+		err = os.RemoveAll(stackDir)
+		if err != nil {
+			return nil, err
+		}
+		err = os.MkdirAll(stackDir, os.ModePerm)
+		if err != nil {
+			return nil, err
+		}
+
+		// only the default version of the stacks is mirrored
+		version, err := stack.getVersion(registry, "")
+		if err != nil {
+			return nil, err
+		}
+		err = PullStackVersion(registry, stack.Name, version, stackDir)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to mirror the stack %s", stack.Name)
+		}
+
+		mirrorStack := stack.Schema
+		mirrorStack.Version = version.Version
+		mirrorStack.Links = version.Links
+		mirrorStack.StarterProjects = version.StarterProjects
+		mirrorStack.Resources, err = listStackResources(stackDir)
+		if err != nil {
+			return nil, err
+		}
+		mirrorIndex = append(mirrorIndex, mirrorStack)
+	}
+	return mirrorIndex, nil
+}
+
+// downloadGithubStackDevfile downloads the devfile of a stack of a Github-based registry
+func downloadGithubStackDevfile(registry Registry, link, destination string) error {
+	URL, err := convertURL(registry.URL)
+	if err != nil {
+		return errors.Wrapf(err, "unable to convert URL %s", registry.URL)
+	}
+	params := util.DownloadParams{
+		Request: util.HTTPRequestParams{
+			URL: URL + link,
+		},
+		Filepath: destination,
+	}
+	params.Request.Token, err = getRegistryToken(registry)
+	if err != nil {
+		return err
+	}
+	return util.DownloadFile(params)
+}
+
+// listStackResources lists the files of the directory of a stack, relative to the directory
+func listStackResources(stackDir string) ([]string, error) {
+	var resources []string
+	err := filepath.Walk(stackDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		if info.IsDir() {
+			return nil
+		}
+		rel, err := filepath.Rel(stackDir, path)
+		if err != nil {
+			return err
+		}
+		resources = append(resources, filepath.ToSlash(rel))
+		return nil
+	})
+	return resources, err
+}
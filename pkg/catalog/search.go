@@ -0,0 +1,215 @@
+package catalog
+
+import (
+	"sort"
+	"strings"
+)
+
+// scores of the matches of a search term with a value, from the best match to the worst one
+const (
+	exactMatchScore       = 100
+	prefixMatchScore      = 80
+	substringMatchScore   = 60
+	subsequenceMatchScore = 40
+	typoMatchScore        = 30
+	descriptionMatchScore = 20
+)
+
+// DevfileComponentFilter filters the devfile components on their fields, the empty fields match all the components.
+// The term is fuzzy matched with the name, the display name, the tags and the description of the components,
+// the other fields match the values of the components case-insensitively, with a tolerance for typos
+type DevfileComponentFilter struct {
+	Term        string
+	Language    string
+	ProjectType string
+	Provider    string
+	Registry    string
+	// Tags are all required
+	Tags []string
+}
+
+// IsEmpty indicates if the filter matches all the components
+func (f DevfileComponentFilter) IsEmpty() bool {
+	return f.Term == "" && f.Language == "" && f.ProjectType == "" && f.Provider == "" && f.Registry == "" && len(f.Tags) == 0
+}
+
+// SearchDevfileComponents returns the components matching the filter, ranked from the best match to the worst one.
+// The components matching equally keep the order of the list
+func SearchDevfileComponents(components []DevfileComponentType, filter DevfileComponentFilter) []DevfileComponentType {
+	type match struct {
+		component DevfileComponentType
+		score     int
+	}
+	var matches []match
+	for _, component := range components {
+		if score, ok := filter.score(component); ok {
+			matches = append(matches, match{component: component, score: score})
+		}
+	}
+	sort.SliceStable(matches, func(i, j int) bool {
+		return matches[i].score > matches[j].score
+	})
+
+	result := make([]DevfileComponentType, 0, len(matches))
+	for _, m := range matches {
+		result = append(result, m.component)
+	}
+	return result
+}
+
+// score returns the score of the component for the filter, and false if the component doesn't match the filter
+func (f DevfileComponentFilter) score(component DevfileComponentType) (int, bool) {
+	total := 0
+	fields := []struct {
+		pattern string
+		value   string
+	}{
+		{f.Language, component.Language},
+		{f.ProjectType, component.ProjectType},
+		{f.Provider, component.Provider},
+		{f.Registry, component.Registry.Name},
+	}
+	for _, field := range fields {
+		if field.pattern == "" {
+			continue
+		}
+		score := fieldScore(field.pattern, field.value)
+		if score == 0 {
+			return 0, false
+		}
+		total += score
+	}
+
+	for _, tag := range f.Tags {
+		best := 0
+		for _, componentTag := range component.Tags {
+			if score := fieldScore(tag, componentTag); score > best {
+				best = score
+			}
+		}
+		if best == 0 {
+			return 0, false
+		}
+		total += best
+	}
+
+	if f.Term != "" {
+		score := termScore(f.Term, component)
+		if score == 0 {
+			return 0, false
+		}
+		total += score
+	}
+	return total, true
+}
+
+// termScore returns the best score of the search term with the name, the display name, the tags
+// and the description of the component, the name being preferred
+func termScore(term string, component DevfileComponentType) int {
+	best := fuzzyScore(term, component.Name)
+	if best > 0 {
+		// the matches of the name come before the same matches of the other fields
+		best++
+	}
+	candidates := append([]string{component.DisplayName}, component.Tags...)
+	for _, candidate := range candidates {
+		if score := fuzzyScore(term, candidate); score > best {
+			best = score
+		}
+	}
+	if best == 0 && strings.Contains(strings.ToLower(component.Description), strings.ToLower(term)) {
+		best = descriptionMatchScore
+	}
+	return best
+}
+
+// fieldScore returns the score of the pattern with the value of a field, which only matches
+// case-insensitively or with a few typos, so that a language like java doesn't match javascript
+func fieldScore(pattern, value string) int {
+	p, v := strings.ToLower(pattern), strings.ToLower(value)
+	if p == v {
+		return exactMatchScore
+	}
+	return typoScore(p, v)
+}
+
+// fuzzyScore returns the score of the pattern with the value, 0 if the pattern doesn't match. The pattern matches
+// if it's equal to, a prefix of, a substring of or a subsequence of the value, or if it has a few typos
+func fuzzyScore(pattern, value string) int {
+	p, v := strings.ToLower(pattern), strings.ToLower(value)
+	switch {
+	case p == "" || v == "":
+		return 0
+	case p == v:
+		return exactMatchScore
+	case strings.HasPrefix(v, p):
+		return prefixMatchScore
+	case strings.Contains(v, p):
+		return substringMatchScore
+	case isSubsequence(p, v):
+		return subsequenceMatchScore
+	}
+	return typoScore(p, v)
+}
+
+// typoScore returns a score decreasing with the edit distance of the pattern and the value,
+// 0 if there are more typos than a third of the length of the pattern
+func typoScore(p, v string) int {
+	maxDistance := len([]rune(p)) / 3
+	if maxDistance == 0 {
+		return 0
+	}
+	distance := editDistance(p, v)
+	if distance > maxDistance {
+		return 0
+	}
+	return typoMatchScore - distance
+}
+
+// isSubsequence indicates if the characters of p appear in v in the same order
+func isSubsequence(p, v string) bool {
+	pr := []rune(p)
+	i := 0
+	for _, r := range v {
+		if i < len(pr) && pr[i] == r {
+			i++
+		}
+	}
+	return i == len(pr)
+}
+
+// editDistance returns the Damerau-Levenshtein distance of a and b, with the transpositions of adjacent characters
+func editDistance(a, b string) int {
+	ar, br := []rune(a), []rune(b)
+	d := make([][]int, len(ar)+1)
+	for i := range d {
+		d[i] = make([]int, len(br)+1)
+		d[i][0] = i
+	}
+	for j := range d[0] {
+		d[0][j] = j
+	}
+	for i := 1; i <= len(ar); i++ {
+		for j := 1; j <= len(br); j++ {
+			cost := 1
+			if ar[i-1] == br[j-1] {
+				cost = 0
+			}
+			d[i][j] = min(d[i-1][j]+1, d[i][j-1]+1, d[i-1][j-1]+cost)
+			if i > 1 && j > 1 && ar[i-1] == br[j-2] && ar[i-2] == br[j-1] {
+				d[i][j] = min(d[i][j], d[i-2][j-2]+1)
+			}
+		}
+	}
+	return d[len(ar)][len(br)]
+}
+
+func min(values ...int) int {
+	m := values[0]
+	for _, v := range values[1:] {
+		if v < m {
+			m = v
+		}
+	}
+	return m
+}
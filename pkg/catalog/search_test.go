@@ -0,0 +1,81 @@
+package catalog
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSearchDevfileComponents(t *testing.T) {
+	defaultRegistry := Registry{Name: "DefaultDevfileRegistry"}
+	otherRegistry := Registry{Name: "OtherRegistry"}
+	components := []DevfileComponentType{
+		{Name: "java-maven", DisplayName: "Maven Java", Language: "java", ProjectType: "maven", Tags: []string{"Java", "Maven"}, Registry: defaultRegistry},
+		{Name: "java-quarkus", DisplayName: "Quarkus Java", Language: "java", ProjectType: "quarkus", Provider: "Red Hat", Tags: []string{"Java", "Quarkus"}, Registry: defaultRegistry},
+		{Name: "nodejs", DisplayName: "NodeJS Runtime", Description: "Stack with NodeJS 12", Language: "javascript", ProjectType: "nodejs", Tags: []string{"NodeJS", "Express"}, Registry: defaultRegistry},
+		{Name: "python", DisplayName: "Python", Language: "python", ProjectType: "python", Tags: []string{"Python", "pip"}, Registry: otherRegistry},
+		{Name: "python-django", DisplayName: "Django", Language: "python", ProjectType: "django", Tags: []string{"Python", "Django"}, Registry: otherRegistry},
+	}
+
+	tests := []struct {
+		name   string
+		filter DevfileComponentFilter
+		want   []string
+	}{
+		{
+			name:   "the exact name match comes first",
+			filter: DevfileComponentFilter{Term: "python"},
+			want:   []string{"python", "python-django"},
+		},
+		{
+			name:   "fuzzy term with a typo",
+			filter: DevfileComponentFilter{Term: "pyhton"},
+			want:   []string{"python", "python-django"},
+		},
+		{
+			name:   "term matching a subsequence of the name",
+			filter: DevfileComponentFilter{Term: "jquarkus"},
+			want:   []string{"java-quarkus"},
+		},
+		{
+			name:   "term matching the description",
+			filter: DevfileComponentFilter{Term: "stack with"},
+			want:   []string{"nodejs"},
+		},
+		{
+			name:   "the language doesn't match a longer language",
+			filter: DevfileComponentFilter{Language: "java"},
+			want:   []string{"java-maven", "java-quarkus"},
+		},
+		{
+			name:   "combined filters",
+			filter: DevfileComponentFilter{Language: "Java", ProjectType: "quarkus", Provider: "red hat"},
+			want:   []string{"java-quarkus"},
+		},
+		{
+			name:   "all the tags are required",
+			filter: DevfileComponentFilter{Tags: []string{"python", "django"}},
+			want:   []string{"python-django"},
+		},
+		{
+			name:   "registry with a typo",
+			filter: DevfileComponentFilter{Registry: "OtherRegsitry"},
+			want:   []string{"python", "python-django"},
+		},
+		{
+			name:   "no match",
+			filter: DevfileComponentFilter{Term: "golang"},
+			want:   []string{},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := []string{}
+			for _, component := range SearchDevfileComponents(components, tt.filter) {
+				got = append(got, component.Name)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("SearchDevfileComponents() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
@@ -0,0 +1,325 @@
+package catalog
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+	"sync"
+	"time"
+
+	indexLibrary "github.com/devfile/registry-support/index/generator/library"
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	registryLibrary "github.com/devfile/registry-support/registry-library/library"
+	"github.com/fsnotify/fsnotify"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+const (
+	// ociManifestMediaType is the media type of the OCI manifests of the stacks
+	ociManifestMediaType = "application/vnd.oci.image.manifest.v1+json"
+	// ociTitleAnnotation is the annotation of a layer containing the name of its file
+	ociTitleAnnotation = "org.opencontainers.image.title"
+	// ociAPIPath is the path of the OCI distribution API, used to pull the stacks
+	ociAPIPath = "/v2/"
+	// regenerateIndexDelay is the delay between a change in the stacks directory and the regeneration of the index,
+	// so that a stack copied file by file is only indexed once
+	regenerateIndexDelay = 500 * time.Millisecond
+)
+
+// ociDescriptor describes the content of a layer or of the configuration of an OCI manifest
+type ociDescriptor struct {
+	MediaType   string            `json:"mediaType"`
+	Digest      string            `json:"digest"`
+	Size        int               `json:"size"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// ociManifest is the OCI manifest of a stack, with a layer per resource of the stack
+type ociManifest struct {
+	SchemaVersion int             `json:"schemaVersion"`
+	Config        ociDescriptor   `json:"config"`
+	Layers        []ociDescriptor `json:"layers"`
+}
+
+// RegistryServer serves a directory of stacks as a devfile registry, with the endpoints used by odo:
+// the index of the stacks on /index, the devfiles of the stacks on /devfiles/<stack> and the
+// read-only part of the OCI distribution API on /v2/ to pull the stacks
+type RegistryServer struct {
+	stacksDir string
+
+	mu    sync.RWMutex
+	index []indexSchema.Schema
+}
+
+// NewRegistryServer returns a RegistryServer serving the stacks of the directory,
+// each stack being a sub-directory containing a devfile.yaml
+func NewRegistryServer(stacksDir string) (*RegistryServer, error) {
+	s := &RegistryServer{stacksDir: stacksDir}
+	err := s.GenerateIndex()
+	if err != nil {
+		return nil, err
+	}
+	return s, nil
+}
+
+// GenerateIndex generates the index of the stacks of the directory.
+// The previous index is kept when the generation fails
+func (s *RegistryServer) GenerateIndex() error {
+	index, err := indexLibrary.GenerateIndexStruct(s.stacksDir, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to generate the index of the stacks of %s", s.stacksDir)
+	}
+	for _, stack := range index {
+		// the resources of the stacks are served from the directories named after the stacks
+		if _, err = os.Stat(filepath.Join(s.stacksDir, stack.Name)); err != nil {
+			return fmt.Errorf("the directory of the stack %s must be named after the stack", stack.Name)
+		}
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.index = index
+	return nil
+}
+
+// Index returns the current index of the stacks
+func (s *RegistryServer) Index() []indexSchema.Schema {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.index
+}
+
+// getStack returns the entry of the stack in the index, or nil if the stack is not indexed
+func (s *RegistryServer) getStack(name string) *indexSchema.Schema {
+	index := s.Index()
+	for i := range index {
+		if index[i].Name == name {
+			return &index[i]
+		}
+	}
+	return nil
+}
+
+// ServeHTTP serves the index, the devfiles and the OCI artifacts of the stacks
+func (s *RegistryServer) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	klog.V(4).Infof("%s %s", r.Method, r.URL.Path)
+	if r.Method != http.MethodGet && r.Method != http.MethodHead {
+		http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+		return
+	}
+
+	urlPath := path.Clean(r.URL.Path)
+	switch {
+	case urlPath == "/index":
+		s.serveIndex(w, r)
+	case strings.HasPrefix(urlPath, "/devfiles/"):
+		s.serveDevfile(w, r, strings.TrimPrefix(urlPath, "/devfiles/"))
+	case urlPath+"/" == ociAPIPath:
+		// the OCI distribution API is supported, without authentication
+		w.Header().Set("Content-Type", "application/json")
+		fmt.Fprint(w, "{}")
+	case strings.HasPrefix(urlPath, ociAPIPath):
+		s.serveOCI(w, r, strings.TrimPrefix(urlPath, ociAPIPath))
+	default:
+		http.NotFound(w, r)
+	}
+}
+
+// serveIndex serves the index of the stacks, with the digest of the index as ETag so that the clients can revalidate it
+func (s *RegistryServer) serveIndex(w http.ResponseWriter, r *http.Request) {
+	jsonBytes, err := json.MarshalIndent(s.Index(), "", "  ")
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	etag := `"` + digest(jsonBytes) + `"`
+	w.Header().Set("ETag", etag)
+	if r.Header.Get("If-None-Match") == etag {
+		w.WriteHeader(http.StatusNotModified)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	_, _ = w.Write(jsonBytes)
+}
+
+// serveDevfile serves the devfile of the stack
+func (s *RegistryServer) serveDevfile(w http.ResponseWriter, r *http.Request, stackName string) {
+	stack := s.getStack(stackName)
+	if stack == nil {
+		http.NotFound(w, r)
+		return
+	}
+	for _, name := range []string{stackDevfile, "." + stackDevfile} {
+		devfilePath := filepath.Join(s.stacksDir, stack.Name, name)
+		if _, err := os.Stat(devfilePath); err == nil {
+			w.Header().Set("Content-Type", "text/yaml")
+			http.ServeFile(w, r, devfilePath)
+			return
+		}
+	}
+	http.NotFound(w, r)
+}
+
+// serveOCI serves the manifests and the blobs of the stacks, on the paths of the OCI distribution API:
+// <repository>/manifests/<tag or digest> and <repository>/blobs/<digest>, the last element of the
+// repository being the name of the stack
+func (s *RegistryServer) serveOCI(w http.ResponseWriter, r *http.Request, apiPath string) {
+	var repository, kind, reference string
+	for _, k := range []string{"manifests", "blobs"} {
+		if i := strings.LastIndex(apiPath, "/"+k+"/"); i > 0 {
+			repository, kind, reference = apiPath[:i], k, apiPath[i+len(k)+2:]
+			break
+		}
+	}
+	stack := s.getStack(path.Base(repository))
+	if kind == "" || stack == nil {
+		http.NotFound(w, r)
+		return
+	}
+
+	manifest, blobs, err := s.getStackArtifact(*stack)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	manifestBytes, err := json.Marshal(manifest)
+	if err != nil {
+		http.Error(w, err.Error(), http.StatusInternalServerError)
+		return
+	}
+	manifestDigest := digest(manifestBytes)
+
+	var content []byte
+	mediaType := "application/octet-stream"
+	switch {
+	case reference == manifestDigest || (kind == "manifests" && !strings.Contains(reference, ":")):
+		// the manifests are only tagged "latest", but any tag is accepted since the stacks are not versioned
+		content, mediaType = manifestBytes, ociManifestMediaType
+	case kind == "blobs" && blobs[reference] != nil:
+		content = blobs[reference]
+	default:
+		http.NotFound(w, r)
+		return
+	}
+
+	w.Header().Set("Content-Type", mediaType)
+	w.Header().Set("Content-Length", fmt.Sprint(len(content)))
+	w.Header().Set("Docker-Content-Digest", digest(content))
+	if r.Method == http.MethodGet {
+		_, _ = w.Write(content)
+	}
+}
+
+// getStackArtifact returns the OCI manifest of the stack and the contents of its blobs, indexed by their digests
+func (s *RegistryServer) getStackArtifact(stack indexSchema.Schema) (ociManifest, map[string][]byte, error) {
+	config := []byte("{}")
+	blobs := map[string][]byte{digest(config): config}
+	manifest := ociManifest{
+		SchemaVersion: 2,
+		Config: ociDescriptor{
+			MediaType: registryLibrary.DevfileConfigMediaType,
+			Digest:    digest(config),
+			Size:      len(config),
+		},
+	}
+	for _, resource := range stack.Resources {
+		content, err := ioutil.ReadFile(filepath.Join(s.stacksDir, stack.Name, filepath.FromSlash(resource)))
+		if err != nil {
+			return manifest, nil, errors.Wrapf(err, "unable to read the resource %s of the stack %s", resource, stack.Name)
+		}
+		blobs[digest(content)] = content
+		manifest.Layers = append(manifest.Layers, ociDescriptor{
+			MediaType:   getResourceMediaType(resource),
+			Digest:      digest(content),
+			Size:        len(content),
+			Annotations: map[string]string{ociTitleAnnotation: resource},
+		})
+	}
+	return manifest, blobs, nil
+}
+
+// getResourceMediaType returns the media type of a resource of a stack, as pushed by the registry build
+func getResourceMediaType(resource string) string {
+	switch {
+	case resource == stackDevfile || resource == "."+stackDevfile:
+		return registryLibrary.DevfileMediaType
+	case strings.HasSuffix(resource, ".svg"):
+		return registryLibrary.DevfileSVGLogoMediaType
+	case strings.HasSuffix(resource, ".png"):
+		return registryLibrary.DevfilePNGLogoMediaType
+	case strings.HasSuffix(resource, ".vsx"):
+		return registryLibrary.DevfileVSXMediaType
+	default:
+		return registryLibrary.DevfileArchiveMediaType
+	}
+}
+
+// digest returns the sha256 digest of the content, in the format used by the OCI distribution API
+func digest(content []byte) string {
+	return fmt.Sprintf("sha256:%x", sha256.Sum256(content))
+}
+
+// Watch regenerates the index when the stacks directory or the directory of a stack changes, until stop is closed.
+// onRegenerate is called after each regeneration of the index, with the error of the generation if any
+func (s *RegistryServer) Watch(stop <-chan struct{}, onRegenerate func(error)) error {
+	watcher, err := fsnotify.NewWatcher()
+	if err != nil {
+		return errors.Wrap(err, "unable to watch the stacks directory")
+	}
+	defer watcher.Close()
+
+	err = s.addStackWatches(watcher)
+	if err != nil {
+		return err
+	}
+
+	// the regeneration is delayed until no change happened for regenerateIndexDelay
+	timer := time.NewTimer(regenerateIndexDelay)
+	timer.Stop()
+	for {
+		select {
+		case event := <-watcher.Events:
+			klog.V(4).Infof("stacks directory change: %s", event)
+			timer.Reset(regenerateIndexDelay)
+		case err := <-watcher.Errors:
+			klog.V(4).Infof("error watching the stacks directory: %v", err)
+		case <-timer.C:
+			// new stacks need to be watched as well
+			err = s.addStackWatches(watcher)
+			if err == nil {
+				err = s.GenerateIndex()
+			}
+			onRegenerate(err)
+		case <-stop:
+			return nil
+		}
+	}
+}
+
+// addStackWatches watches the stacks directory and the directory of each stack
+func (s *RegistryServer) addStackWatches(watcher *fsnotify.Watcher) error {
+	err := watcher.Add(s.stacksDir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to watch %s", s.stacksDir)
+	}
+	files, err := ioutil.ReadDir(s.stacksDir)
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		if !file.IsDir() {
+			continue
+		}
+		err = watcher.Add(filepath.Join(s.stacksDir, file.Name()))
+		if err != nil {
+			return errors.Wrapf(err, "unable to watch %s", file.Name())
+		}
+	}
+	return nil
+}
@@ -0,0 +1,134 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	registryLibrary "github.com/devfile/registry-support/registry-library/library"
+)
+
+const serverTestDevfile = `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+  displayName: NodeJS Runtime
+  language: nodejs
+  tags: ["NodeJS"]
+starterProjects:
+- name: nodejs-starter
+  git:
+    remotes:
+      origin: https://github.com/odo-devfiles/nodejs-ex.git
+`
+
+// mockStacksDir creates a directory of stacks containing a nodejs stack
+func mockStacksDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "odo-stacks")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files := map[string]string{
+		"nodejs/devfile.yaml": serverTestDevfile,
+		"nodejs/logo.svg":     "<svg/>",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+	return dir
+}
+
+func TestRegistryServer(t *testing.T) {
+	dir := mockStacksDir(t)
+	defer os.RemoveAll(dir)
+
+	s, err := NewRegistryServer(dir)
+	if err != nil {
+		t.Fatalf("NewRegistryServer() unexpected error: %v", err)
+	}
+	server := httptest.NewServer(s)
+	defer server.Close()
+
+	t.Run("index", func(t *testing.T) {
+		index, err := registryLibrary.GetRegistryStacks(server.URL)
+		if err != nil {
+			t.Fatalf("GetRegistryStacks() unexpected error: %v", err)
+		}
+		if len(index) != 1 {
+			t.Fatalf("GetRegistryStacks() returned %d stacks, want 1", len(index))
+		}
+		if index[0].Name != "nodejs" || !reflect.DeepEqual(index[0].StarterProjects, []string{"nodejs-starter"}) {
+			t.Errorf("GetRegistryStacks() = %+v", index[0])
+		}
+	})
+
+	t.Run("devfile", func(t *testing.T) {
+		resp, err := http.Get(server.URL + "/devfiles/nodejs")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer resp.Body.Close()
+		body, err := ioutil.ReadAll(resp.Body)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(body) != serverTestDevfile {
+			t.Errorf("GET /devfiles/nodejs = %q", body)
+		}
+
+		resp, err = http.Get(server.URL + "/devfiles/java")
+		if err != nil {
+			t.Fatal(err)
+		}
+		resp.Body.Close()
+		if resp.StatusCode != http.StatusNotFound {
+			t.Errorf("GET /devfiles/java returned %d, want %d", resp.StatusCode, http.StatusNotFound)
+		}
+	})
+
+	t.Run("pull stack", func(t *testing.T) {
+		dest, err := ioutil.TempDir("", "odo-component")
+		if err != nil {
+			t.Fatal(err)
+		}
+		defer os.RemoveAll(dest)
+
+		err = registryLibrary.PullStackFromRegistry(server.URL, "nodejs", dest)
+		if err != nil {
+			t.Fatalf("PullStackFromRegistry() unexpected error: %v", err)
+		}
+		for _, resource := range []string{"devfile.yaml", "logo.svg"} {
+			if _, err := os.Stat(filepath.Join(dest, resource)); err != nil {
+				t.Errorf("the resource %s has not been pulled: %v", resource, err)
+			}
+		}
+	})
+
+	t.Run("regenerate index", func(t *testing.T) {
+		err := os.Mkdir(filepath.Join(dir, "broken"), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(filepath.Join(dir, "broken", "devfile.yaml"), []byte("schemaVersion: 2.0.0\nmetadata:\n  name: other"), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if err = s.GenerateIndex(); err == nil {
+			t.Errorf("GenerateIndex() expected an error for a stack not named after its directory")
+		}
+		if len(s.Index()) != 1 {
+			t.Errorf("the previous index should be kept when the generation fails, got %+v", s.Index())
+		}
+	})
+}
@@ -28,6 +28,8 @@ type DevfileComponentType struct {
 	Registry    Registry
 	Language    string
 	Tags        []string
+	ProjectType string
+	Provider    string
 }
 
 // ComponentSpec is the spec for ComponentType
@@ -0,0 +1,306 @@
+package catalog
+
+import (
+	"archive/tar"
+	"compress/gzip"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/url"
+	"os"
+	"path"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/blang/semver"
+	"github.com/containerd/containerd/remotes/docker"
+	"github.com/deislabs/oras/pkg/content"
+	orasctx "github.com/deislabs/oras/pkg/context"
+	"github.com/deislabs/oras/pkg/oras"
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	registryLibrary "github.com/devfile/registry-support/registry-library/library"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	"github.com/zalando/go-keyring"
+)
+
+// stackArchive is the archive of the resources of a stack which are neither its devfile nor its logos
+const stackArchive = "archive.tar"
+
+// StackVersion is a version of a stack provided by a registry
+type StackVersion struct {
+	Version         string            `json:"version,omitempty"`
+	SchemaVersion   string            `json:"schemaVersion,omitempty"`
+	Default         bool              `json:"default,omitempty"`
+	Description     string            `json:"description,omitempty"`
+	Links           map[string]string `json:"links,omitempty"`
+	Resources       []string          `json:"resources,omitempty"`
+	StarterProjects []string          `json:"starterProjects,omitempty"`
+
+	// versioned indicates that the version is one of the versions of the stack listed by the index of the registry,
+	// and not the single version of a stack of a registry without versions
+	versioned bool
+}
+
+// registryStack is an entry of the index of a registry. The registries providing several versions of
+// a stack list them in the versions of the entry, the others only provide the version of the entry
+type registryStack struct {
+	indexSchema.Schema
+	// Provider is the provider of the stack, listed by the newer versions of the index schema
+	Provider string         `json:"provider,omitempty"`
+	Versions []StackVersion `json:"versions,omitempty"`
+}
+
+// getVersions returns the versions of the stack provided by the registry, the newest first
+func (s registryStack) getVersions() []StackVersion {
+	if len(s.Versions) == 0 {
+		return []StackVersion{{
+			Version:         s.Version,
+			Default:         true,
+			Links:           s.Links,
+			Resources:       s.Resources,
+			StarterProjects: s.StarterProjects,
+		}}
+	}
+
+	versions := make([]StackVersion, len(s.Versions))
+	copy(versions, s.Versions)
+	for i := range versions {
+		versions[i].versioned = true
+	}
+	sort.SliceStable(versions, func(i, j int) bool {
+		return compareStackVersions(versions[i].Version, versions[j].Version) > 0
+	})
+	return versions
+}
+
+// compareStackVersions compares two versions of a stack, as semantic versions when possible
+func compareStackVersions(v1, v2 string) int {
+	sv1, err1 := semver.ParseTolerant(v1)
+	sv2, err2 := semver.ParseTolerant(v2)
+	if err1 == nil && err2 == nil {
+		return sv1.Compare(sv2)
+	}
+	return strings.Compare(v1, v2)
+}
+
+// getRegistryStack returns the entry of the stack in the index of the registry
+func getRegistryStack(registry Registry, stackName string) (registryStack, error) {
+	devfileIndex, err := getRegistryIndex(registry)
+	if err != nil {
+		return registryStack{}, err
+	}
+	for _, stack := range devfileIndex {
+		if stack.Name == stackName {
+			return stack, nil
+		}
+	}
+	return registryStack{}, fmt.Errorf("the stack %s doesn't exist in the registry %s", stackName, registry.Name)
+}
+
+// GetStackVersions returns the versions of the stack provided by the registry, the newest first
+func GetStackVersions(registry Registry, stackName string) ([]StackVersion, error) {
+	stack, err := getRegistryStack(registry, stackName)
+	if err != nil {
+		return nil, err
+	}
+	return stack.getVersions(), nil
+}
+
+// GetStackVersion returns the version of the stack provided by the registry,
+// or its default version if the version is empty
+func GetStackVersion(registry Registry, stackName, version string) (StackVersion, error) {
+	stack, err := getRegistryStack(registry, stackName)
+	if err != nil {
+		return StackVersion{}, err
+	}
+	return stack.getVersion(registry, version)
+}
+
+// getVersion returns the version of the stack provided by the registry, or its default version if the version is empty
+func (s registryStack) getVersion(registry Registry, version string) (StackVersion, error) {
+	versions := s.getVersions()
+	var available []string
+	for _, v := range versions {
+		if (version == "" && v.Default) || (version != "" && v.Version == version) {
+			return v, nil
+		}
+		if v.Version != "" {
+			available = append(available, v.Version)
+		}
+	}
+	if version == "" {
+		// a registry may not flag any version as the default one, the newest version is used
+		return versions[0], nil
+	}
+	if len(available) == 0 {
+		return StackVersion{}, fmt.Errorf("the registry %s doesn't provide versions of the stack %s", registry.Name, s.Name)
+	}
+	return StackVersion{}, fmt.Errorf("the version %s of the stack %s doesn't exist in the registry %s, the available versions are: %s", version, s.Name, registry.Name, strings.Join(available, ", "))
+}
+
+// GetLatestStackVersion returns the newest version of the stack provided by the registry
+func GetLatestStackVersion(registry Registry, stackName string) (StackVersion, error) {
+	versions, err := GetStackVersions(registry, stackName)
+	if err != nil {
+		return StackVersion{}, err
+	}
+	return versions[0], nil
+}
+
+// getRegistryToken returns the token of the registry if it is secure
+func getRegistryToken(registry Registry) (string, error) {
+	if !registryUtil.IsSecure(registry.Name) {
+		return "", nil
+	}
+	token, err := keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, registry.Name), registryUtil.RegistryUser)
+	if err != nil {
+		return "", errors.Wrap(err, "unable to get secure registry credential from keyring")
+	}
+	return token, nil
+}
+
+// GetStackVersionDevfile returns the content of the devfile of the version of the stack provided by the registry
+func GetStackVersionDevfile(registry Registry, stackName string, version StackVersion) ([]byte, error) {
+	if registryUtil.IsLocalRegistry(registry.URL) {
+		devfilePath := filepath.Join(getLocalStackVersionDir(registry, stackName, version), stackDevfile)
+		devfileData, err := ioutil.ReadFile(devfilePath)
+		if err != nil {
+			return nil, errors.Wrapf(err, "failed to read the devfile of the stack %s from %s", stackName, devfilePath)
+		}
+		return devfileData, nil
+	}
+
+	request := util.HTTPRequestParams{}
+	if strings.Contains(registry.URL, "github") {
+		// Github-based registry
+		URL, err := convertURL(registry.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to convert URL %s", registry.URL)
+		}
+		request.URL = URL + version.Links["self"]
+		request.Token, err = getRegistryToken(registry)
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		// OCI-based registry
+		registryURL, err := url.Parse(registry.URL)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to parse the URL of the registry %s", registry.Name)
+		}
+		registryURL.Path = path.Join(registryURL.Path, "devfiles", stackName)
+		if version.versioned {
+			registryURL.Path = path.Join(registryURL.Path, version.Version)
+		}
+		request.URL = registryURL.String()
+	}
+
+	devfileData, err := fetchRegistryFile(registry, request)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to download the devfile of the stack %s from %s", stackName, request.URL)
+	}
+	return devfileData, nil
+}
+
+// PullStackVersion copies the resources of the version of the stack provided by the registry into the destination directory
+func PullStackVersion(registry Registry, stackName string, version StackVersion, destDir string) error {
+	switch {
+	case registryUtil.IsLocalRegistry(registry.URL):
+		return copyLocalStackResources(getLocalStackVersionDir(registry, stackName, version), version.Resources, destDir)
+	case strings.Contains(registry.URL, "github"):
+		// the Github-based registries only provide the devfile of the stacks
+		return downloadGithubStackDevfile(registry, version.Links["self"], filepath.Join(destDir, stackDevfile))
+	default:
+		if err := checkRegistryOnline(registry, fmt.Sprintf("pull the stack %s", stackName)); err != nil {
+			return err
+		}
+		return pullOCIStack(registry, version.Links["self"], destDir)
+	}
+}
+
+// pullOCIStack pulls the artifact of a stack from an OCI-based registry, referenced by its link in the index of the registry
+func pullOCIStack(registry Registry, link, destDir string) error {
+	registryURL, err := url.Parse(registry.URL)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse the URL of the registry %s", registry.Name)
+	}
+	resolver := docker.NewResolver(docker.ResolverOptions{PlainHTTP: registryURL.Scheme != "https"})
+	ref := path.Join(registryURL.Host, link)
+	fileStore := content.NewFileStore(destDir)
+	defer fileStore.Close()
+
+	_, _, err = oras.Pull(orasctx.Background(), resolver, ref, fileStore, oras.WithAllowedMediaTypes(registryLibrary.DevfileAllMediaTypesList))
+	if err != nil {
+		return errors.Wrapf(err, "failed to pull the stack %s", ref)
+	}
+
+	archivePath := filepath.Join(destDir, stackArchive)
+	if _, err := os.Stat(archivePath); err != nil {
+		return nil
+	}
+	err = extractStackArchive(archivePath, destDir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to extract the archive of the stack %s", ref)
+	}
+	return os.Remove(archivePath)
+}
+
+// extractStackArchive extracts the gzipped tar archive of the resources of a stack into the directory
+func extractStackArchive(archivePath, destDir string) error {
+	reader, err := os.Open(archivePath)
+	if err != nil {
+		return err
+	}
+	defer reader.Close()
+
+	gzReader, err := gzip.NewReader(reader)
+	if err != nil {
+		return err
+	}
+	defer gzReader.Close()
+
+	tarReader := tar.NewReader(gzReader)
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		// #nosec G305, the entries escaping the directory are rejected
+		target := filepath.Join(destDir, header.Name)
+		if !strings.HasPrefix(target, filepath.Clean(destDir)+string(os.PathSeparator)) {
+			return fmt.Errorf("the entry %s of the archive is outside of the stack", header.Name)
+		}
+		switch header.Typeflag {
+		case tar.TypeDir:
+			err = os.MkdirAll(target, os.ModePerm)
+		case tar.TypeReg:
+			err = writeArchiveEntry(tarReader, target, os.FileMode(header.Mode))
+		}
+		if err != nil {
+			return err
+		}
+	}
+}
+
+// writeArchiveEntry writes the content of an entry of an archive into the file
+func writeArchiveEntry(reader io.Reader, target string, mode os.FileMode) error {
+	err := os.MkdirAll(filepath.Dir(target), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	w, err := os.OpenFile(target, os.O_CREATE|os.O_RDWR|os.O_TRUNC, mode)
+	if err != nil {
+		return err
+	}
+	defer w.Close()
+	// #nosec G110, the stacks are provided by the configured registries
+	_, err = io.Copy(w, reader)
+	return err
+}
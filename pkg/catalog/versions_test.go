@@ -0,0 +1,244 @@
+package catalog
+
+import (
+	"io/ioutil"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	indexSchema "github.com/devfile/registry-support/index/generator/schema"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
+)
+
+// mockVersionedLocalRegistry creates a local registry with two versions of a nodejs stack in a temporary directory
+func mockVersionedLocalRegistry(t *testing.T) (Registry, string) {
+	dir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	index := `[{
+  "name": "nodejs",
+  "displayName": "NodeJS Runtime",
+  "versions": [
+    {"version": "1.0.0", "schemaVersion": "2.0.0", "links": {"self": "devfile-catalog/nodejs:1.0.0"}, "resources": ["devfile.yaml"]},
+    {"version": "1.10.0", "schemaVersion": "2.1.0", "links": {"self": "devfile-catalog/nodejs:1.10.0"}, "resources": ["devfile.yaml"]},
+    {"version": "1.2.0", "schemaVersion": "2.0.0", "default": true, "links": {"self": "devfile-catalog/nodejs:1.2.0"}, "resources": ["devfile.yaml"]}
+  ]
+}]`
+	files := map[string]string{
+		registryUtil.LocalRegistryIndex:     index,
+		"stacks/nodejs/1.0.0/devfile.yaml":  "schemaVersion: 2.0.0\nmetadata:\n  version: 1.0.0",
+		"stacks/nodejs/1.2.0/devfile.yaml":  "schemaVersion: 2.0.0\nmetadata:\n  version: 1.2.0",
+		"stacks/nodejs/1.10.0/devfile.yaml": "schemaVersion: 2.1.0\nmetadata:\n  version: 1.10.0",
+	}
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		err = os.MkdirAll(filepath.Dir(path), os.ModePerm)
+		if err != nil {
+			t.Fatal(err)
+		}
+		err = ioutil.WriteFile(path, []byte(content), 0600)
+		if err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	url, err := registryUtil.GetLocalRegistryURL(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return Registry{Name: "LocalRegistry", URL: url}, dir
+}
+
+func TestGetStackVersions(t *testing.T) {
+	registry, dir := mockVersionedLocalRegistry(t)
+	defer os.RemoveAll(dir)
+
+	versions, err := GetStackVersions(registry, "nodejs")
+	if err != nil {
+		t.Fatalf("GetStackVersions() unexpected error: %v", err)
+	}
+	var got []string
+	for _, version := range versions {
+		got = append(got, version.Version)
+	}
+	if want := []string{"1.10.0", "1.2.0", "1.0.0"}; !reflect.DeepEqual(got, want) {
+		t.Errorf("GetStackVersions() = %v, want %v", got, want)
+	}
+
+	tests := []struct {
+		name    string
+		version string
+		want    string
+		wantErr bool
+	}{
+		{name: "default version", version: "", want: "1.2.0"},
+		{name: "specified version", version: "1.0.0", want: "1.0.0"},
+		{name: "unknown version", version: "2.0.0", wantErr: true},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			version, err := GetStackVersion(registry, "nodejs", tt.version)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("GetStackVersion() unexpected error: %v, wantErr %v", err, tt.wantErr)
+			}
+			if version.Version != tt.want {
+				t.Errorf("GetStackVersion() = %s, want %s", version.Version, tt.want)
+			}
+		})
+	}
+
+	latest, err := GetLatestStackVersion(registry, "nodejs")
+	if err != nil || latest.Version != "1.10.0" {
+		t.Errorf("GetLatestStackVersion() = %s, %v, want 1.10.0", latest.Version, err)
+	}
+}
+
+func TestPullStackVersionFromLocalRegistry(t *testing.T) {
+	registry, dir := mockVersionedLocalRegistry(t)
+	defer os.RemoveAll(dir)
+
+	version, err := GetStackVersion(registry, "nodejs", "1.0.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileData, err := GetStackVersionDevfile(registry, "nodejs", version)
+	if err != nil {
+		t.Fatalf("GetStackVersionDevfile() unexpected error: %v", err)
+	}
+	if string(devfileData) != "schemaVersion: 2.0.0\nmetadata:\n  version: 1.0.0" {
+		t.Errorf("GetStackVersionDevfile() = %q", devfileData)
+	}
+
+	dest, err := ioutil.TempDir("", "odo-component")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dest)
+	err = PullStackVersion(registry, "nodejs", version, dest)
+	if err != nil {
+		t.Fatalf("PullStackVersion() unexpected error: %v", err)
+	}
+	pulled, err := ioutil.ReadFile(filepath.Join(dest, "devfile.yaml"))
+	if err != nil || string(pulled) != string(devfileData) {
+		t.Errorf("PullStackVersion() pulled %q, %v", pulled, err)
+	}
+}
+
+func TestPullStackVersionFromOCIRegistry(t *testing.T) {
+	dir := mockStacksDir(t)
+	defer os.RemoveAll(dir)
+	s, err := NewRegistryServer(dir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	server := httptest.NewServer(s)
+	defer server.Close()
+	registry := Registry{Name: "ServedRegistry", URL: server.URL}
+
+	// the stacks of a registry without versions only have their default version
+	versions, err := GetStackVersions(registry, "nodejs")
+	if err != nil {
+		t.Fatalf("GetStackVersions() unexpected error: %v", err)
+	}
+	if len(versions) != 1 || !versions[0].Default {
+		t.Fatalf("GetStackVersions() = %+v, want the default version only", versions)
+	}
+
+	dest, err := ioutil.TempDir("", "odo-component")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dest)
+	err = PullStackVersion(registry, "nodejs", versions[0], dest)
+	if err != nil {
+		t.Fatalf("PullStackVersion() unexpected error: %v", err)
+	}
+	if _, err := os.Stat(filepath.Join(dest, "devfile.yaml")); err != nil {
+		t.Errorf("the devfile has not been pulled: %v", err)
+	}
+}
+
+func TestStackLock(t *testing.T) {
+	registry, dir := mockVersionedLocalRegistry(t)
+	defer os.RemoveAll(dir)
+	contextDir, err := ioutil.TempDir("", "odo-component")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(contextDir)
+
+	lock, err := GetStackLock(contextDir)
+	if err != nil || lock != nil {
+		t.Fatalf("GetStackLock() = %v, %v, want no lock", lock, err)
+	}
+
+	version, err := GetStackVersion(registry, "nodejs", "1.2.0")
+	if err != nil {
+		t.Fatal(err)
+	}
+	devfileData, err := GetStackVersionDevfile(registry, "nodejs", version)
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := NewStackLock(registry, "nodejs", version, devfileData)
+	err = WriteStackLock(contextDir, want, devfileData)
+	if err != nil {
+		t.Fatalf("WriteStackLock() unexpected error: %v", err)
+	}
+
+	lock, err = GetStackLock(contextDir)
+	if err != nil {
+		t.Fatalf("GetStackLock() unexpected error: %v", err)
+	}
+	if !reflect.DeepEqual(*lock, want) {
+		t.Errorf("GetStackLock() = %+v, want %+v", *lock, want)
+	}
+
+	// the devfile of the stack is read from the registry when its copy is missing or modified
+	err = ioutil.WriteFile(filepath.Join(contextDir, stackLockDir, stackLockDevfile), []byte("modified"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	base, err := GetStackLockDevfile(contextDir, *lock)
+	if err != nil || string(base) != string(devfileData) {
+		t.Errorf("GetStackLockDevfile() = %q, %v, want %q", base, err, devfileData)
+	}
+
+	// the devfile of the version of the stack has changed in the registry
+	err = ioutil.WriteFile(filepath.Join(dir, "stacks", "nodejs", "1.2.0", "devfile.yaml"), []byte("changed"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err = GetStackLockDevfile(contextDir, *lock); err == nil {
+		t.Errorf("GetStackLockDevfile() expected an error for a devfile not matching the digest of the lock")
+	}
+}
+
+func TestMirrorRegistryStacksDefaultVersion(t *testing.T) {
+	registry, dir := mockVersionedLocalRegistry(t)
+	defer os.RemoveAll(dir)
+	mirrorDir, err := ioutil.TempDir("", "odo-mirror")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(mirrorDir)
+
+	index, err := MirrorRegistryStacks(registry, mirrorDir)
+	if err != nil {
+		t.Fatalf("MirrorRegistryStacks() unexpected error: %v", err)
+	}
+	want := []indexSchema.Schema{{
+		Name:        "nodejs",
+		Version:     "1.2.0",
+		DisplayName: "NodeJS Runtime",
+		Links:       map[string]string{"self": "devfile-catalog/nodejs:1.2.0"},
+		Resources:   []string{"devfile.yaml"},
+	}}
+	if !reflect.DeepEqual(index, want) {
+		t.Errorf("MirrorRegistryStacks() = %+v, want %+v", index, want)
+	}
+}
@@ -0,0 +1,140 @@
+package cleanup
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	applabels "github.com/openshift/odo/pkg/application/labels"
+	componentlabels "github.com/openshift/odo/pkg/component/labels"
+	"github.com/openshift/odo/pkg/kclient"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const apiVersion = "odo.dev/v1alpha1"
+
+// Orphan is a resource created by odo for a component which doesn't exist anymore
+type Orphan struct {
+	// Kind is the kind of the resource, like PersistentVolumeClaim or Volume for the Docker volumes
+	Kind string `json:"kind"`
+	Name string `json:"name"`
+	// Component and Application are the component and the application of the resource, from its labels
+	Component   string `json:"component,omitempty"`
+	Application string `json:"application,omitempty"`
+	// Reason explains why the resource is orphaned
+	Reason            string    `json:"reason"`
+	CreationTimestamp time.Time `json:"creationTimestamp,omitempty"`
+	// Size is the size of the storage resources, empty for the other resources
+	Size string `json:"size,omitempty"`
+}
+
+// OrphanList is a list of orphaned resources
+type OrphanList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Orphan `json:"items"`
+}
+
+// Client finds and deletes the orphaned resources of a backend
+type Client interface {
+	// ListOrphans returns the orphaned resources, sorted by kind and name
+	ListOrphans() ([]Orphan, error)
+	// DeleteOrphan deletes an orphaned resource returned by ListOrphans
+	DeleteOrphan(Orphan) error
+}
+
+// GetMachineReadableFormatForList returns the list of orphans in a machine readable format
+func GetMachineReadableFormatForList(orphans []Orphan) OrphanList {
+	if orphans == nil {
+		orphans = []Orphan{}
+	}
+	return OrphanList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: apiVersion,
+		},
+		Items: orphans,
+	}
+}
+
+// components are the components existing on a backend, and the UIDs of their Deployments or DeploymentConfigs
+type components struct {
+	names map[string]bool
+	uids  map[string]bool
+}
+
+// newComponents returns an empty set of components
+func newComponents() components {
+	return components{
+		names: map[string]bool{},
+		uids:  map[string]bool{},
+	}
+}
+
+// add adds the component of the Deployment or DeploymentConfig with the given metadata
+func (c components) add(meta metav1.Object) {
+	c.uids[string(meta.GetUID())] = true
+	labels := meta.GetLabels()
+	if name, ok := labels[componentlabels.ComponentLabel]; ok {
+		c.names[componentKey(name, labels[applabels.ApplicationLabel])] = true
+	}
+}
+
+// componentKey returns the key identifying the component of the application
+func componentKey(componentName, appName string) string {
+	return appName + "/" + componentName
+}
+
+// getOrphanReason returns why the resource with the given metadata is orphaned, or an empty string if it isn't.
+// A resource owned by a Deployment or a DeploymentConfig is orphaned when none of its owners exists.
+// A resource without owners is orphaned when it is managed by odo and its component doesn't exist
+func (c components) getOrphanReason(meta metav1.Object) string {
+	var missingOwners []string
+	for _, owner := range meta.GetOwnerReferences() {
+		if owner.Kind != kclient.DeploymentKind && owner.Kind != "DeploymentConfig" {
+			// the resource is managed by something else than a component
+			return ""
+		}
+		if c.uids[string(owner.UID)] {
+			return ""
+		}
+		missingOwners = append(missingOwners, fmt.Sprintf("%s %s", owner.Kind, owner.Name))
+	}
+	if len(missingOwners) > 0 {
+		return fmt.Sprintf("its owner %s doesn't exist", missingOwners[0])
+	}
+
+	labels := meta.GetLabels()
+	componentName, ok := labels[componentlabels.ComponentLabel]
+	if !ok || labels[applabels.ManagedBy] != "odo" {
+		return ""
+	}
+	appName := labels[applabels.ApplicationLabel]
+	if c.names[componentKey(componentName, appName)] {
+		return ""
+	}
+	return fmt.Sprintf("the component %s of the application %s doesn't exist", componentName, appName)
+}
+
+// newOrphan returns the orphan of the given kind with the given metadata
+func newOrphan(kind string, meta metav1.Object, reason string) Orphan {
+	labels := meta.GetLabels()
+	return Orphan{
+		Kind:              kind,
+		Name:              meta.GetName(),
+		Component:         labels[componentlabels.ComponentLabel],
+		Application:       labels[applabels.ApplicationLabel],
+		Reason:            reason,
+		CreationTimestamp: meta.GetCreationTimestamp().Time,
+	}
+}
+
+// sortOrphans sorts the orphans by kind and name
+func sortOrphans(orphans []Orphan) {
+	sort.SliceStable(orphans, func(i, j int) bool {
+		if orphans[i].Kind != orphans[j].Kind {
+			return orphans[i].Kind < orphans[j].Kind
+		}
+		return orphans[i].Name < orphans[j].Name
+	})
+}
@@ -0,0 +1,209 @@
+package cleanup
+
+import (
+	"reflect"
+	"testing"
+
+	applabels "github.com/openshift/odo/pkg/application/labels"
+	componentlabels "github.com/openshift/odo/pkg/component/labels"
+	odoFake "github.com/openshift/odo/pkg/kclient/fake"
+	"github.com/openshift/odo/pkg/lclient"
+	"github.com/openshift/odo/pkg/occlient"
+	"github.com/openshift/odo/pkg/testingutil"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	ktesting "k8s.io/client-go/testing"
+)
+
+func TestGetOrphanReason(t *testing.T) {
+	existing := newComponents()
+	existing.add(&metav1.ObjectMeta{
+		Name: "nodejs-app",
+		UID:  types.UID("1234"),
+		Labels: map[string]string{
+			componentlabels.ComponentLabel: "nodejs",
+			applabels.ApplicationLabel:     "app",
+		},
+	})
+
+	odoLabels := func(componentName, appName string) map[string]string {
+		return map[string]string{
+			componentlabels.ComponentLabel: componentName,
+			applabels.ApplicationLabel:     appName,
+			applabels.ManagedBy:            "odo",
+		}
+	}
+
+	tests := []struct {
+		name       string
+		meta       metav1.ObjectMeta
+		wantReason string
+	}{
+		{
+			name: "Case 1: owner Deployment exists",
+			meta: metav1.ObjectMeta{
+				Labels:          odoLabels("python", "app"),
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "nodejs-app", UID: types.UID("1234")}},
+			},
+		},
+		{
+			name: "Case 2: owner Deployment doesn't exist",
+			meta: metav1.ObjectMeta{
+				Labels:          odoLabels("nodejs", "app"),
+				OwnerReferences: []metav1.OwnerReference{{Kind: "Deployment", Name: "nodejs-app", UID: types.UID("5678")}},
+			},
+			wantReason: "its owner Deployment nodejs-app doesn't exist",
+		},
+		{
+			name: "Case 3: resource owned by something else than a component",
+			meta: metav1.ObjectMeta{
+				Labels:          odoLabels("python", "app"),
+				OwnerReferences: []metav1.OwnerReference{{Kind: "StatefulSet", Name: "mydb", UID: types.UID("5678")}},
+			},
+		},
+		{
+			name: "Case 4: component of the labels exists",
+			meta: metav1.ObjectMeta{Labels: odoLabels("nodejs", "app")},
+		},
+		{
+			name:       "Case 5: component of the labels doesn't exist",
+			meta:       metav1.ObjectMeta{Labels: odoLabels("python", "app")},
+			wantReason: "the component python of the application app doesn't exist",
+		},
+		{
+			name:       "Case 6: component exists in another application",
+			meta:       metav1.ObjectMeta{Labels: odoLabels("nodejs", "otherapp")},
+			wantReason: "the component nodejs of the application otherapp doesn't exist",
+		},
+		{
+			name: "Case 7: resource not managed by odo",
+			meta: metav1.ObjectMeta{
+				Labels: map[string]string{
+					componentlabels.ComponentLabel: "python",
+					applabels.ApplicationLabel:     "app",
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reason := existing.getOrphanReason(&tt.meta)
+			if reason != tt.wantReason {
+				t.Errorf("getOrphanReason() = %q, want %q", reason, tt.wantReason)
+			}
+		})
+	}
+}
+
+func TestDockerListOrphans(t *testing.T) {
+	client := NewDockerClient(lclient.FakeNew())
+
+	orphans, err := client.ListOrphans()
+	if err != nil {
+		t.Fatalf("ListOrphans() unexpected error %v", err)
+	}
+
+	// the volume labelled with the java component only has not been created by odo, it is not listed
+	want := []Orphan{
+		{Kind: volumeKind, Name: "odo-project-source-duplicate1", Component: "duplicate", Reason: "the component duplicate has no containers"},
+		{Kind: volumeKind, Name: "odo-project-source-duplicate2", Component: "duplicate", Reason: "the component duplicate has no containers"},
+	}
+	if !reflect.DeepEqual(orphans, want) {
+		t.Errorf("ListOrphans() = %#v, want %#v", orphans, want)
+	}
+
+	if err := client.DeleteOrphan(Orphan{Kind: pvcKind, Name: "mypvc"}); err == nil {
+		t.Errorf("DeleteOrphan() expected an error for a PVC")
+	}
+}
+
+func TestIsOdoVolume(t *testing.T) {
+	tests := []struct {
+		name   string
+		labels map[string]string
+		want   bool
+	}{
+		{name: "project source volume", labels: map[string]string{"component": "nodejs", "type": "projects"}, want: true},
+		{name: "supervisord volume", labels: map[string]string{"component": "nodejs", "type": "supervisord"}, want: true},
+		{name: "storage volume", labels: map[string]string{"component": "nodejs", "storage-name": "mydb"}, want: true},
+		{name: "volume of another tool with a component label", labels: map[string]string{"component": "nodejs"}, want: false},
+		{name: "volume of another tool with another type", labels: map[string]string{"component": "nodejs", "type": "cache"}, want: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := isOdoVolume(tt.labels); got != tt.want {
+				t.Errorf("isOdoVolume() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestKubernetesListOrphans(t *testing.T) {
+	client, fakeClientSet := occlient.FakeNew()
+	client.Namespace = "default"
+	client.GetKubeClient().Namespace = "default"
+	client.GetKubeClient().SetDiscoveryInterface(odoFake.NewFakeDiscovery())
+
+	deployment := testingutil.CreateFakeDeployment("nodejs")
+	deployment.UID = types.UID("1234")
+	deployment.Labels[applabels.ApplicationLabel] = "app"
+
+	odoLabels := func(componentName string) map[string]string {
+		return map[string]string{
+			componentlabels.ComponentLabel: componentName,
+			applabels.ApplicationLabel:     "app",
+			applabels.ManagedBy:            "odo",
+		}
+	}
+	ownedPVC := testingutil.FakePVC("mydb-nodejs-pvc", "1Gi", odoLabels("nodejs"))
+	ownedPVC.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: "nodejs", UID: deployment.UID}}
+	orphanedPVC := testingutil.FakePVC("mydb-python-pvc", "2Gi", odoLabels("python"))
+	orphanedPVC.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: "python", UID: types.UID("5678")}}
+	orphanedSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "python-tlssecret", Labels: odoLabels("python")},
+		Type:       corev1.SecretTypeTLS,
+	}
+	opaqueSecret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "python-secret", Labels: odoLabels("python")},
+		Type:       corev1.SecretTypeOpaque,
+	}
+
+	fakeClientSet.Kubernetes.PrependReactor("list", "deployments", func(action ktesting.Action) (bool, runtime.Object, error) {
+		return true, &appsv1.DeploymentList{Items: []appsv1.Deployment{*deployment}}, nil
+	})
+	fakeClientSet.Kubernetes.PrependReactor("list", "persistentvolumeclaims", func(action ktesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.PersistentVolumeClaimList{Items: []corev1.PersistentVolumeClaim{*ownedPVC, *orphanedPVC}}, nil
+	})
+	fakeClientSet.Kubernetes.PrependReactor("list", "secrets", func(action ktesting.Action) (bool, runtime.Object, error) {
+		return true, &corev1.SecretList{Items: []corev1.Secret{*orphanedSecret, *opaqueSecret}}, nil
+	})
+
+	orphans, err := NewKubernetesClient(client).ListOrphans()
+	if err != nil {
+		t.Fatalf("ListOrphans() unexpected error %v", err)
+	}
+
+	want := []Orphan{
+		{
+			Kind:        pvcKind,
+			Name:        "mydb-python-pvc",
+			Component:   "python",
+			Application: "app",
+			Reason:      "its owner Deployment python doesn't exist",
+			Size:        "2Gi",
+		},
+		{
+			Kind:        secretKind,
+			Name:        "python-tlssecret",
+			Component:   "python",
+			Application: "app",
+			Reason:      "the component python of the application app doesn't exist",
+		},
+	}
+	if !reflect.DeepEqual(orphans, want) {
+		t.Errorf("ListOrphans() = %#v, want %#v", orphans, want)
+	}
+}
@@ -0,0 +1,87 @@
+package cleanup
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/docker/go-units"
+	"github.com/openshift/odo/pkg/devfile/adapters/docker/utils"
+	"github.com/openshift/odo/pkg/lclient"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// volumeKind is the kind of the orphaned Docker volumes
+const volumeKind = "Volume"
+
+// dockerClient finds the orphaned volumes of the Docker daemon
+type dockerClient struct {
+	client *lclient.Client
+}
+
+// NewDockerClient returns the client finding the orphaned volumes of the Docker daemon
+func NewDockerClient(client *lclient.Client) Client {
+	return dockerClient{client: client}
+}
+
+// ListOrphans returns the volumes of the components which have no containers anymore
+func (d dockerClient) ListOrphans() ([]Orphan, error) {
+	containers, err := d.client.GetContainerList(true)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list the Docker containers")
+	}
+	existing := map[string]bool{}
+	for _, container := range containers {
+		if componentName, ok := container.Labels["component"]; ok {
+			existing[componentName] = true
+		}
+	}
+
+	volumes, err := d.client.GetVolumes()
+	if err != nil {
+		return nil, err
+	}
+	var orphans []Orphan
+	for _, volume := range volumes {
+		componentName, ok := volume.Labels["component"]
+		if !ok || !isOdoVolume(volume.Labels) || existing[componentName] {
+			continue
+		}
+		orphan := Orphan{
+			Kind:      volumeKind,
+			Name:      volume.Name,
+			Component: componentName,
+			Reason:    fmt.Sprintf("the component %s has no containers", componentName),
+		}
+		if createdAt, err := time.Parse(time.RFC3339, volume.CreatedAt); err == nil {
+			orphan.CreationTimestamp = createdAt
+		}
+		if volume.UsageData != nil && volume.UsageData.Size >= 0 {
+			orphan.Size = units.BytesSize(float64(volume.UsageData.Size))
+		}
+		orphans = append(orphans, orphan)
+	}
+
+	sortOrphans(orphans)
+	return orphans, nil
+}
+
+// isOdoVolume returns true if the labels of the volume are the ones odo sets on the volumes it creates for the components,
+// the storage, project source and supervisord volumes, to leave alone the volumes of the other tools
+func isOdoVolume(labels map[string]string) bool {
+	if strings.TrimSpace(labels["storage-name"]) != "" {
+		return true
+	}
+	volumeType := labels["type"]
+	return volumeType == utils.ProjectsVolume || volumeType == utils.SupervisordVolume
+}
+
+// DeleteOrphan deletes the orphaned volume
+func (d dockerClient) DeleteOrphan(orphan Orphan) error {
+	if orphan.Kind != volumeKind {
+		return fmt.Errorf("unable to delete the %s %s, this kind of resource is not supported", orphan.Kind, orphan.Name)
+	}
+	klog.V(3).Infof("Deleting the orphaned volume %s", orphan.Name)
+	return d.client.RemoveVolume(orphan.Name)
+}
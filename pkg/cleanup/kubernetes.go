@@ -0,0 +1,159 @@
+package cleanup
+
+import (
+	"fmt"
+
+	applabels "github.com/openshift/odo/pkg/application/labels"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/occlient"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+// kinds of the orphaned Kubernetes resources
+const (
+	pvcKind            = kclient.PersistentVolumeClaimKind
+	ingressKind        = "Ingress"
+	secretKind         = "Secret"
+	serviceBindingKind = kclient.ServiceBindingKind
+)
+
+// kubernetesClient finds the orphaned resources of the current namespace of the cluster
+type kubernetesClient struct {
+	client *occlient.Client
+}
+
+// NewKubernetesClient returns the client finding the orphaned resources of the current namespace of the cluster
+func NewKubernetesClient(client *occlient.Client) Client {
+	return kubernetesClient{client: client}
+}
+
+// ListOrphans returns the PVCs, Ingresses, TLS secrets and ServiceBindings belonging to no existing component
+func (k kubernetesClient) ListOrphans() ([]Orphan, error) {
+	existing, err := k.getComponents()
+	if err != nil {
+		return nil, err
+	}
+
+	odoSelector := util.ConvertLabelsToSelector(map[string]string{applabels.ManagedBy: "odo"})
+	var orphans []Orphan
+
+	pvcs, err := k.client.GetKubeClient().ListPVCs(odoSelector)
+	if err != nil {
+		return nil, err
+	}
+	for i := range pvcs {
+		if reason := existing.getOrphanReason(&pvcs[i]); reason != "" {
+			orphan := newOrphan(pvcKind, &pvcs[i], reason)
+			if size, ok := pvcs[i].Spec.Resources.Requests[corev1.ResourceStorage]; ok {
+				orphan.Size = size.String()
+			}
+			orphans = append(orphans, orphan)
+		}
+	}
+
+	ingresses, err := k.client.GetKubeClient().ListIngresses(odoSelector)
+	if err != nil {
+		// the ingresses are not supported by all the clusters
+		klog.V(3).Infof("unable to list the ingresses: %v", err)
+	} else {
+		for _, ingress := range ingresses.Items {
+			var meta metav1.Object
+			if ingress.NetworkingV1Ingress != nil {
+				meta = ingress.NetworkingV1Ingress
+			} else if ingress.ExtensionV1Beta1Ingress != nil {
+				meta = ingress.ExtensionV1Beta1Ingress
+			} else {
+				continue
+			}
+			if reason := existing.getOrphanReason(meta); reason != "" {
+				orphans = append(orphans, newOrphan(ingressKind, meta, reason))
+			}
+		}
+	}
+
+	secrets, err := k.client.GetKubeClient().ListSecrets(odoSelector)
+	if err != nil {
+		return nil, err
+	}
+	for i := range secrets {
+		if secrets[i].Type != corev1.SecretTypeTLS {
+			continue
+		}
+		if reason := existing.getOrphanReason(&secrets[i]); reason != "" {
+			orphans = append(orphans, newOrphan(secretKind, &secrets[i], reason))
+		}
+	}
+
+	supported, err := k.client.GetKubeClient().IsServiceBindingSupported()
+	if err != nil {
+		return nil, err
+	}
+	if supported {
+		// the ServiceBindings are not labelled, they are orphaned when their owner Deployment doesn't exist
+		serviceBindings, err := k.client.GetKubeClient().ListDynamicResource(kclient.ServiceBindingGroup, kclient.ServiceBindingVersion, kclient.ServiceBindingResource)
+		if err != nil {
+			return nil, errors.Wrap(err, "unable to list the ServiceBindings")
+		}
+		if serviceBindings != nil {
+			for i := range serviceBindings.Items {
+				if len(serviceBindings.Items[i].GetOwnerReferences()) == 0 {
+					continue
+				}
+				if reason := existing.getOrphanReason(&serviceBindings.Items[i]); reason != "" {
+					orphans = append(orphans, newOrphan(serviceBindingKind, &serviceBindings.Items[i], reason))
+				}
+			}
+		}
+	}
+
+	sortOrphans(orphans)
+	return orphans, nil
+}
+
+// DeleteOrphan deletes the orphaned resource
+func (k kubernetesClient) DeleteOrphan(orphan Orphan) error {
+	klog.V(3).Infof("Deleting the orphaned %s %s", orphan.Kind, orphan.Name)
+	switch orphan.Kind {
+	case pvcKind:
+		return k.client.GetKubeClient().DeletePVC(orphan.Name)
+	case ingressKind:
+		return k.client.GetKubeClient().DeleteIngress(orphan.Name)
+	case secretKind:
+		return k.client.GetKubeClient().DeleteSecret(orphan.Name)
+	case serviceBindingKind:
+		return k.client.GetKubeClient().DeleteDynamicResource(orphan.Name, kclient.ServiceBindingGroup, kclient.ServiceBindingVersion, kclient.ServiceBindingResource)
+	}
+	return fmt.Errorf("unable to delete the %s %s, this kind of resource is not supported", orphan.Kind, orphan.Name)
+}
+
+// getComponents returns the components of the namespace, from their Deployments and DeploymentConfigs
+func (k kubernetesClient) getComponents() (components, error) {
+	existing := newComponents()
+
+	deployments, err := k.client.GetKubeClient().GetDeploymentFromSelector("")
+	if err != nil {
+		return existing, errors.Wrap(err, "unable to list the Deployments")
+	}
+	for i := range deployments {
+		existing.add(&deployments[i])
+	}
+
+	supported, err := k.client.IsDeploymentConfigSupported()
+	if err != nil {
+		return existing, err
+	}
+	if supported {
+		deploymentConfigs, err := k.client.ListDeploymentConfigs("")
+		if err != nil {
+			return existing, err
+		}
+		for i := range deploymentConfigs {
+			existing.add(&deploymentConfigs[i])
+		}
+	}
+	return existing, nil
+}
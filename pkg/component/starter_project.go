@@ -1,16 +1,21 @@
 package component
 
 import (
+	"fmt"
 	"io/ioutil"
+	"net/url"
 	"os"
 	"path/filepath"
+	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 
 	"github.com/go-git/go-git/v5"
 	"github.com/go-git/go-git/v5/plumbing"
+	"github.com/go-git/go-git/v5/plumbing/transport"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
+	"github.com/go-git/go-git/v5/plumbing/transport/ssh"
 	"github.com/openshift/odo/pkg/log"
 	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
 	"github.com/openshift/odo/pkg/util"
@@ -25,18 +30,6 @@ const (
 
 var DevfilePath = filepath.Join("./", devFile)
 
-func checkoutProject(subDir, zipURL, path, starterToken string) error {
-
-	if subDir == "" {
-		subDir = "/"
-	}
-	err := util.GetAndExtractZip(zipURL, path, subDir, starterToken)
-	if err != nil {
-		return errors.Wrap(err, "failed to download and extract project zip folder")
-	}
-	return nil
-}
-
 // GetStarterProject gets starter project value from flag --starter.
 func GetStarterProject(projects []devfilev1.StarterProject, projectPassed string) (project *devfilev1.StarterProject, err error) {
 
@@ -71,8 +64,9 @@ func GetStarterProject(projects []devfilev1.StarterProject, projectPassed string
 
 }
 
-// DownloadStarterProject Downloads first starter project from list of starter projects in devfile
-func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, contextDir string) error {
+// DownloadStarterProject downloads the starter project into the context directory, or the current directory if empty.
+// An error is returned if the files of the starter project would overwrite existing files, unless force is true
+func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, contextDir string, force bool) error {
 	var path string
 	var err error
 	// Retrieve the working directory in order to clone correctly
@@ -85,38 +79,200 @@ func DownloadStarterProject(starterProject *devfilev1.StarterProject, decryptedT
 		path = contextDir
 	}
 
-	// We will check to see if the project has a valid directory
-	err = util.IsValidProjectDir(path, DevfilePath)
+	log.Info("\nStarter Project")
+
+	tmpDir, err := ioutil.TempDir("", "odo-starter-project")
 	if err != nil {
 		return err
 	}
+	defer os.RemoveAll(tmpDir)
 
-	log.Info("\nStarter Project")
+	projectDir, err := fetchStarterProject(starterProject, decryptedToken, path, tmpDir)
+	if err != nil {
+		return err
+	}
+
+	conflicts, err := getConflictingFiles(projectDir, path)
+	if err != nil {
+		return err
+	}
+	if len(conflicts) > 0 {
+		if !force {
+			return errors.Errorf("the starter project %s would overwrite the existing files %s of %s, use --force to overwrite them", starterProject.Name, formatFileList(conflicts), path)
+		}
+		log.Warningf("Overwriting the existing files %s with the files of the starter project %s", formatFileList(conflicts), starterProject.Name)
+	}
+
+	return copyStarterProject(projectDir, path)
+}
+
+// ArchiveStarterProject downloads the starter project and archives it into the zip file, which can be used
+// as the location of a zip starter project in place of the original source of the starter project
+func ArchiveStarterProject(starterProject *devfilev1.StarterProject, decryptedToken string, archivePath string) error {
+	tmpDir, err := ioutil.TempDir("", "odo-starter-project")
+	if err != nil {
+		return err
+	}
+	defer os.RemoveAll(tmpDir)
 
-	if starterProject.Git != nil {
-		err := downloadGitProject(starterProject, decryptedToken, path)
+	projectDir, err := fetchStarterProject(starterProject, decryptedToken, "", tmpDir)
+	if err != nil {
+		return err
+	}
 
+	err = os.MkdirAll(filepath.Dir(archivePath), os.ModePerm)
+	if err != nil {
+		return err
+	}
+	return util.Zip(projectDir, archivePath, starterProject.Name)
+}
+
+// fetchStarterProject downloads the starter project into the temporary directory, and returns the directory containing
+// the files of the project, its sub directory if specified. The local paths of the zip starter projects are relative
+// to the context directory
+func fetchStarterProject(starterProject *devfilev1.StarterProject, starterToken, contextDir, tmpDir string) (string, error) {
+	var projectDir string
+	switch {
+	case starterProject.Git != nil:
+		projectDir = filepath.Join(tmpDir, "git")
+		err := downloadGitProject(starterProject, starterToken, projectDir)
 		if err != nil {
-			return err
+			return "", err
+		}
+		if starterProject.SubDir == "" {
+			return projectDir, nil
+		}
+
+	case starterProject.Zip != nil:
+		location := starterProject.Zip.Location
+		if location == "" {
+			return "", errors.Errorf("the location of the zip starter project %s is empty", starterProject.Name)
+		}
+		if !strings.Contains(location, "://") {
+			// local zip file or directory
+			if !filepath.IsAbs(location) {
+				location = filepath.Join(contextDir, location)
+			}
+			info, err := os.Stat(location)
+			if err != nil {
+				return "", errors.Wrapf(err, "unable to find the starter project %s", starterProject.Name)
+			}
+			if info.IsDir() {
+				projectDir = location
+				break
+			}
+			location, err = filepath.Abs(location)
+			if err != nil {
+				return "", err
+			}
+			location = "file://" + filepath.ToSlash(location)
 		}
 
-	} else if starterProject.Zip != nil {
-		url := starterProject.Zip.Location
-		sparseDir := starterProject.SubDir
-		downloadSpinner := log.Spinnerf("Downloading starter project %s from %s", starterProject.Name, url)
-		err := checkoutProject(sparseDir, url, path, decryptedToken)
+		projectDir = filepath.Join(tmpDir, "zip")
+		downloadSpinner := log.Spinnerf("Downloading starter project %s from %s", starterProject.Name, starterProject.Zip.Location)
+		err := extractZipProject(starterProject.SubDir, location, projectDir, starterToken)
 		if err != nil {
 			downloadSpinner.End(false)
-			return err
+			return "", err
 		}
 		downloadSpinner.End(true)
-	} else {
-		return errors.Errorf("Project type not supported")
+		// the sub directory has been extracted only
+		return projectDir, nil
+
+	default:
+		return "", errors.Errorf("Project type not supported")
 	}
 
+	// the sub directory must be inside the project
+	subDir := filepath.Join(projectDir, filepath.FromSlash(starterProject.SubDir))
+	if rel, err := filepath.Rel(projectDir, subDir); err != nil || strings.HasPrefix(rel, "..") {
+		return "", errors.Errorf("the sub directory %s of the starter project %s is outside of the project", starterProject.SubDir, starterProject.Name)
+	}
+	info, err := os.Stat(subDir)
+	if err != nil || !info.IsDir() {
+		return "", errors.Errorf("the sub directory %s of the starter project %s doesn't exist", starterProject.SubDir, starterProject.Name)
+	}
+	return subDir, nil
+}
+
+// extractZipProject downloads the zip file and extracts the sub directory of the project into the directory
+func extractZipProject(subDir, zipURL, path, starterToken string) error {
+	if subDir == "" {
+		subDir = "/"
+	}
+	err := util.GetAndExtractZip(zipURL, path, subDir, starterToken)
+	if err != nil {
+		return errors.Wrap(err, "failed to download and extract project zip folder")
+	}
 	return nil
 }
 
+// isStarterDevfile indicates if the file of a starter project is its devfile, which is never copied
+// as the devfile of the component is written after the starter project
+func isStarterDevfile(rel string) bool {
+	return rel == devFile || rel == "."+devFile
+}
+
+// getConflictingFiles returns the files of the starter project which already exist in the destination directory
+func getConflictingFiles(projectDir, destDir string) ([]string, error) {
+	var conflicts []string
+	err := filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			return err
+		}
+		if info.IsDir() || isStarterDevfile(rel) {
+			return nil
+		}
+		if _, err := os.Lstat(filepath.Join(destDir, rel)); err == nil {
+			conflicts = append(conflicts, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	return conflicts, err
+}
+
+// copyStarterProject copies the files of the starter project into the destination directory
+func copyStarterProject(projectDir, destDir string) error {
+	return filepath.Walk(projectDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(projectDir, path)
+		if err != nil {
+			return err
+		}
+		target := filepath.Join(destDir, rel)
+		switch {
+		case info.IsDir():
+			return os.MkdirAll(target, os.ModePerm)
+		case isStarterDevfile(rel):
+			return nil
+		case info.Mode()&os.ModeSymlink != 0:
+			link, err := os.Readlink(path)
+			if err != nil {
+				return err
+			}
+			_ = os.Remove(target)
+			return os.Symlink(link, target)
+		default:
+			return util.CopyFile(path, target, info)
+		}
+	})
+}
+
+// formatFileList formats the list of files for the messages, limited to the first files
+func formatFileList(files []string) string {
+	const maxFiles = 5
+	if len(files) > maxFiles {
+		return fmt.Sprintf("%s and %d more", strings.Join(files[:maxFiles], ", "), len(files)-maxFiles)
+	}
+	return strings.Join(files, ", ")
+}
+
 // downloadGitProject downloads the git starter projects from devfile.yaml
 func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken, path string) error {
 	remoteName, remoteUrl, revision, err := parsercommon.GetDefaultSource(starterProject.Git.GitLikeProjectSource)
@@ -124,68 +280,51 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 		return errors.Wrapf(err, "unable to get default project source for starter project %s", starterProject.Name)
 	}
 
-	// convert revision to referenceName type, ref name could be a branch or tag
-	// if revision is not specified it would be the default branch of the project
-	refName := plumbing.ReferenceName(revision)
-
-	if plumbing.IsHash(revision) {
-		// Specifying commit in the reference name is not supported by the go-git library
-		// while doing git.PlainClone()
-		log.Warning("Specifying commit in 'revision' is not yet supported in odo.")
-		// overriding revision to empty as we do not support this
-		revision = ""
-	}
-
-	if revision != "" {
-		// lets consider revision to be a branch name first
-		refName = plumbing.NewBranchReferenceName(revision)
+	auth, err := getGitAuth(remoteUrl, starterToken)
+	if err != nil {
+		return err
 	}
 
 	downloadSpinner := log.Spinnerf("Downloading starter project %s from %s", starterProject.Name, remoteUrl)
 	defer downloadSpinner.End(false)
 
-	cloneOptions := &git.CloneOptions{
-		URL:           remoteUrl,
-		RemoteName:    remoteName,
-		ReferenceName: refName,
-		SingleBranch:  true,
-		// we don't need history for starter projects
-		Depth: 1,
-	}
-
-	if starterToken != "" {
-		cloneOptions.Auth = &http.BasicAuth{
-			Username: registryUtil.RegistryUser,
-			Password: starterToken,
-		}
-	}
-
-	originalPath := ""
-	if starterProject.SubDir != "" {
-		originalPath = path
-		path, err = ioutil.TempDir("", "")
+	if plumbing.IsHash(revision) {
+		// go-git can't clone a commit directly, the repository is cloned and the commit checked out
+		err = cloneGitCommit(path, remoteName, remoteUrl, revision, auth)
 		if err != nil {
 			return err
 		}
-	}
-
-	_, err = git.PlainClone(path, false, cloneOptions)
-
-	if err != nil {
-
-		// it returns the following error if no matching ref found
-		// if we get this error, we are trying again considering revision as tag, only if revision is specified.
-		if _, ok := err.(git.NoMatchingRefSpecError); !ok || revision == "" {
-			return err
+	} else {
+		cloneOptions := &git.CloneOptions{
+			URL:        remoteUrl,
+			RemoteName: remoteName,
+			Auth:       auth,
+			// if revision is not specified it would be the default branch of the project
+			SingleBranch: true,
+			// we don't need history for starter projects
+			Depth: 1,
+		}
+		if revision != "" {
+			// lets consider revision to be a branch name first
+			cloneOptions.ReferenceName = plumbing.NewBranchReferenceName(revision)
 		}
 
-		// try again to consider revision as tag name
-		cloneOptions.ReferenceName = plumbing.NewTagReferenceName(revision)
-		// remove if any .git folder downloaded in above try
-		_ = os.RemoveAll(filepath.Join(path, ".git"))
 		_, err = git.PlainClone(path, false, cloneOptions)
 		if err != nil {
-			return err
+			// it returns the following error if no matching ref found
+			// if we get this error, we are trying again considering revision as tag, only if revision is specified.
+			if _, ok := err.(git.NoMatchingRefSpecError); !ok || revision == "" {
+				return err
+			}
+
+			// try again to consider revision as tag name
+			cloneOptions.ReferenceName = plumbing.NewTagReferenceName(revision)
+			// remove if any .git folder downloaded in above try
+			_ = os.RemoveAll(filepath.Join(path, ".git"))
+			_, err = git.PlainClone(path, false, cloneOptions)
+			if err != nil {
+				return err
+			}
 		}
 	}
 
@@ -195,16 +334,72 @@ func downloadGitProject(starterProject *devfilev1.StarterProject, starterToken,
 		// we don't need to return (fail) if this happens
 		log.Warning("Unable to delete .git from cloned starter project")
 	}
+	downloadSpinner.End(true)
+
+	return nil
+}
 
-	if starterProject.SubDir != "" {
-		err = util.GitSubDir(path, originalPath,
-			starterProject.SubDir)
+// cloneGitCommit clones the repository and checks out the commit
+func cloneGitCommit(path, remoteName, remoteURL, commit string, auth transport.AuthMethod) error {
+	repository, err := git.PlainClone(path, false, &git.CloneOptions{
+		URL:        remoteURL,
+		RemoteName: remoteName,
+		Auth:       auth,
+		NoCheckout: true,
+	})
+	if err != nil {
+		return err
+	}
+	worktree, err := repository.Worktree()
+	if err != nil {
+		return err
+	}
+	err = worktree.Checkout(&git.CheckoutOptions{Hash: plumbing.NewHash(commit)})
+	if err != nil {
+		return errors.Wrapf(err, "unable to checkout the commit %s", commit)
+	}
+	return nil
+}
+
+// getGitAuth returns the authentication for the git remote: the SSH agent for the SSH remotes,
+// and the token for the HTTP remotes if specified
+func getGitAuth(remoteURL, starterToken string) (transport.AuthMethod, error) {
+	if user, ok := getSSHUser(remoteURL); ok {
+		auth, err := ssh.NewSSHAgentAuth(user)
 		if err != nil {
-			return err
+			return nil, errors.Wrapf(err, "unable to use the SSH agent to authenticate to %s, please make sure the agent is running and SSH_AUTH_SOCK is set", remoteURL)
 		}
+		return auth, nil
 	}
-	downloadSpinner.End(true)
-
-	return nil
+	if starterToken != "" {
+		return &http.BasicAuth{
+			Username: registryUtil.RegistryUser,
+			Password: starterToken,
+		}, nil
+	}
+	return nil, nil
+}
 
+// getSSHUser returns the user of a SSH git remote, like ssh://git@example.com/repo.git or git@example.com:repo.git,
+// and false if the remote doesn't use SSH
+func getSSHUser(remoteURL string) (string, bool) {
+	if strings.HasPrefix(remoteURL, "ssh://") {
+		u, err := url.Parse(remoteURL)
+		if err != nil || u.User == nil || u.User.Username() == "" {
+			return ssh.DefaultUsername, true
+		}
+		return u.User.Username(), true
+	}
+	if strings.Contains(remoteURL, "://") {
+		return "", false
+	}
+	// scp-like syntax: [user@]host:path, the host being before the first colon and slash
+	colon := strings.Index(remoteURL, ":")
+	if colon <= 0 || strings.Contains(remoteURL[:colon], "/") {
+		return "", false
+	}
+	if at := strings.Index(remoteURL[:colon], "@"); at > 0 {
+		return remoteURL[:at], true
+	}
+	return ssh.DefaultUsername, true
 }
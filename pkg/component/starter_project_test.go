@@ -0,0 +1,225 @@
+package component
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/go-git/go-git/v5"
+	"github.com/go-git/go-git/v5/plumbing/object"
+	"github.com/openshift/odo/pkg/util"
+)
+
+// writeFiles writes the files in the directory
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	for name, content := range files {
+		path := filepath.Join(dir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// readFiles returns the files of the directory with their content
+func readFiles(t *testing.T, dir string) map[string]string {
+	files := map[string]string{}
+	err := filepath.Walk(dir, func(path string, info os.FileInfo, err error) error {
+		if err != nil || info.IsDir() {
+			return err
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		rel, _ := filepath.Rel(dir, path)
+		files[filepath.ToSlash(rel)] = string(content)
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	return files
+}
+
+func tempDir(t *testing.T) string {
+	dir, err := ioutil.TempDir("", "odo-starter-project-test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	return dir
+}
+
+func TestDownloadStarterProject(t *testing.T) {
+	sourceDir := tempDir(t)
+	defer os.RemoveAll(sourceDir)
+	writeFiles(t, sourceDir, map[string]string{
+		"devfile.yaml":           "schemaVersion: 2.0.0",
+		"README.md":              "root",
+		"backend/devfile.yaml":   "schemaVersion: 2.0.0",
+		"backend/server.js":      "server",
+		"backend/lib/handler.js": "handler",
+	})
+	archiveDir := tempDir(t)
+	defer os.RemoveAll(archiveDir)
+	archive := filepath.Join(archiveDir, "starter.zip")
+	if err := util.Zip(sourceDir, archive, "starter"); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name      string
+		project   devfilev1.StarterProject
+		existing  map[string]string
+		force     bool
+		wantFiles map[string]string
+		wantErr   string
+	}{
+		{
+			name:    "local directory with a sub directory",
+			project: devfilev1.StarterProject{Name: "local", SubDir: "backend", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: sourceDir}}},
+			wantFiles: map[string]string{
+				"server.js":      "server",
+				"lib/handler.js": "handler",
+			},
+		},
+		{
+			name:    "local zip archive, the devfile of the starter project is not copied",
+			project: devfilev1.StarterProject{Name: "archive", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: archive}}},
+			wantFiles: map[string]string{
+				"README.md":              "root",
+				"backend/server.js":      "server",
+				"backend/lib/handler.js": "handler",
+				"backend/devfile.yaml":   "schemaVersion: 2.0.0",
+			},
+		},
+		{
+			name:     "existing files are not overwritten",
+			project:  devfilev1.StarterProject{Name: "local", SubDir: "backend", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: sourceDir}}},
+			existing: map[string]string{"server.js": "mine", "devfile.yaml": "mine"},
+			wantFiles: map[string]string{
+				"server.js":    "mine",
+				"devfile.yaml": "mine",
+			},
+			wantErr: "would overwrite the existing files server.js",
+		},
+		{
+			name:     "existing files are overwritten with force",
+			project:  devfilev1.StarterProject{Name: "local", SubDir: "backend", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: sourceDir}}},
+			existing: map[string]string{"server.js": "mine", "devfile.yaml": "mine"},
+			force:    true,
+			wantFiles: map[string]string{
+				"server.js":      "server",
+				"lib/handler.js": "handler",
+				"devfile.yaml":   "mine",
+			},
+		},
+		{
+			name:      "sub directory outside of the project",
+			project:   devfilev1.StarterProject{Name: "local", SubDir: "../", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: sourceDir}}},
+			wantFiles: map[string]string{},
+			wantErr:   "outside of the project",
+		},
+		{
+			name:      "missing sub directory",
+			project:   devfilev1.StarterProject{Name: "local", SubDir: "frontend", ProjectSource: devfilev1.ProjectSource{Zip: &devfilev1.ZipProjectSource{Location: sourceDir}}},
+			wantFiles: map[string]string{},
+			wantErr:   "doesn't exist",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			contextDir := tempDir(t)
+			defer os.RemoveAll(contextDir)
+			writeFiles(t, contextDir, tt.existing)
+
+			err := DownloadStarterProject(&tt.project, "", contextDir, tt.force)
+			if tt.wantErr == "" && err != nil {
+				t.Fatalf("DownloadStarterProject() unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || !strings.Contains(err.Error(), tt.wantErr)) {
+				t.Fatalf("DownloadStarterProject() error = %v, want %q", err, tt.wantErr)
+			}
+			if got := readFiles(t, contextDir); !reflect.DeepEqual(got, tt.wantFiles) {
+				t.Errorf("DownloadStarterProject() files = %v, want %v", got, tt.wantFiles)
+			}
+		})
+	}
+}
+
+func TestDownloadGitProjectCommit(t *testing.T) {
+	repoDir := tempDir(t)
+	defer os.RemoveAll(repoDir)
+	repository, err := git.PlainInit(repoDir, false)
+	if err != nil {
+		t.Fatal(err)
+	}
+	worktree, err := repository.Worktree()
+	if err != nil {
+		t.Fatal(err)
+	}
+	commit := func(content string) string {
+		writeFiles(t, repoDir, map[string]string{"app/main.go": content})
+		if _, err := worktree.Add("app/main.go"); err != nil {
+			t.Fatal(err)
+		}
+		hash, err := worktree.Commit(content, &git.CommitOptions{Author: &object.Signature{Name: "odo", Email: "odo@example.com", When: time.Now()}})
+		if err != nil {
+			t.Fatal(err)
+		}
+		return hash.String()
+	}
+	first := commit("first")
+	commit("second")
+
+	contextDir := tempDir(t)
+	defer os.RemoveAll(contextDir)
+	starterProject := devfilev1.StarterProject{
+		Name:   "git",
+		SubDir: "app",
+		ProjectSource: devfilev1.ProjectSource{Git: &devfilev1.GitProjectSource{GitLikeProjectSource: devfilev1.GitLikeProjectSource{
+			Remotes:      map[string]string{"origin": repoDir},
+			CheckoutFrom: &devfilev1.CheckoutFrom{Remote: "origin", Revision: first},
+		}}},
+	}
+	err = DownloadStarterProject(&starterProject, "", contextDir, false)
+	if err != nil {
+		t.Fatalf("DownloadStarterProject() unexpected error: %v", err)
+	}
+	want := map[string]string{"main.go": "first"}
+	if got := readFiles(t, contextDir); !reflect.DeepEqual(got, want) {
+		t.Errorf("DownloadStarterProject() files = %v, want %v", got, want)
+	}
+}
+
+func TestGetSSHUser(t *testing.T) {
+	tests := []struct {
+		remote   string
+		wantUser string
+		wantSSH  bool
+	}{
+		{remote: "ssh://git@github.com/openshift/odo.git", wantUser: "git", wantSSH: true},
+		{remote: "ssh://developer@example.com:2222/repo.git", wantUser: "developer", wantSSH: true},
+		{remote: "ssh://example.com/repo.git", wantUser: "git", wantSSH: true},
+		{remote: "git@github.com:openshift/odo.git", wantUser: "git", wantSSH: true},
+		{remote: "example.com:repo.git", wantUser: "git", wantSSH: true},
+		{remote: "https://github.com/openshift/odo.git", wantSSH: false},
+		{remote: "/tmp/repo:name", wantSSH: false},
+		{remote: "./repo", wantSSH: false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.remote, func(t *testing.T) {
+			user, ok := getSSHUser(tt.remote)
+			if ok != tt.wantSSH || user != tt.wantUser {
+				t.Errorf("getSSHUser() = %q, %v, want %q, %v", user, ok, tt.wantUser, tt.wantSSH)
+			}
+		})
+	}
+}
@@ -67,6 +67,9 @@ func (lci *LocalConfigInfo) ValidateStorage(storage localConfigProvider.LocalSto
 	if storage.Size == "" || storage.Path == "" {
 		return fmt.Errorf("\"size\" and \"path\" flags are required for s2i components")
 	}
+	if storage.StorageClass != "" || len(storage.AccessModes) > 0 || storage.VolumeMode != "" {
+		return fmt.Errorf("\"storage-class\", \"access-mode\" and \"volume-mode\" flags are only supported for devfile components")
+	}
 
 	configStorage, err := lci.ListStorage()
 	if err != nil {
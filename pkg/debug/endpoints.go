@@ -0,0 +1,172 @@
+package debug
+
+import (
+	"fmt"
+	"net"
+	"sort"
+	"strconv"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+)
+
+const (
+	// DefaultEndpointLocalPortStart is the first local port tried for an endpoint
+	// whose container port cannot be used locally
+	DefaultEndpointLocalPortStart = 20001
+	// maxLocalPort is the highest port number that can be used locally
+	maxLocalPort = 65535
+	// minUnprivilegedPort is the lowest port which can be bound without special privileges
+	minUnprivilegedPort = 1024
+)
+
+// ForwardedEndpoint is a devfile endpoint forwarded to a port on localhost
+type ForwardedEndpoint struct {
+	Name          string
+	ContainerName string
+	ContainerPort int
+	LocalPort     int
+	Exposure      devfilev1.EndpointExposure
+	Protocol      devfilev1.EndpointProtocol
+	Path          string
+	Secure        bool
+}
+
+// PortPair returns the port pair in the format "localPort:RemotePort"
+func (e ForwardedEndpoint) PortPair() string {
+	return fmt.Sprintf("%d:%d", e.LocalPort, e.ContainerPort)
+}
+
+// LocalURL returns the URL used to access the endpoint on localhost
+func (e ForwardedEndpoint) LocalURL() string {
+	scheme := "http"
+	switch e.Protocol {
+	case devfilev1.HTTPSEndpointProtocol, devfilev1.WSEndpointProtocol, devfilev1.WSSEndpointProtocol,
+		devfilev1.TCPEndpointProtocol, devfilev1.UDPEndpointProtocol:
+		scheme = string(e.Protocol)
+	default:
+		if e.Secure {
+			scheme = "https"
+		}
+	}
+	return fmt.Sprintf("%s://localhost:%d%s", scheme, e.LocalPort, e.Path)
+}
+
+// GetForwardableEndpoints returns the endpoints of the devfile container components which
+// should be forwarded to localhost i.e. the ones with "public" or "internal" exposure.
+// The result is sorted by container and endpoint name, and contains no local ports yet
+func GetForwardableEndpoints(devObj parser.DevfileObj) ([]ForwardedEndpoint, error) {
+	containerComponents, err := devObj.Data.GetDevfileContainerComponents(common.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []ForwardedEndpoint
+	seenPorts := make(map[int]bool)
+	for _, component := range containerComponents {
+		for _, endpoint := range component.Container.Endpoints {
+			exposure := endpoint.Exposure
+			if exposure == "" {
+				exposure = devfilev1.PublicEndpointExposure
+			}
+			if exposure == devfilev1.NoneEndpointExposure {
+				continue
+			}
+			// all containers share the pod network, a port only needs to be forwarded once
+			if seenPorts[endpoint.TargetPort] {
+				continue
+			}
+			seenPorts[endpoint.TargetPort] = true
+
+			path := endpoint.Path
+			if path == "" {
+				path = "/"
+			}
+			endpoints = append(endpoints, ForwardedEndpoint{
+				Name:          endpoint.Name,
+				ContainerName: component.Name,
+				ContainerPort: endpoint.TargetPort,
+				Exposure:      exposure,
+				Protocol:      endpoint.Protocol,
+				Path:          path,
+				Secure:        endpoint.Secure,
+			})
+		}
+	}
+
+	sort.SliceStable(endpoints, func(i, j int) bool {
+		if endpoints[i].ContainerName != endpoints[j].ContainerName {
+			return endpoints[i].ContainerName < endpoints[j].ContainerName
+		}
+		return endpoints[i].Name < endpoints[j].Name
+	})
+	return endpoints, nil
+}
+
+// AssignLocalPorts assigns a free local port to each of the endpoints.
+// The container port is used when it is unprivileged and free, otherwise the first free port
+// starting from startPort is used. Given the same free ports, the assignment is always the same
+func AssignLocalPorts(endpoints []ForwardedEndpoint, startPort int) ([]ForwardedEndpoint, error) {
+	return assignLocalPorts(endpoints, startPort, IsLocalPortFree)
+}
+
+func assignLocalPorts(endpoints []ForwardedEndpoint, startPort int, isFree func(port int) bool) ([]ForwardedEndpoint, error) {
+	used := make(map[int]bool)
+	result := make([]ForwardedEndpoint, 0, len(endpoints))
+
+	// first pass: keep the container ports wherever possible
+	for _, endpoint := range endpoints {
+		port := endpoint.ContainerPort
+		if port >= minUnprivilegedPort && !used[port] && isFree(port) {
+			endpoint.LocalPort = port
+			used[port] = true
+		} else {
+			endpoint.LocalPort = 0
+		}
+		result = append(result, endpoint)
+	}
+
+	// second pass: allocate the remaining endpoints sequentially from startPort
+	next := startPort
+	for i := range result {
+		if result[i].LocalPort != 0 {
+			continue
+		}
+		for ; next <= maxLocalPort; next++ {
+			if !used[next] && isFree(next) {
+				break
+			}
+		}
+		if next > maxLocalPort {
+			return nil, fmt.Errorf("unable to find a free local port for endpoint %q", result[i].Name)
+		}
+		result[i].LocalPort = next
+		used[next] = true
+		next++
+	}
+	return result, nil
+}
+
+// IsLocalPortFree checks if the given port can be listened on localhost
+func IsLocalPortFree(port int) bool {
+	listener, err := net.Listen("tcp", "localhost:"+strconv.Itoa(port))
+	if err != nil {
+		return false
+	}
+	_ = listener.Close()
+	return true
+}
+
+// ForwardEndpoints forwards all the given endpoints of the devfile component to their local ports
+// it blocks until stopChan is closed or the forwarding fails
+func (f *DefaultPortForwarder) ForwardEndpoints(endpoints []ForwardedEndpoint, stopChan, readyChan chan struct{}) error {
+	if len(endpoints) == 0 {
+		return fmt.Errorf("no endpoints with public or internal exposure found in the devfile")
+	}
+	portPairs := make([]string, 0, len(endpoints))
+	for _, endpoint := range endpoints {
+		portPairs = append(portPairs, endpoint.PortPair())
+	}
+	return f.ForwardPortPairs(portPairs, stopChan, readyChan, true)
+}
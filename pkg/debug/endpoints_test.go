@@ -0,0 +1,113 @@
+package debug
+
+import (
+	"reflect"
+	"testing"
+
+	v1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/testingutil/filesystem"
+	"github.com/kylelemons/godebug/pretty"
+	odoTestingUtil "github.com/openshift/odo/pkg/testingutil"
+)
+
+func TestGetForwardableEndpoints(t *testing.T) {
+	fs := filesystem.NewFakeFs()
+
+	got, err := GetForwardableEndpoints(odoTestingUtil.DevfileObjWithInternalNoneEndpoints(fs))
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	want := []ForwardedEndpoint{
+		{
+			Name:          "port-3000",
+			ContainerName: "runtime",
+			ContainerPort: 3000,
+			Exposure:      v1.PublicEndpointExposure,
+			Path:          "/",
+		},
+		{
+			Name:          "port-8080",
+			ContainerName: "runtime-debug",
+			ContainerPort: 8080,
+			Exposure:      v1.InternalEndpointExposure,
+			Path:          "/",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("endpoints mismatch: %s", pretty.Compare(want, got))
+	}
+}
+
+func Test_assignLocalPorts(t *testing.T) {
+	tests := []struct {
+		name      string
+		endpoints []ForwardedEndpoint
+		busyPorts []int
+		want      []int
+		wantErr   bool
+	}{
+		{
+			name: "case 1: container ports are free and unprivileged",
+			endpoints: []ForwardedEndpoint{
+				{Name: "http", ContainerPort: 8080},
+				{Name: "debug", ContainerPort: 5858},
+			},
+			want: []int{8080, 5858},
+		},
+		{
+			name: "case 2: privileged container port gets the first free port from the start port",
+			endpoints: []ForwardedEndpoint{
+				{Name: "http", ContainerPort: 80},
+				{Name: "https", ContainerPort: 443},
+			},
+			busyPorts: []int{20001},
+			want:      []int{20002, 20003},
+		},
+		{
+			name: "case 3: busy container port is remapped",
+			endpoints: []ForwardedEndpoint{
+				{Name: "http", ContainerPort: 8080},
+				{Name: "other", ContainerPort: 3000},
+			},
+			busyPorts: []int{8080},
+			want:      []int{20001, 3000},
+		},
+		{
+			name: "case 4: no free port left",
+			endpoints: []ForwardedEndpoint{
+				{Name: "http", ContainerPort: 80},
+			},
+			busyPorts: []int{65535},
+			wantErr:   true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			busy := make(map[int]bool)
+			for _, port := range tt.busyPorts {
+				busy[port] = true
+			}
+			startPort := DefaultEndpointLocalPortStart
+			if tt.wantErr {
+				startPort = maxLocalPort
+			}
+
+			got, err := assignLocalPorts(tt.endpoints, startPort, func(port int) bool { return !busy[port] })
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("assignLocalPorts() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var gotPorts []int
+			for _, endpoint := range got {
+				gotPorts = append(gotPorts, endpoint.LocalPort)
+			}
+			if !reflect.DeepEqual(gotPorts, tt.want) {
+				t.Errorf("got local ports %v, want %v", gotPorts, tt.want)
+			}
+		})
+	}
+}
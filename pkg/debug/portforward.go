@@ -42,6 +42,13 @@ func NewDefaultPortForwarder(componentName, appName string, projectName string,
 // stop Chan is used to stop port forwarding
 // ready Chan is used to signal failure to the channel receiver
 func (f *DefaultPortForwarder) ForwardPorts(portPair string, stopChan, readyChan chan struct{}, isDevfile bool) error {
+	log.Info("Started port forwarding at ports -", portPair)
+	return f.ForwardPortPairs([]string{portPair}, stopChan, readyChan, isDevfile)
+}
+
+// ForwardPortPairs forwards all the given port pairs to the remote pod over a single connection
+// each port pair is in the format "localPort:RemotePort"
+func (f *DefaultPortForwarder) ForwardPortPairs(portPairs []string, stopChan, readyChan chan struct{}, isDevfile bool) error {
 	var pod *corev1.Pod
 	var conf *rest.Config
 	var err error
@@ -80,10 +87,9 @@ func (f *DefaultPortForwarder) ForwardPorts(portPair string, stopChan, readyChan
 	req := f.kClient.GeneratePortForwardReq(pod.Name)
 
 	dialer := spdy.NewDialer(upgrader, &http.Client{Transport: transport}, "POST", req.URL())
-	fw, err := portforward.New(dialer, []string{portPair}, stopChan, readyChan, f.Out, f.ErrOut)
+	fw, err := portforward.New(dialer, portPairs, stopChan, readyChan, f.Out, f.ErrOut)
 	if err != nil {
 		return err
 	}
-	log.Info("Started port forwarding at ports -", portPair)
 	return fw.ForwardPorts()
 }
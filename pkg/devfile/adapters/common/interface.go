@@ -18,4 +18,6 @@ type ComponentAdapter interface {
 	StartSupervisordCtlStatusWatch()
 	Log(follow bool, command devfilev1.Command) (io.ReadCloser, error)
 	Exec(command []string) error
+	MountStorage(storageName string) (StorageMount, error)
+	UnmountStorage(mount StorageMount) error
 }
@@ -0,0 +1,282 @@
+package common
+
+import (
+	"archive/tar"
+	"bytes"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path"
+	"path/filepath"
+	"strings"
+
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+const (
+	// defaultStorageHelperImage is the image of the helper containers mounting the storage of the stopped components,
+	// it must provide the sh, tar, mkdir, rm and sleep commands
+	defaultStorageHelperImage = "registry.access.redhat.com/ubi8/ubi:latest"
+
+	// storageHelperImageEnvName is the environment variable overriding the image of the storage helper containers
+	storageHelperImageEnvName = "ODO_STORAGE_HELPER_IMAGE"
+
+	// StorageHelperMountPath is the path of the storage in the storage helper containers
+	StorageHelperMountPath = "/storage"
+)
+
+// StorageMount is a container of the component in which a storage is mounted
+type StorageMount struct {
+	ComponentInfo
+	// Path is the path of the storage in the container
+	Path string
+	// Helper is true when the container has been started only to access the storage of a stopped component
+	Helper bool
+}
+
+// GetStorageHelperImage returns the image of the helper containers mounting the storage of the stopped components
+func GetStorageHelperImage() string {
+	if env, ok := os.LookupEnv(storageHelperImageEnvName); ok {
+		return env
+	}
+	return defaultStorageHelperImage
+}
+
+// CopyToStorage copies the local file or directory to the path of the storage mounted in the container,
+// streaming a tar archive to the tar command of the container
+func CopyToStorage(client ExecClient, mount StorageMount, localPath, storagePath string) error {
+	stat, err := os.Stat(localPath)
+	if err != nil {
+		return errors.Wrapf(err, "unable to copy %s", localPath)
+	}
+
+	targetPath, err := getStoragePath(mount, storagePath)
+	if err != nil {
+		return err
+	}
+	// the local file or directory keeps its name when it is copied to a directory of the storage
+	targetDir, targetName := path.Dir(targetPath), path.Base(targetPath)
+	if storagePath == "" || strings.HasSuffix(storagePath, "/") || targetPath == path.Clean(mount.Path) {
+		targetDir, targetName = targetPath, filepath.Base(filepath.Clean(localPath))
+	}
+
+	var stderr bytes.Buffer
+	err = client.ExecCMDInContainer(mount.ComponentInfo, []string{"mkdir", "-p", targetDir}, ioutil.Discard, &stderr, nil, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create the directory %s in the storage: %s", targetDir, strings.TrimSpace(stderr.String()))
+	}
+
+	klog.V(4).Infof("Copying %s to %s/%s in the container %s", localPath, targetDir, targetName, mount.ContainerName)
+	reader, writer := io.Pipe()
+	go func() {
+		err := writeTar(filepath.Clean(localPath), stat, targetName, writer)
+		writer.CloseWithError(err)
+	}()
+
+	stderr.Reset()
+	err = client.ExecCMDInContainer(mount.ComponentInfo, []string{"tar", "xf", "-", "-C", targetDir, "--no-same-owner"}, ioutil.Discard, &stderr, reader, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to extract the archive in the storage: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// CopyFromStorage copies the file or directory at the path of the storage mounted in the container to the local path,
+// extracting the tar archive streamed by the tar command of the container
+func CopyFromStorage(client ExecClient, mount StorageMount, storagePath, localPath string) error {
+	sourcePath, err := getStoragePath(mount, storagePath)
+	if err != nil {
+		return err
+	}
+	sourceDir, sourceName := path.Dir(sourcePath), path.Base(sourcePath)
+	if sourcePath == path.Clean(mount.Path) {
+		// the whole storage is copied to the local directory
+		sourceDir, sourceName = sourcePath, "."
+	}
+
+	// the file or directory keeps its name when it is copied to an existing local directory
+	localPath = filepath.Clean(localPath)
+	targetName := filepath.Base(localPath)
+	targetDir := filepath.Dir(localPath)
+	if stat, err := os.Stat(localPath); (err == nil && stat.IsDir()) || sourceName == "." {
+		targetDir, targetName = localPath, sourceName
+	}
+
+	klog.V(4).Infof("Copying %s/%s of the container %s to %s", sourceDir, sourceName, mount.ContainerName, filepath.Join(targetDir, targetName))
+	reader, writer := io.Pipe()
+	var stderr bytes.Buffer
+	go func() {
+		err := client.ExecCMDInContainer(mount.ComponentInfo, []string{"tar", "cf", "-", "-C", sourceDir, sourceName}, writer, &stderr, nil, false)
+		if err != nil {
+			err = errors.Wrapf(err, "unable to archive %s in the storage: %s", storagePath, strings.TrimSpace(stderr.String()))
+		}
+		writer.CloseWithError(err)
+	}()
+
+	err = extractTar(reader, sourceName, targetDir, targetName)
+	// drain the archive to let the command of the container complete
+	_, _ = io.Copy(ioutil.Discard, reader)
+	return err
+}
+
+// ArchiveStorage writes the tar archive of the whole storage mounted in the container to the writer
+func ArchiveStorage(client ExecClient, mount StorageMount, writer io.Writer) error {
+	var stderr bytes.Buffer
+	klog.V(4).Infof("Archiving %s of the container %s", mount.Path, mount.ContainerName)
+	err := client.ExecCMDInContainer(mount.ComponentInfo, []string{"tar", "cf", "-", "-C", path.Clean(mount.Path), "."}, writer, &stderr, nil, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to archive the storage: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// RestoreStorage replaces the content of the storage mounted in the container by the content of the tar archive
+func RestoreStorage(client ExecClient, mount StorageMount, reader io.Reader) error {
+	mountPath := path.Clean(mount.Path)
+	var stderr bytes.Buffer
+	klog.V(4).Infof("Removing the content of %s of the container %s", mountPath, mount.ContainerName)
+	// the hidden files are removed too, the mount path being passed as $0 of the shell
+	err := client.ExecCMDInContainer(mount.ComponentInfo, []string{"sh", "-c", `rm -rf "$0"/* "$0"/.[!.]* "$0"/..?*`, mountPath}, ioutil.Discard, &stderr, nil, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to remove the content of the storage: %s", strings.TrimSpace(stderr.String()))
+	}
+
+	stderr.Reset()
+	err = client.ExecCMDInContainer(mount.ComponentInfo, []string{"tar", "xf", "-", "-C", mountPath, "--no-same-owner"}, ioutil.Discard, &stderr, reader, false)
+	if err != nil {
+		return errors.Wrapf(err, "unable to extract the archive in the storage: %s", strings.TrimSpace(stderr.String()))
+	}
+	return nil
+}
+
+// getStoragePath returns the path in the container of the path of the storage,
+// returning an error if it is outside of the storage
+func getStoragePath(mount StorageMount, storagePath string) (string, error) {
+	mountPath := path.Clean(mount.Path)
+	fullPath := path.Join(mountPath, storagePath)
+	if fullPath != mountPath && !strings.HasPrefix(fullPath, strings.TrimSuffix(mountPath, "/")+"/") {
+		return "", errors.Errorf("the path %s is outside of the storage", storagePath)
+	}
+	return fullPath, nil
+}
+
+// writeTar writes the tar archive of the local file or directory to the writer, its content being named after name in the archive
+func writeTar(localPath string, stat os.FileInfo, name string, writer io.Writer) error {
+	tarWriter := tar.NewWriter(writer)
+	if !stat.IsDir() {
+		if err := writeTarFile(tarWriter, localPath, stat, name); err != nil {
+			return err
+		}
+		return tarWriter.Close()
+	}
+
+	err := filepath.Walk(localPath, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(localPath, filePath)
+		if err != nil {
+			return err
+		}
+		return writeTarFile(tarWriter, filePath, info, path.Join(name, filepath.ToSlash(rel)))
+	})
+	if err != nil {
+		return err
+	}
+	return tarWriter.Close()
+}
+
+// writeTarFile writes the header and the content of the file to the tar archive
+func writeTarFile(tarWriter *tar.Writer, filePath string, info os.FileInfo, name string) error {
+	link := ""
+	if info.Mode()&os.ModeSymlink != 0 {
+		target, err := os.Readlink(filePath)
+		if err != nil {
+			return err
+		}
+		link = target
+	}
+	header, err := tar.FileInfoHeader(info, link)
+	if err != nil {
+		return err
+	}
+	header.Name = name
+	if err = tarWriter.WriteHeader(header); err != nil {
+		return err
+	}
+	if !info.Mode().IsRegular() {
+		return nil
+	}
+	file, err := os.Open(filePath)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	_, err = io.Copy(tarWriter, file)
+	return err
+}
+
+// extractTar extracts the tar archive to the local directory, the entry sourceName of the archive being renamed targetName.
+// The entries outside of the target directory and the links are skipped
+func extractTar(reader io.Reader, sourceName, targetDir, targetName string) error {
+	tarReader := tar.NewReader(reader)
+	found := false
+	for {
+		header, err := tarReader.Next()
+		if err == io.EOF {
+			break
+		}
+		if err != nil {
+			return err
+		}
+
+		name := path.Clean(header.Name)
+		if name != sourceName && !strings.HasPrefix(name, sourceName+"/") && sourceName != "." {
+			continue
+		}
+		found = true
+		rel := strings.TrimPrefix(strings.TrimPrefix(name, sourceName), "/")
+		if sourceName == "." {
+			rel = name
+		}
+		target := filepath.Join(targetDir, targetName, filepath.FromSlash(rel))
+		if !isInDirectory(target, targetDir) {
+			klog.V(4).Infof("Skipping %s which is outside of %s", header.Name, targetDir)
+			continue
+		}
+
+		switch header.Typeflag {
+		case tar.TypeDir:
+			if err = os.MkdirAll(target, 0755); err != nil {
+				return err
+			}
+		case tar.TypeReg:
+			if err = os.MkdirAll(filepath.Dir(target), 0755); err != nil {
+				return err
+			}
+			file, err := os.OpenFile(target, os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.FileMode(header.Mode).Perm())
+			if err != nil {
+				return err
+			}
+			_, err = io.Copy(file, tarReader)
+			file.Close()
+			if err != nil {
+				return err
+			}
+		default:
+			klog.V(4).Infof("Skipping %s which is not a regular file or a directory", header.Name)
+		}
+	}
+	if !found {
+		return fmt.Errorf("%s has not been found in the storage", sourceName)
+	}
+	return nil
+}
+
+// isInDirectory returns true if the path is the directory or is in the directory
+func isInDirectory(filePath, dir string) bool {
+	rel, err := filepath.Rel(dir, filePath)
+	return err == nil && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator))
+}
@@ -0,0 +1,203 @@
+package common
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+)
+
+// localExecClient runs the commands on the local machine, the storage being a local directory
+type localExecClient struct{}
+
+func (localExecClient) ExecCMDInContainer(compInfo ComponentInfo, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, tty bool) error {
+	c := exec.Command(cmd[0], cmd[1:]...)
+	c.Stdout, c.Stderr, c.Stdin = stdout, stderr, stdin
+	return c.Run()
+}
+
+// listFiles returns the files and directories of the directory, relative to it
+func listFiles(t *testing.T, dir string) []string {
+	var files []string
+	err := filepath.Walk(dir, func(filePath string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(dir, filePath)
+		if err != nil {
+			return err
+		}
+		if rel != "." {
+			files = append(files, filepath.ToSlash(rel))
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(files)
+	return files
+}
+
+// newLocalSource creates a directory src containing a file and a sub directory
+func newLocalSource(t *testing.T, dir string) string {
+	src := filepath.Join(dir, "src")
+	if err := os.MkdirAll(filepath.Join(src, "sub"), 0755); err != nil {
+		t.Fatal(err)
+	}
+	for _, name := range []string{"a.txt", "sub/b.txt"} {
+		if err := ioutil.WriteFile(filepath.Join(src, filepath.FromSlash(name)), []byte(name), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	return src
+}
+
+func TestCopyToStorage(t *testing.T) {
+	if _, err := exec.LookPath("tar"); err != nil {
+		t.Skip("tar is not available")
+	}
+
+	tests := []struct {
+		name        string
+		file        bool
+		storagePath string
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "Case 1: directory copied to the root of the storage",
+			storagePath: "",
+			want:        []string{"src", "src/a.txt", "src/sub", "src/sub/b.txt"},
+		},
+		{
+			name:        "Case 2: directory copied to a new directory of the storage",
+			storagePath: "data",
+			want:        []string{"data", "data/a.txt", "data/sub", "data/sub/b.txt"},
+		},
+		{
+			name:        "Case 3: file copied to a directory of the storage",
+			file:        true,
+			storagePath: "seed/",
+			want:        []string{"seed", "seed/a.txt"},
+		},
+		{
+			name:        "Case 4: file copied and renamed",
+			file:        true,
+			storagePath: "seed/dump.txt",
+			want:        []string{"seed", "seed/dump.txt"},
+		},
+		{
+			name:        "Case 5: path outside of the storage",
+			storagePath: "../outside",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "storage-copy")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			localPath := newLocalSource(t, dir)
+			if tt.file {
+				localPath = filepath.Join(localPath, "a.txt")
+			}
+			storageDir := filepath.Join(dir, "storage")
+			if err = os.Mkdir(storageDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+
+			err = CopyToStorage(localExecClient{}, StorageMount{Path: filepath.ToSlash(storageDir)}, localPath, tt.storagePath)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CopyToStorage() unexpected error %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := listFiles(t, storageDir); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CopyToStorage() copied %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCopyFromStorage(t *testing.T) {
+	if _, err := exec.LookPath("tar"); err != nil {
+		t.Skip("tar is not available")
+	}
+
+	tests := []struct {
+		name        string
+		storagePath string
+		localPath   string
+		existingDir bool
+		want        []string
+		wantErr     bool
+	}{
+		{
+			name:        "Case 1: whole storage copied to a new directory",
+			storagePath: "",
+			localPath:   "out",
+			want:        []string{"out", "out/src", "out/src/a.txt", "out/src/sub", "out/src/sub/b.txt"},
+		},
+		{
+			name:        "Case 2: directory copied to an existing directory",
+			storagePath: "src/sub",
+			localPath:   "out",
+			existingDir: true,
+			want:        []string{"out", "out/sub", "out/sub/b.txt"},
+		},
+		{
+			name:        "Case 3: file copied and renamed",
+			storagePath: "src/a.txt",
+			localPath:   "dump.txt",
+			want:        []string{"dump.txt"},
+		},
+		{
+			name:        "Case 4: missing file of the storage",
+			storagePath: "src/missing.txt",
+			localPath:   "out",
+			wantErr:     true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "storage-copy")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			storageDir := filepath.Join(dir, "storage")
+			if err = os.Mkdir(storageDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			newLocalSource(t, storageDir)
+			localDir := filepath.Join(dir, "local")
+			if err = os.Mkdir(localDir, 0755); err != nil {
+				t.Fatal(err)
+			}
+			if tt.existingDir {
+				if err = os.Mkdir(filepath.Join(localDir, tt.localPath), 0755); err != nil {
+					t.Fatal(err)
+				}
+			}
+
+			err = CopyFromStorage(localExecClient{}, StorageMount{Path: filepath.ToSlash(storageDir)}, tt.storagePath, filepath.Join(localDir, tt.localPath))
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("CopyFromStorage() unexpected error %v, wantErr %v", err, tt.wantErr)
+			}
+			if err != nil {
+				return
+			}
+			if got := listFiles(t, localDir); !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("CopyFromStorage() copied %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
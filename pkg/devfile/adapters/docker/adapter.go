@@ -67,6 +67,16 @@ func (d Adapter) Exec(command []string) error {
 	return d.componentAdapter.Exec(command)
 }
 
+// MountStorage returns a container in which the storage of the component is mounted
+func (d Adapter) MountStorage(storageName string) (common.StorageMount, error) {
+	return d.componentAdapter.MountStorage(storageName)
+}
+
+// UnmountStorage removes the helper container started by MountStorage
+func (d Adapter) UnmountStorage(mount common.StorageMount) error {
+	return d.componentAdapter.UnmountStorage(mount)
+}
+
 func (d Adapter) ExecCMDInContainer(info common.ComponentInfo, cmd []string, stdOut io.Writer, stdErr io.Writer, stdIn io.Reader, show bool) error {
 	return d.componentAdapter.ExecCMDInContainer(info, cmd, stdOut, stdErr, stdIn, show)
 }
@@ -0,0 +1,96 @@
+package component
+
+import (
+	"github.com/docker/docker/api/types"
+	"github.com/docker/docker/api/types/container"
+	"github.com/docker/docker/api/types/mount"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/devfile/adapters/docker/utils"
+)
+
+// storageHelperLabel is the label of the helper containers mounting the volumes of the stopped components, set to the name of the component
+const storageHelperLabel = "odo.dev/storage-helper"
+
+// MountStorage returns the running container of the component in which the volume of the storage is mounted,
+// or starts a helper container mounting the volume when the component is not running
+func (a Adapter) MountStorage(storageName string) (common.StorageMount, error) {
+	volumes, err := a.Client.GetVolumes()
+	if err != nil {
+		return common.StorageMount{}, errors.Wrapf(err, "unable to retrieve list of all Docker volumes")
+	}
+	volumeName := getStorageVolumeName(volumes, a.ComponentName, storageName)
+	if volumeName == "" {
+		return common.StorageMount{}, errors.Errorf("the storage %s of the component %s doesn't exist, please use `odo push` to create it", storageName, a.ComponentName)
+	}
+
+	containers, err := utils.GetComponentContainers(a.Client, a.ComponentName)
+	if err != nil {
+		return common.StorageMount{}, errors.Wrapf(err, "unable to get the containers of the component %s", a.ComponentName)
+	}
+	if mount, found := getContainerStorageMount(containers, volumeName); found {
+		return mount, nil
+	}
+
+	klog.V(3).Infof("The component %s is not running, starting a helper container mounting the volume %s", a.ComponentName, volumeName)
+	image := common.GetStorageHelperImage()
+	err = a.Client.PullImage(image)
+	if err != nil {
+		return common.StorageMount{}, errors.Wrapf(err, "unable to pull %s image", image)
+	}
+	containerConfig := a.Client.GenerateContainerConfig(image, []string{"sleep"}, []string{"3600"}, nil, map[string]string{storageHelperLabel: a.ComponentName}, nil)
+	hostConfig := container.HostConfig{}
+	utils.AddVolumeToContainer(volumeName, common.StorageHelperMountPath, &hostConfig)
+	containerID, err := a.Client.StartContainer(&containerConfig, &hostConfig, nil)
+	if err != nil {
+		return common.StorageMount{}, errors.Wrapf(err, "unable to start the helper container mounting the volume %s", volumeName)
+	}
+	return common.StorageMount{
+		ComponentInfo: common.ComponentInfo{
+			ContainerName: containerID,
+		},
+		Path:   common.StorageHelperMountPath,
+		Helper: true,
+	}, nil
+}
+
+// UnmountStorage removes the helper container started by MountStorage
+func (a Adapter) UnmountStorage(mount common.StorageMount) error {
+	if !mount.Helper {
+		return nil
+	}
+	err := a.Client.RemoveContainer(mount.ContainerName)
+	if err != nil {
+		return errors.Wrapf(err, "unable to remove the helper container %s", mount.ContainerName)
+	}
+	return nil
+}
+
+// getStorageVolumeName returns the name of the Docker volume of the storage of the component
+func getStorageVolumeName(volumes []types.Volume, componentName, storageName string) string {
+	for _, volume := range volumes {
+		if volume.Labels["component"] == componentName && volume.Labels["storage-name"] == storageName {
+			return volume.Name
+		}
+	}
+	return ""
+}
+
+// getContainerStorageMount returns the first container mounting the volume
+func getContainerStorageMount(containers []types.Container, volumeName string) (common.StorageMount, bool) {
+	for _, c := range containers {
+		for _, m := range c.Mounts {
+			if m.Type == mount.TypeVolume && m.Name == volumeName {
+				return common.StorageMount{
+					ComponentInfo: common.ComponentInfo{
+						ContainerName: c.ID,
+					},
+					Path: m.Destination,
+				}, true
+			}
+		}
+	}
+	return common.StorageMount{}, false
+}
@@ -83,6 +83,16 @@ func (k Adapter) Exec(command []string) error {
 	return k.componentAdapter.Exec(command)
 }
 
+// MountStorage returns a container in which the storage of the component is mounted
+func (k Adapter) MountStorage(storageName string) (common.StorageMount, error) {
+	return k.componentAdapter.MountStorage(storageName)
+}
+
+// UnmountStorage removes the helper container started by MountStorage
+func (k Adapter) UnmountStorage(mount common.StorageMount) error {
+	return k.componentAdapter.UnmountStorage(mount)
+}
+
 func (k Adapter) ExecCMDInContainer(info common.ComponentInfo, cmd []string, stdOut io.Writer, stdErr io.Writer, stdIn io.Reader, show bool) error {
 	return k.componentAdapter.ExecCMDInContainer(info, cmd, stdOut, stdErr, stdIn, show)
 }
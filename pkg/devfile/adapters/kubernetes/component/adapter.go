@@ -104,6 +104,8 @@ type Adapter struct {
 	devfileDebugPort int
 	pod              *corev1.Pod
 	deployment       *appsv1.Deployment
+	// componentLinks are the links to other components to inject into the component
+	componentLinks []service.ComponentLink
 }
 
 // Push updates the component if a matching component exists or creates one if it doesn't exist
@@ -175,6 +177,18 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 		return errors.Wrap(err, "failed to create service(s) associated with the component")
 	}
 
+	// wait for the services the component depends on to be ready before starting it
+	err = service.WaitForServicesFromKubernetesInlineComponents(a.Client.GetKubeClient(), k8sComponents)
+	if err != nil {
+		return errors.Wrap(err, "failed to wait for service(s) associated with the component")
+	}
+
+	// create the secrets holding the information to connect to the linked components
+	a.componentLinks, err = service.PushComponentLinks(a.Client.GetKubeClient(), k8sComponents, labels)
+	if err != nil {
+		return errors.Wrap(err, "failed to create link(s) with other components")
+	}
+
 	if componentExists && needRestart {
 		err = a.Client.GetKubeClient().WaitForPodNotReady(podName)
 		if err != nil {
@@ -239,6 +253,11 @@ func (a Adapter) Push(parameters common.PushParameters) (err error) {
 		return err
 	}
 
+	err = service.UpdateComponentLinksOwnerReferences(a.Client.GetKubeClient(), labels, ownerReference)
+	if err != nil {
+		return err
+	}
+
 	parameters.EnvSpecificInfo.SetDevfileObj(a.Devfile)
 	err = component.ApplyConfig(&a.Client, config.LocalConfigInfo{}, parameters.EnvSpecificInfo, color.Output, componentExists, false)
 	if err != nil {
@@ -458,6 +477,7 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 		volumeNameToVolInfo[pvc.Labels[storagelabels.StorageLabel]] = storage.VolumeInfo{
 			PVCName:    pvc.Name,
 			VolumeName: generatedVolumeName,
+			Block:      pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock,
 		}
 	}
 
@@ -495,11 +515,26 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 		deployment.Annotations["app.openshift.io/vcs-uri"] = vcsUri
 	}
 
+	err = service.InjectComponentLinks(a.Client.GetKubeClient(), &deployment.Spec.Template, a.componentLinks, labels)
+	if err != nil {
+		return err
+	}
+
 	// add the annotations to the service for linking
 	serviceAnnotations := make(map[string]string)
 	serviceAnnotations["service.binding/backend_ip"] = "path={.spec.clusterIP}"
 	serviceAnnotations["service.binding/backend_port"] = "path={.spec.ports},elementType=sliceOfMaps,sourceKey=name,sourceValue=port"
 
+	// list the endpoints of the component, for the components linked to it
+	endpoints, err := service.GetComponentEndpoints(a.Devfile)
+	if err != nil {
+		return err
+	}
+	serviceAnnotations[service.ComponentEndpointsAnnotation], err = service.GetComponentEndpointsAnnotation(endpoints)
+	if err != nil {
+		return err
+	}
+
 	serviceName, err := util.NamespaceKubernetesObjectWithTrim(componentName, a.AppName)
 	if err != nil {
 		return err
@@ -580,6 +615,14 @@ func (a *Adapter) createOrUpdateComponent(componentExists bool, ei envinfo.EnvSp
 
 	}
 
+	if len(svc.Spec.Ports) > 0 {
+		// update the information of the components linked to this component, in case its ports have changed
+		err = service.RefreshComponentLinksToTarget(a.Client.GetKubeClient(), *svc)
+		if err != nil {
+			return errors.Wrap(err, "unable to update the links of other components to the component")
+		}
+	}
+
 	return nil
 }
 
@@ -0,0 +1,154 @@
+package component
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/kclient"
+	storagelabels "github.com/openshift/odo/pkg/storage/labels"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+const (
+	// storageHelperLabel is the label of the helper pods mounting the storage of the stopped components, set to the name of the component
+	storageHelperLabel = "odo.dev/storage-helper"
+	// storageHelperIDLabel is the label identifying a helper pod
+	storageHelperIDLabel = "odo.dev/storage-helper-id"
+	// storageHelperContainerName is the name of the container of the helper pods
+	storageHelperContainerName = "storage-helper"
+	// storageHelperVolumeName is the name of the volume of the storage in the helper pods
+	storageHelperVolumeName = "storage"
+)
+
+// MountStorage returns the running container of the component in which the storage is mounted,
+// or starts a helper pod mounting the PVC of the storage when the component is not running
+func (a Adapter) MountStorage(storageName string) (common.StorageMount, error) {
+	selector := util.ConvertLabelsToSelector(map[string]string{
+		"component":                       a.ComponentName,
+		storagelabels.DevfileStorageLabel: storageName,
+	})
+	pvcs, err := a.Client.GetKubeClient().ListPVCs(selector)
+	if err != nil {
+		return common.StorageMount{}, errors.Wrapf(err, "unable to get the PVC of the storage %s", storageName)
+	}
+	if len(pvcs) == 0 {
+		return common.StorageMount{}, errors.Errorf("the storage %s of the component %s doesn't exist on the cluster, please use `odo push` to create it", storageName, a.ComponentName)
+	}
+	pvc := pvcs[0]
+	if pvc.Spec.VolumeMode != nil && *pvc.Spec.VolumeMode == corev1.PersistentVolumeBlock {
+		return common.StorageMount{}, errors.Errorf("the storage %s is a block volume, files can't be copied to or from it", storageName)
+	}
+
+	pod, err := a.Client.GetKubeClient().GetOnePod(a.ComponentName, a.AppName)
+	if err != nil {
+		if _, ok := err.(*kclient.PodNotFoundError); !ok {
+			return common.StorageMount{}, errors.Wrapf(err, "unable to get the pod of the component %s", a.ComponentName)
+		}
+	} else if pod.Status.Phase == corev1.PodRunning {
+		if mount, found := getPodStorageMount(pod, pvc.Name); found {
+			return mount, nil
+		}
+	}
+
+	klog.V(3).Infof("The component %s is not running, starting a helper pod mounting the PVC %s", a.ComponentName, pvc.Name)
+	helperPod, err := a.Client.GetKubeClient().CreatePod(getStorageHelperPod(a.ComponentName, storageName, pvc.Name))
+	if err != nil {
+		return common.StorageMount{}, err
+	}
+	helperPodName := helperPod.Name
+	helperPod, err = a.Client.GetKubeClient().WaitAndGetPodWithEvents(storageHelperIDLabel+"="+helperPod.Labels[storageHelperIDLabel], corev1.PodRunning, "Waiting for the helper pod mounting the storage to start")
+	if err != nil {
+		if deleteErr := a.Client.GetKubeClient().DeletePod(helperPodName); deleteErr != nil {
+			klog.V(3).Infof("unable to delete the helper pod %s: %v", helperPodName, deleteErr)
+		}
+		return common.StorageMount{}, err
+	}
+	return common.StorageMount{
+		ComponentInfo: common.ComponentInfo{
+			PodName:       helperPod.Name,
+			ContainerName: storageHelperContainerName,
+		},
+		Path:   common.StorageHelperMountPath,
+		Helper: true,
+	}, nil
+}
+
+// UnmountStorage deletes the helper pod started by MountStorage
+func (a Adapter) UnmountStorage(mount common.StorageMount) error {
+	if !mount.Helper {
+		return nil
+	}
+	err := a.Client.GetKubeClient().DeletePod(mount.PodName)
+	if err != nil {
+		return errors.Wrapf(err, "unable to delete the helper pod %s", mount.PodName)
+	}
+	return nil
+}
+
+// getPodStorageMount returns the first container of the pod mounting the PVC
+func getPodStorageMount(pod *corev1.Pod, pvcName string) (common.StorageMount, bool) {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.PersistentVolumeClaim == nil || volume.PersistentVolumeClaim.ClaimName != pvcName {
+			continue
+		}
+		for _, container := range pod.Spec.Containers {
+			for _, volumeMount := range container.VolumeMounts {
+				if volumeMount.Name == volume.Name {
+					return common.StorageMount{
+						ComponentInfo: common.ComponentInfo{
+							PodName:       pod.Name,
+							ContainerName: container.Name,
+						},
+						Path: volumeMount.MountPath,
+					}, true
+				}
+			}
+		}
+	}
+	return common.StorageMount{}, false
+}
+
+// getStorageHelperPod returns the helper pod mounting the PVC of the storage of the component
+func getStorageHelperPod(componentName, storageName, pvcName string) *corev1.Pod {
+	id := util.GenerateRandomString(8)
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: fmt.Sprintf("%s-%s-helper-%s", util.TruncateString(componentName, 20), util.TruncateString(storageName, 20), id),
+			Labels: map[string]string{
+				storageHelperLabel:                componentName,
+				storageHelperIDLabel:              id,
+				storagelabels.DevfileStorageLabel: storageName,
+			},
+		},
+		Spec: corev1.PodSpec{
+			RestartPolicy: corev1.RestartPolicyNever,
+			Containers: []corev1.Container{
+				{
+					Name:    storageHelperContainerName,
+					Image:   common.GetStorageHelperImage(),
+					Command: []string{"sleep", "3600"},
+					VolumeMounts: []corev1.VolumeMount{
+						{
+							Name:      storageHelperVolumeName,
+							MountPath: common.StorageHelperMountPath,
+						},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name: storageHelperVolumeName,
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{
+							ClaimName: pvcName,
+						},
+					},
+				},
+			},
+		},
+	}
+}
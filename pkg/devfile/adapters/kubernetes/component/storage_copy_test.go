@@ -0,0 +1,81 @@
+package component
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetPodStorageMount(t *testing.T) {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "nodejs-pod",
+		},
+		Spec: corev1.PodSpec{
+			Containers: []corev1.Container{
+				{
+					Name: "runtime",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "odo-projects", MountPath: "/projects"},
+					},
+				},
+				{
+					Name: "db",
+					VolumeMounts: []corev1.VolumeMount{
+						{Name: "mydb-vol", MountPath: "/var/lib/data"},
+					},
+				},
+			},
+			Volumes: []corev1.Volume{
+				{
+					Name:         "odo-projects",
+					VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}},
+				},
+				{
+					Name: "mydb-vol",
+					VolumeSource: corev1.VolumeSource{
+						PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: "mydb-pvc"},
+					},
+				},
+			},
+		},
+	}
+
+	tests := []struct {
+		name      string
+		pvcName   string
+		wantMount common.StorageMount
+		wantFound bool
+	}{
+		{
+			name:    "Case 1: PVC mounted in a container of the pod",
+			pvcName: "mydb-pvc",
+			wantMount: common.StorageMount{
+				ComponentInfo: common.ComponentInfo{
+					PodName:       "nodejs-pod",
+					ContainerName: "db",
+				},
+				Path: "/var/lib/data",
+			},
+			wantFound: true,
+		},
+		{
+			name:    "Case 2: PVC not mounted in the pod",
+			pvcName: "other-pvc",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			gotMount, gotFound := getPodStorageMount(pod, tt.pvcName)
+			if gotFound != tt.wantFound {
+				t.Errorf("getPodStorageMount() found = %v, want %v", gotFound, tt.wantFound)
+			}
+			if !reflect.DeepEqual(gotMount, tt.wantMount) {
+				t.Errorf("getPodStorageMount() = %#v, want %#v", gotMount, tt.wantMount)
+			}
+		})
+	}
+}
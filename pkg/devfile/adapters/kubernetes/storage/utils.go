@@ -23,6 +23,8 @@ import (
 type VolumeInfo struct {
 	PVCName    string
 	VolumeName string
+	// Block is true when the pvc is a raw block volume attached to the containers as a device
+	Block bool
 }
 
 // GetVolumesAndVolumeMounts gets the PVC volumes and updates the containers with the volume mounts.
@@ -49,7 +51,11 @@ func GetVolumesAndVolumeMounts(devfileObj devfileParser.DevfileObj, containers [
 			}
 		}
 
-		addVolumeMountToContainers(containers, volInfo.VolumeName, containerNameToMountPaths)
+		if volInfo.Block {
+			addVolumeDeviceToContainers(containers, volInfo.VolumeName, containerNameToMountPaths)
+		} else {
+			addVolumeMountToContainers(containers, volInfo.VolumeName, containerNameToMountPaths)
+		}
 	}
 	return pvcVols, nil
 }
@@ -89,6 +95,22 @@ func addVolumeMountToContainers(containers []corev1.Container, volumeName string
 	}
 }
 
+// addVolumeDeviceToContainers adds the block volume as a device to the containers, at the paths in containerNameToMountPaths
+func addVolumeDeviceToContainers(containers []corev1.Container, volumeName string, containerNameToMountPaths map[string][]string) {
+	for containerName, devicePaths := range containerNameToMountPaths {
+		for i := range containers {
+			if containers[i].Name == containerName {
+				for _, devicePath := range devicePaths {
+					containers[i].VolumeDevices = append(containers[i].VolumeDevices, corev1.VolumeDevice{
+						Name:       volumeName,
+						DevicePath: devicePath,
+					})
+				}
+			}
+		}
+	}
+}
+
 // GenerateVolumeNameFromPVC generates a volume name based on the pvc name
 func GenerateVolumeNameFromPVC(pvc string) (volumeName string, err error) {
 	volumeName, err = util.NamespaceOpenShiftObject(pvc, "vol")
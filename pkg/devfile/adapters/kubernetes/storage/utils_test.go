@@ -122,6 +122,18 @@ func TestAddVolumeMountToContainers(t *testing.T) {
 	}
 }
 
+func TestAddVolumeDeviceToContainers(t *testing.T) {
+	containers := []v1.Container{{Name: "container1"}, {Name: "container2"}}
+	addVolumeDeviceToContainers(containers, "myvolume", map[string][]string{"container1": {"/dev/xvda"}})
+
+	if len(containers[0].VolumeDevices) != 1 || containers[0].VolumeDevices[0].Name != "myvolume" || containers[0].VolumeDevices[0].DevicePath != "/dev/xvda" {
+		t.Errorf("the block volume has not been attached to the container: %v", containers[0].VolumeDevices)
+	}
+	if len(containers[0].VolumeMounts) != 0 || len(containers[1].VolumeDevices) != 0 {
+		t.Errorf("unexpected volumes of the containers: %v", containers)
+	}
+}
+
 func TestGetVolumesAndVolumeMounts(t *testing.T) {
 
 	type testVolumeMountInfo struct {
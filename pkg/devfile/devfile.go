@@ -4,8 +4,10 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/devfile/api/v2/pkg/validation/variables"
 	"github.com/devfile/library/pkg/devfile"
 	"github.com/devfile/library/pkg/devfile/parser"
+	libvalidate "github.com/devfile/library/pkg/devfile/validate"
 	"github.com/openshift/odo/pkg/devfile/validate"
 	"github.com/openshift/odo/pkg/log"
 )
@@ -15,9 +17,37 @@ func parseDevfile(args parser.ParserArgs) (parser.DevfileObj, error) {
 	if err != nil {
 		return parser.DevfileObj{}, err
 	}
+	return validateDevfile(devObj, varWarnings)
+}
+
+// parseDevfileWithVariables parses the devfile like devfile.ParseDevfileAndValidate, overriding the values
+// of the variables of the devfile with the given ones before replacing them
+func parseDevfileWithVariables(args parser.ParserArgs, overrides map[string]string) (parser.DevfileObj, error) {
+	devObj, err := parser.ParseDevfile(args)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
 
+	spec := devObj.Data.GetDevfileWorkspaceSpec()
+	if spec.Variables == nil {
+		spec.Variables = map[string]string{}
+	}
+	for name, value := range overrides {
+		spec.Variables[name] = value
+	}
+	varWarnings := variables.ValidateAndReplaceGlobalVariable(spec)
+
+	err = libvalidate.ValidateDevfileData(devObj.Data)
+	if err != nil {
+		return parser.DevfileObj{}, err
+	}
+	return validateDevfile(devObj, varWarnings)
+}
+
+// validateDevfile runs the odo specific validations on the parsed devfile and displays the warnings of the variable substitution
+func validateDevfile(devObj parser.DevfileObj, varWarnings variables.VariableWarning) (parser.DevfileObj, error) {
 	// odo specific validations
-	err = validate.ValidateDevfileData(devObj.Data)
+	err := validate.ValidateDevfileData(devObj.Data)
 	if err != nil {
 		return parser.DevfileObj{}, err
 	}
@@ -45,6 +75,16 @@ func ParseFromFile(devfilePath string) (parser.DevfileObj, error) {
 	return parseDevfile(parser.ParserArgs{Path: devfilePath})
 }
 
+// ParseFromFileWithVariables reads, parses and validates devfile from a file, overriding the values of its variables
+// with the given ones, like the variables overridden in an environment of the component
+// if there are warning it logs them on stdout
+func ParseFromFileWithVariables(devfilePath string, overrides map[string]string) (parser.DevfileObj, error) {
+	if len(overrides) == 0 {
+		return ParseFromFile(devfilePath)
+	}
+	return parseDevfileWithVariables(parser.ParserArgs{Path: devfilePath}, overrides)
+}
+
 // ParseFromData parses devfile from []byte and does all the validation
 // if there are warning it logs them on stdout
 func ParseFromData(data []byte) (parser.DevfileObj, error) {
@@ -0,0 +1,404 @@
+// Package effective computes the effective devfile of a component: the devfile flattened with its parent and plugins,
+// with the changes odo makes to it when pushing the component, and where each of its fields comes from
+package effective
+
+import (
+	"encoding/json"
+	"fmt"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
+	"github.com/devfile/api/v2/pkg/validation"
+	"github.com/devfile/api/v2/pkg/validation/variables"
+	"github.com/devfile/library/pkg/devfile/generator"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	adaptersCommon "github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// Options are the settings of the component changing the devfile when it's pushed
+type Options struct {
+	// RunCommand and DebugCommand are the names of the commands, the default commands are used if empty
+	RunCommand   string
+	DebugCommand string
+	DebugPort    int
+	// URLs are the URLs of the env file of the component
+	URLs []localConfigProvider.LocalURL
+	// Variables are the values of the devfile variables overridden in the env file
+	Variables map[string]string
+}
+
+// Devfile is the effective devfile of a component
+type Devfile struct {
+	Obj parser.DevfileObj
+	// Origins maps the paths of the fields of the devfile to where they come from. A path is made of the keys
+	// of the fields separated by slashes, the items of the lists being identified by their name or id, or by their
+	// index if they have none, like components/runtime/container/env/DEBUG_PORT
+	Origins map[string]string
+}
+
+// setOrigin records the origin of the field, appending it to the previous origins of the field
+func (d *Devfile) setOrigin(origin string, path ...string) {
+	key := strings.Join(path, "/")
+	if previous, ok := d.Origins[key]; ok && previous != origin {
+		origin = previous + "; " + origin
+	}
+	d.Origins[key] = origin
+}
+
+// Get returns the effective devfile of the devfile
+func Get(devfilePath string, options Options) (*Devfile, error) {
+	devObj, err := parser.ParseDevfile(parser.ParserArgs{Path: devfilePath})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the devfile %s", devfilePath)
+	}
+	d := &Devfile{Obj: devObj, Origins: map[string]string{}}
+	spec := devObj.Data.GetDevfileWorkspaceSpec()
+	if len(options.Variables) > 0 && spec.Variables == nil {
+		spec.Variables = map[string]string{}
+	}
+	for variable, value := range options.Variables {
+		spec.Variables[variable] = value
+		d.setOrigin("overridden in the env file", "variables", variable)
+	}
+	if devObj.Data.GetSchemaVersion() != "2.0.0" || len(options.Variables) > 0 {
+		// replace the variables, like the parsing of odo does
+		variables.ValidateAndReplaceGlobalVariable(spec)
+	}
+
+	name := filepath.Base(devfilePath)
+	if err = d.recordImports(); err != nil {
+		return nil, err
+	}
+	if err = d.recordOverrides(devfilePath, name); err != nil {
+		return nil, err
+	}
+	if err = d.applyURLs(options.URLs); err != nil {
+		return nil, err
+	}
+	if err = d.applyProjectSources(); err != nil {
+		return nil, err
+	}
+	if err = d.applySupervisord(options); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// recordImports records the elements imported from the parent and the plugins, from the attributes added by the parser
+func (d *Devfile) recordImports() error {
+	components, err := d.Obj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	commands, err := d.Obj.Data.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	projects, err := d.Obj.Data.GetProjects(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	starterProjects, err := d.Obj.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+
+	for _, component := range components {
+		d.recordImport(component.Attributes, "components", component.Name)
+	}
+	for _, command := range commands {
+		d.recordImport(command.Attributes, "commands", command.Id)
+	}
+	for _, project := range projects {
+		d.recordImport(project.Attributes, "projects", project.Name)
+	}
+	for _, project := range starterProjects {
+		d.recordImport(project.Attributes, "starterProjects", project.Name)
+	}
+	return nil
+}
+
+func (d *Devfile) recordImport(attrs attributes.Attributes, path ...string) {
+	if !attrs.Exists(validation.ImportSourceAttribute) {
+		return
+	}
+	var err error
+	source := attrs.GetString(validation.ImportSourceAttribute, &err)
+	if err == nil {
+		d.setOrigin(fmt.Sprintf("imported from %s", source), path...)
+	}
+}
+
+// recordOverrides records the fields overridden by the parent overrides and the plugin overrides of the devfile
+func (d *Devfile) recordOverrides(devfilePath, name string) error {
+	flattened := false
+	rawObj, err := parser.ParseDevfile(parser.ParserArgs{Path: devfilePath, FlattenedDevfile: &flattened})
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse the devfile %s", devfilePath)
+	}
+
+	if parent := rawObj.Data.GetParent(); parent != nil {
+		origin := fmt.Sprintf("overridden by the parent overrides of %s", name)
+		d.recordOverride(origin, "components", parent.Components)
+		d.recordOverride(origin, "commands", parent.Commands)
+		d.recordOverride(origin, "projects", parent.Projects)
+		d.recordOverride(origin, "starterProjects", parent.StarterProjects)
+	}
+
+	components, err := rawObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		if component.Plugin == nil {
+			continue
+		}
+		origin := fmt.Sprintf("overridden by the overrides of the plugin %s of %s", component.Name, name)
+		d.recordOverride(origin, "components", component.Plugin.Components)
+		d.recordOverride(origin, "commands", component.Plugin.Commands)
+	}
+	return nil
+}
+
+// recordOverride records the origin of the fields set by the overrides of the section
+func (d *Devfile) recordOverride(origin string, section string, overrides interface{}) {
+	data, err := json.Marshal(overrides)
+	if err != nil {
+		klog.V(4).Infof("Unable to record the overrides of the %s: %v", section, err)
+		return
+	}
+	var values []interface{}
+	if err = json.Unmarshal(data, &values); err != nil {
+		klog.V(4).Infof("Unable to record the overrides of the %s: %v", section, err)
+		return
+	}
+	for i, value := range values {
+		fields, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		element := elementName(fields, i)
+		for field, fieldValue := range fields {
+			if field == "name" || field == "id" {
+				continue
+			}
+			d.recordFields(origin, fieldValue, section, element, field)
+		}
+	}
+}
+
+// recordFields records the origin of the leaves of the value
+func (d *Devfile) recordFields(origin string, value interface{}, path ...string) {
+	switch v := value.(type) {
+	case map[string]interface{}:
+		for field, fieldValue := range v {
+			d.recordFields(origin, fieldValue, append(append([]string{}, path...), field)...)
+		}
+	case []interface{}:
+		for i, item := range v {
+			fields, ok := item.(map[string]interface{})
+			if !ok {
+				// the lists of values, like the arguments of a container, are overridden as a whole
+				d.setOrigin(origin, path...)
+				return
+			}
+			d.recordFields(origin, fields, append(append([]string{}, path...), elementName(fields, i))...)
+		}
+	default:
+		d.setOrigin(origin, path...)
+	}
+}
+
+// elementName returns the name or the id of the element of a list, or its index if it has none
+func elementName(fields map[string]interface{}, i int) string {
+	for _, key := range []string{"name", "id"} {
+		if name, ok := fields[key].(string); ok && name != "" {
+			return name
+		}
+	}
+	return strconv.Itoa(i)
+}
+
+// applyURLs fills the default values of the endpoints of the URLs of the env file, like odo does when it updates them
+func (d *Devfile) applyURLs(urls []localConfigProvider.LocalURL) error {
+	if len(urls) == 0 {
+		return nil
+	}
+	envURLs := map[string]localConfigProvider.LocalURL{}
+	for _, url := range urls {
+		envURLs[url.Name] = url
+	}
+
+	components, err := d.Obj.Data.GetDevfileContainerComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		updated := false
+		for i := range component.Container.Endpoints {
+			endpoint := &component.Container.Endpoints[i]
+			url, ok := envURLs[endpoint.Name]
+			if !ok {
+				continue
+			}
+			path := []string{"components", component.Name, "container", "endpoints", endpoint.Name}
+			origin := fmt.Sprintf("URL %s of the env file", url.Name)
+			if url.Kind != "" {
+				origin = fmt.Sprintf("%s of kind %s", origin, url.Kind)
+			}
+			if url.Host != "" {
+				origin = fmt.Sprintf("%s on the host %s", origin, url.Host)
+			}
+			d.setOrigin(origin, path...)
+			for _, field := range envinfo.SetEndpointDefaults(endpoint) {
+				d.setOrigin("default value set by odo for the URL", append(path, field)...)
+				updated = true
+			}
+		}
+		if updated {
+			if err = d.Obj.Data.UpdateComponent(component); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// applyProjectSources adds the PROJECTS_ROOT and PROJECT_SOURCE env variables to the containers mounting the sources,
+// like the generator of the containers of the component does
+func (d *Devfile) applyProjectSources() error {
+	components, err := d.Obj.Data.GetDevfileContainerComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	projects, err := d.Obj.Data.GetProjects(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		if component.Container.MountSources != nil && !*component.Container.MountSources {
+			continue
+		}
+		projectsRoot := generator.DevfileSourceVolumeMount
+		if component.Container.SourceMapping != "" {
+			projectsRoot = component.Container.SourceMapping
+		}
+		projectSource := projectsRoot
+		if len(projects) > 0 {
+			projectSource = filepath.ToSlash(filepath.Join(projectsRoot, projects[0].Name))
+			if projects[0].ClonePath != "" {
+				projectSource = filepath.ToSlash(filepath.Join(projectsRoot, projects[0].ClonePath))
+			}
+		}
+		d.addEnv(&component, generator.EnvProjectsRoot, projectsRoot, "added by odo, the directory the sources are mounted in")
+		d.addEnv(&component, generator.EnvProjectsSrc, projectSource, "added by odo, the directory the sources are synchronized to")
+		if err = d.Obj.Data.UpdateComponent(component); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// applySupervisord applies the changes made to the containers running the run and the debug commands,
+// whose process is managed by supervisord
+func (d *Devfile) applySupervisord(options Options) error {
+	runCommand, err := adaptersCommon.GetRunCommand(d.Obj.Data, options.RunCommand)
+	if err != nil {
+		// the devfile can't be pushed, but it can still be shown
+		klog.V(4).Infof("Unable to get the run command: %v", err)
+		return nil
+	}
+	debugCommand, err := adaptersCommon.GetDebugCommand(d.Obj.Data, options.DebugCommand)
+	if err != nil {
+		klog.V(4).Infof("Unable to get the debug command: %v", err)
+	}
+
+	components, err := d.Obj.Data.GetDevfileContainerComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return err
+	}
+	for _, component := range components {
+		updated := false
+		if runCommand.Exec != nil && component.Name == runCommand.Exec.Component {
+			d.setSupervisordEntrypoint(&component)
+			d.addEnv(&component, adaptersCommon.EnvOdoCommandRun, commandLine(runCommand), fmt.Sprintf("added by odo, the command line of the run command %s", runCommand.Id))
+			if runCommand.Exec.WorkingDir != "" {
+				d.addEnv(&component, adaptersCommon.EnvOdoCommandRunWorkingDir, runCommand.Exec.WorkingDir, fmt.Sprintf("added by odo, the working directory of the run command %s", runCommand.Id))
+			}
+			updated = true
+		}
+		if debugCommand.Exec != nil && component.Name == debugCommand.Exec.Component {
+			d.setSupervisordEntrypoint(&component)
+			d.addEnv(&component, adaptersCommon.EnvOdoCommandDebug, commandLine(debugCommand), fmt.Sprintf("added by odo, the command line of the debug command %s", debugCommand.Id))
+			if debugCommand.Exec.WorkingDir != "" {
+				d.addEnv(&component, adaptersCommon.EnvOdoCommandDebugWorkingDir, debugCommand.Exec.WorkingDir, fmt.Sprintf("added by odo, the working directory of the debug command %s", debugCommand.Id))
+			}
+			d.setDebugPort(&component, options.DebugPort)
+			updated = true
+		}
+		if updated {
+			if err = d.Obj.Data.UpdateComponent(component); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// setSupervisordEntrypoint sets the entrypoint of the container to supervisord if it has none
+func (d *Devfile) setSupervisordEntrypoint(component *devfilev1.Component) {
+	container := component.Container
+	if len(container.Command) != 0 || len(container.Args) != 0 {
+		return
+	}
+	container.Command = []string{adaptersCommon.SupervisordBinaryPath}
+	container.Args = []string{"-c", adaptersCommon.SupervisordConfFile}
+	d.setOrigin("added by odo, supervisord runs the commands of odo", "components", component.Name, "container", "command")
+	d.setOrigin("added by odo, supervisord runs the commands of odo", "components", component.Name, "container", "args")
+}
+
+// setDebugPort sets the DEBUG_PORT env variable of the container to the debug port of the env file,
+// which takes precedence over the value of the devfile
+func (d *Devfile) setDebugPort(component *devfilev1.Component, debugPort int) {
+	if debugPort == 0 {
+		debugPort = envinfo.DefaultDebugPort
+	}
+	value := strconv.Itoa(debugPort)
+	for i, env := range component.Container.Env {
+		if env.Name != adaptersCommon.EnvDebugPort {
+			continue
+		}
+		if env.Value != value {
+			component.Container.Env[i].Value = value
+			d.setOrigin("set by odo to the debug port of the env file", "components", component.Name, "container", "env", env.Name, "value")
+		}
+		return
+	}
+	d.addEnv(component, adaptersCommon.EnvDebugPort, value, "added by odo, the debug port of the env file")
+}
+
+// addEnv adds the env variable to the container if it's not already set
+func (d *Devfile) addEnv(component *devfilev1.Component, name, value, origin string) {
+	if adaptersCommon.IsEnvPresent(component.Container.Env, name) {
+		return
+	}
+	component.Container.Env = append(component.Container.Env, devfilev1.EnvVar{Name: name, Value: value})
+	d.setOrigin(origin, "components", component.Name, "container", "env", name)
+}
+
+// commandLine returns the command line of the exec command prefixed with its env variables, like supervisord runs it
+func commandLine(command devfilev1.Command) string {
+	if envs := util.GetCommandStringFromEnvs(command.Exec.Env); envs != "" {
+		return envs + " && " + command.Exec.CommandLine
+	}
+	return command.Exec.CommandLine
+}
@@ -0,0 +1,129 @@
+package effective
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/openshift/odo/pkg/localConfigProvider"
+)
+
+const parentDevfile = `schemaVersion: 2.1.0
+metadata:
+  name: nodejs-parent
+components:
+  - name: runtime
+    container:
+      image: nodejs
+      memoryLimit: 1024Mi
+      env:
+        - name: DEBUG_PORT
+          value: "5858"
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      workingDir: /projects
+      group:
+        kind: run
+        isDefault: true
+  - id: debug
+    exec:
+      component: runtime
+      commandLine: npm run debug
+      group:
+        kind: debug
+        isDefault: true
+`
+
+const childDevfile = `schemaVersion: 2.1.0
+metadata:
+  name: mynode
+parent:
+  uri: ./parent.yaml
+  components:
+    - name: runtime
+      container:
+        memoryLimit: 2Gi
+components:
+  - name: db
+    container:
+      image: postgres
+      mountSources: false
+`
+
+func TestGet(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-effective")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	if err = ioutil.WriteFile(filepath.Join(dir, "parent.yaml"), []byte(parentDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+	devfilePath := filepath.Join(dir, "devfile.yaml")
+	if err = ioutil.WriteFile(devfilePath, []byte(childDevfile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	devfile, err := Get(devfilePath, Options{
+		DebugPort: 7777,
+		URLs:      []localConfigProvider.LocalURL{{Name: "http", Kind: localConfigProvider.INGRESS, Host: "example.com"}},
+	})
+	if err != nil {
+		t.Fatalf("Get() unexpected error: %v", err)
+	}
+
+	wantOrigins := map[string]string{
+		"components/runtime": "imported from",
+		"commands/run":       "imported from",
+		"components/runtime/container/memoryLimit":                     "overridden by the parent overrides of devfile.yaml",
+		"components/runtime/container/endpoints/http":                  "URL http of the env file of kind ingress on the host example.com",
+		"components/runtime/container/endpoints/http/exposure":         "default value set by odo for the URL",
+		"components/runtime/container/env/DEBUG_PORT/value":            "set by odo to the debug port of the env file",
+		"components/runtime/container/env/ODO_COMMAND_RUN":             "the command line of the run command run",
+		"components/runtime/container/env/ODO_COMMAND_DEBUG":           "the command line of the debug command debug",
+		"components/runtime/container/env/PROJECTS_ROOT":               "the directory the sources are mounted in",
+		"components/runtime/container/command":                         "supervisord",
+		"components/runtime/container/env/ODO_COMMAND_RUN_WORKING_DIR": "the working directory of the run command run",
+	}
+	for path, want := range wantOrigins {
+		if got := devfile.Origins[path]; !strings.Contains(got, want) {
+			t.Errorf("origin of %s = %q, want %q", path, got, want)
+		}
+	}
+	for _, path := range []string{"components/db", "components/db/container/env/PROJECTS_ROOT", "components/runtime/container/image"} {
+		if origin, ok := devfile.Origins[path]; ok {
+			t.Errorf("unexpected origin of %s: %q", path, origin)
+		}
+	}
+
+	out, err := devfile.YAML(true)
+	if err != nil {
+		t.Fatalf("YAML() unexpected error: %v", err)
+	}
+	for _, want := range []string{
+		"schemaVersion: 2.1.0\nmetadata:",
+		"  - name: runtime # imported from",
+		"      memoryLimit: 2Gi # overridden by the parent overrides of devfile.yaml",
+		`          value: "7777" # set by odo to the debug port of the env file`,
+	} {
+		if !strings.Contains(string(out), want) {
+			t.Errorf("YAML() doesn't contain %q:\n%s", want, out)
+		}
+	}
+
+	out, err = devfile.YAML(false)
+	if err != nil {
+		t.Fatalf("YAML() unexpected error: %v", err)
+	}
+	if strings.Contains(string(out), "#") {
+		t.Errorf("YAML() contains annotations:\n%s", out)
+	}
+}
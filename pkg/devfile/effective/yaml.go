@@ -0,0 +1,152 @@
+package effective
+
+import (
+	"bytes"
+	"strconv"
+	"strings"
+
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	sigsyaml "sigs.k8s.io/yaml"
+)
+
+// topLevelOrder is the order of the top level fields of the devfile, the other fields coming last
+var topLevelOrder = []string{"schemaVersion", "metadata", "parent", "variables", "attributes", "projects", "starterProjects", "components", "commands", "events"}
+
+// YAML returns the effective devfile in YAML format, with comments indicating where the fields come from if annotate is true.
+// The fields without comment come from the devfile of the component
+func (d *Devfile) YAML(annotate bool) ([]byte, error) {
+	data, err := sigsyaml.Marshal(d.Obj.Data)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to marshal the effective devfile")
+	}
+	var document yaml.Node
+	if err = yaml.Unmarshal(data, &document); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal the effective devfile")
+	}
+	if len(document.Content) == 0 {
+		return data, nil
+	}
+
+	root := document.Content[0]
+	sortTopLevel(root)
+	nameFirst(root)
+	if annotate {
+		d.annotate(root, nil)
+	}
+
+	var out bytes.Buffer
+	encoder := yaml.NewEncoder(&out)
+	encoder.SetIndent(2)
+	if err = encoder.Encode(&document); err != nil {
+		return nil, errors.Wrap(err, "unable to marshal the effective devfile")
+	}
+	if err = encoder.Close(); err != nil {
+		return nil, err
+	}
+	return out.Bytes(), nil
+}
+
+// sortTopLevel sorts the top level fields of the devfile in their usual order
+func sortTopLevel(root *yaml.Node) {
+	if root.Kind != yaml.MappingNode {
+		return
+	}
+	var sorted []*yaml.Node
+	used := map[int]bool{}
+	for _, field := range topLevelOrder {
+		for i := 0; i+1 < len(root.Content); i += 2 {
+			if root.Content[i].Value == field {
+				sorted = append(sorted, root.Content[i], root.Content[i+1])
+				used[i] = true
+			}
+		}
+	}
+	for i := 0; i+1 < len(root.Content); i += 2 {
+		if !used[i] {
+			sorted = append(sorted, root.Content[i], root.Content[i+1])
+		}
+	}
+	root.Content = sorted
+}
+
+// annotate adds the origins of the fields as comments to the nodes of the document
+func (d *Devfile) annotate(node *yaml.Node, path []string) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 0; i+1 < len(node.Content); i += 2 {
+			keyNode, valueNode := node.Content[i], node.Content[i+1]
+			fieldPath := append(append([]string{}, path...), keyNode.Value)
+			if origin, ok := d.Origins[strings.Join(fieldPath, "/")]; ok {
+				setComment(keyNode, valueNode, origin)
+			}
+			d.annotate(valueNode, fieldPath)
+		}
+	case yaml.SequenceNode:
+		for i, item := range node.Content {
+			itemPath := append(append([]string{}, path...), itemName(item, i))
+			// the origin of an element of a list is shown on its first field
+			if origin, ok := d.Origins[strings.Join(itemPath, "/")]; ok {
+				if item.Kind == yaml.MappingNode && len(item.Content) > 1 {
+					setComment(item.Content[0], item.Content[1], origin)
+				} else {
+					item.LineComment = addComment(item.LineComment, origin)
+				}
+			}
+			d.annotate(item, itemPath)
+		}
+	}
+}
+
+// itemName returns the name or the id of the item of a list, or its index if it has none, like elementName
+func itemName(item *yaml.Node, i int) string {
+	if item.Kind == yaml.MappingNode {
+		for _, key := range []string{"name", "id"} {
+			for j := 0; j+1 < len(item.Content); j += 2 {
+				if item.Content[j].Value == key && item.Content[j+1].Value != "" {
+					return item.Content[j+1].Value
+				}
+			}
+		}
+	}
+	return strconv.Itoa(i)
+}
+
+// nameFirst moves the name or the id of the items of the lists to their first field
+func nameFirst(node *yaml.Node) {
+	switch node.Kind {
+	case yaml.MappingNode:
+		for i := 1; i < len(node.Content); i += 2 {
+			nameFirst(node.Content[i])
+		}
+	case yaml.SequenceNode:
+		for _, item := range node.Content {
+			if item.Kind == yaml.MappingNode {
+				for j := 2; j+1 < len(item.Content); j += 2 {
+					if key := item.Content[j].Value; key == "name" || key == "id" {
+						fields := append([]*yaml.Node{item.Content[j], item.Content[j+1]}, item.Content[:j]...)
+						item.Content = append(fields, item.Content[j+2:]...)
+						break
+					}
+				}
+			}
+			nameFirst(item)
+		}
+	}
+}
+
+// setComment adds the comment to the line of the field, after its value if it's a scalar
+func setComment(keyNode, valueNode *yaml.Node, text string) {
+	if valueNode.Kind == yaml.ScalarNode {
+		valueNode.LineComment = addComment(valueNode.LineComment, text)
+		return
+	}
+	keyNode.LineComment = addComment(keyNode.LineComment, text)
+}
+
+func addComment(comment, text string) string {
+	if comment == "" {
+		return "# " + text
+	}
+	return comment + "; " + text
+}
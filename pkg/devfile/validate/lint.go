@@ -0,0 +1,417 @@
+package validate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	v2Validation "github.com/devfile/api/v2/pkg/validation"
+	"github.com/devfile/api/v2/pkg/validation/variables"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/pkg/errors"
+	"gopkg.in/yaml.v3"
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// Severity is the severity of a problem of a devfile
+type Severity string
+
+const (
+	// SeverityError is the severity of the problems preventing odo from using the devfile
+	SeverityError Severity = "error"
+	// SeverityWarning is the severity of the problems odo can live with, like deprecated fields
+	SeverityWarning Severity = "warning"
+)
+
+// Lint rules
+const (
+	RuleYAMLSyntax             = "yaml-syntax"
+	RuleSchema                 = "schema"
+	RuleParse                  = "parse"
+	RuleDevfileValidation      = "devfile-validation"
+	RuleUndefinedVariable      = "undefined-variable"
+	RuleNoComponents           = "no-components"
+	RuleNoContainerComponent   = "no-container-component"
+	RuleUnsupportedCommandType = "unsupported-command-type"
+	RuleCompositeRunKind       = "composite-run-kind"
+	RuleUnsupportedField       = "unsupported-field"
+	RuleEndpointConflict       = "endpoint-conflict"
+	RuleMountSources           = "mount-sources"
+	RuleVolumeSize             = "volume-size"
+	RuleDeprecatedField        = "deprecated-field"
+)
+
+// Rules describes the lint rules
+var Rules = map[string]string{
+	RuleYAMLSyntax:             "The devfile must be a valid YAML document",
+	RuleSchema:                 "The devfile must be valid against the JSON schema of its schemaVersion",
+	RuleParse:                  "The devfile and its parent must be parsed successfully",
+	RuleDevfileValidation:      "The devfile must pass the generic devfile validation",
+	RuleUndefinedVariable:      "The variables referenced by the devfile must be defined",
+	RuleNoComponents:           "The devfile must define components",
+	RuleNoContainerComponent:   "odo requires at least one container component",
+	RuleUnsupportedCommandType: "odo only supports exec and composite commands",
+	RuleCompositeRunKind:       "odo doesn't support composite commands of run kind",
+	RuleUnsupportedField:       "The field is not supported by odo",
+	RuleEndpointConflict:       "The names and the target ports of the endpoints must be unique across the containers",
+	RuleMountSources:           "The sources must be mounted in the containers running the commands",
+	RuleVolumeSize:             "The size of the volumes must be a valid quantity, like 1Gi",
+	RuleDeprecatedField:        "The field is deprecated",
+}
+
+// Diagnostic is a problem found in a devfile
+type Diagnostic struct {
+	Rule     string   `json:"rule"`
+	Severity Severity `json:"severity"`
+	Message  string   `json:"message"`
+	// Path is the path of the field of the problem, like components[runtime].container.endpoints[http].targetPort
+	Path string `json:"path,omitempty"`
+	// Line and Column are the position of the field in the devfile, starting at 1, 0 if unknown
+	Line   int `json:"line,omitempty"`
+	Column int `json:"column,omitempty"`
+}
+
+// String returns the diagnostic as line:column: severity: message [rule]
+func (d Diagnostic) String() string {
+	return fmt.Sprintf("%d:%d: %s: %s [%s]", d.Line, d.Column, d.Severity, d.Message, d.Rule)
+}
+
+// deprecatedProjectFields are the fields of the projects and starter projects accepted by the schema 2.0.0, but ignored by odo
+var deprecatedProjectFields = map[string]string{
+	"github":             "the github project source is deprecated and ignored by odo, use git instead",
+	"sparseCheckoutDirs": "sparseCheckoutDirs is deprecated and ignored by odo, use subDir instead",
+}
+
+// deprecatedMetadataFields are the alpha metadata fields of the experimental deployment of odo, which has been removed
+var deprecatedMetadataFields = map[string]string{
+	"alpha.build-dockerfile":    "alpha.build-dockerfile is deprecated and ignored by odo",
+	"alpha.deployment-manifest": "alpha.deployment-manifest is deprecated and ignored by odo",
+}
+
+var yamlErrorLine = regexp.MustCompile(`line (\d+)`)
+
+// linter collects the problems of a devfile
+type linter struct {
+	document    *yaml.Node
+	diagnostics []Diagnostic
+}
+
+func (l *linter) report(rule string, severity Severity, path fieldPath, format string, a ...interface{}) {
+	line, column := locate(l.document, path)
+	l.diagnostics = append(l.diagnostics, Diagnostic{
+		Rule:     rule,
+		Severity: severity,
+		Message:  fmt.Sprintf(format, a...),
+		Path:     path.String(),
+		Line:     line,
+		Column:   column,
+	})
+}
+
+// LintDevfile runs the generic devfile validation and the odo validations on the devfile, and returns all the problems
+// found instead of the first one, sorted by their position in the devfile. The error is only returned if the devfile can't be read
+func LintDevfile(devfilePath string) ([]Diagnostic, error) {
+	content, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the devfile %s", devfilePath)
+	}
+
+	l := &linter{document: &yaml.Node{}}
+	if err = yaml.Unmarshal(content, l.document); err != nil {
+		line := 0
+		if match := yamlErrorLine.FindStringSubmatch(err.Error()); match != nil {
+			line, _ = strconv.Atoi(match[1])
+		}
+		l.diagnostics = append(l.diagnostics, Diagnostic{Rule: RuleYAMLSyntax, Severity: SeverityError, Message: err.Error(), Line: line})
+		return l.diagnostics, nil
+	}
+
+	l.lintDeprecatedFields()
+
+	devObj, err := parser.ParseDevfile(parser.ParserArgs{Path: devfilePath})
+	if err != nil {
+		l.reportParseError(err)
+		return l.sorted(), nil
+	}
+	if devObj.Data.GetSchemaVersion() != "2.0.0" {
+		l.lintVariables(variables.ValidateAndReplaceGlobalVariable(devObj.Data.GetDevfileWorkspaceSpec()))
+	}
+
+	components, err := devObj.Data.GetComponents(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	commands, err := devObj.Data.GetCommands(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	projects, err := devObj.Data.GetProjects(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	starterProjects, err := devObj.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+	if err != nil {
+		return nil, err
+	}
+	events := devObj.Data.GetEvents()
+
+	l.lintGeneric(components, commands, events, projects, starterProjects)
+	l.lintComponents(components)
+	l.lintCommands(commands)
+	if err := validatePreStart(events.PreStart); err != nil {
+		l.report(RuleUnsupportedField, SeverityError, fieldPath{key("events"), key("preStart")}, "%v", err)
+	}
+	l.lintEndpoints(components)
+	l.lintMountSources(components, commands)
+	l.lintVolumeSizes(components)
+
+	return l.sorted(), nil
+}
+
+// sorted returns the diagnostics sorted by their position in the devfile
+func (l *linter) sorted() []Diagnostic {
+	sort.SliceStable(l.diagnostics, func(i, j int) bool {
+		if l.diagnostics[i].Line != l.diagnostics[j].Line {
+			return l.diagnostics[i].Line < l.diagnostics[j].Line
+		}
+		return l.diagnostics[i].Column < l.diagnostics[j].Column
+	})
+	return l.diagnostics
+}
+
+// reportParseError reports the errors of the JSON schema validation one by one, or the error of the parser
+func (l *linter) reportParseError(err error) {
+	message := err.Error()
+	if !strings.HasPrefix(message, "invalid devfile schema") {
+		l.report(RuleParse, SeverityError, nil, "%s", message)
+		return
+	}
+	for _, line := range strings.Split(message, "\n") {
+		line = strings.TrimPrefix(strings.TrimSpace(line), "- ")
+		if line == "" || strings.HasPrefix(line, "invalid devfile schema") {
+			continue
+		}
+		var path fieldPath
+		if i := strings.Index(line, ": "); i > 0 {
+			path = parseSchemaField(line[:i])
+			line = line[i+2:]
+		}
+		l.report(RuleSchema, SeverityError, path, "%s", line)
+	}
+}
+
+// lintDeprecatedFields reports the deprecated fields, which are dropped by the parser and can only be found in the YAML document
+func (l *linter) lintDeprecatedFields() {
+	root := l.document
+	if root.Kind == yaml.DocumentNode && len(root.Content) > 0 {
+		root = root.Content[0]
+	}
+	if root.Kind != yaml.MappingNode {
+		return
+	}
+	for _, section := range []string{"projects", "starterProjects"} {
+		_, projects := mappingValue(root, section)
+		if projects == nil || projects.Kind != yaml.SequenceNode {
+			continue
+		}
+		for i, project := range projects.Content {
+			if project.Kind != yaml.MappingNode {
+				continue
+			}
+			for j := 0; j+1 < len(project.Content); j += 2 {
+				field := project.Content[j].Value
+				if message, ok := deprecatedProjectFields[field]; ok {
+					l.report(RuleDeprecatedField, SeverityWarning, fieldPath{key(section), index(i), key(field)}, "%s", message)
+				}
+			}
+		}
+	}
+	if _, metadata := mappingValue(root, "metadata"); metadata != nil && metadata.Kind == yaml.MappingNode {
+		for j := 0; j+1 < len(metadata.Content); j += 2 {
+			field := metadata.Content[j].Value
+			if message, ok := deprecatedMetadataFields[field]; ok {
+				l.report(RuleDeprecatedField, SeverityWarning, fieldPath{key("metadata"), key(field)}, "%s", message)
+			}
+		}
+	}
+}
+
+// lintVariables reports the references to undefined variables
+func (l *linter) lintVariables(warning variables.VariableWarning) {
+	sections := []struct {
+		name     string
+		warnings map[string][]string
+	}{
+		{"commands", warning.Commands},
+		{"components", warning.Components},
+		{"projects", warning.Projects},
+		{"starterProjects", warning.StarterProjects},
+	}
+	for _, section := range sections {
+		names := make([]string, 0, len(section.warnings))
+		for name := range section.warnings {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		for _, name := range names {
+			l.report(RuleUndefinedVariable, SeverityWarning, fieldPath{key(section.name), item(name)}, "undefined variable(s) %s", strings.Join(section.warnings[name], ", "))
+		}
+	}
+}
+
+// lintGeneric runs the generic devfile validation of each section of the devfile
+func (l *linter) lintGeneric(components []devfilev1.Component, commands []devfilev1.Command, events devfilev1.Events, projects []devfilev1.Project, starterProjects []devfilev1.StarterProject) {
+	// the endpoints and the sizes of the volumes are checked by the odo rules, which report all the problems
+	// instead of the first one, so that the generic validation reports the other problems of the components
+	genericComponents := make([]devfilev1.Component, len(components))
+	for i, component := range components {
+		if component.Container != nil {
+			container := *component.Container
+			container.Endpoints = nil
+			component.Container = &container
+		}
+		if component.Volume != nil {
+			volume := *component.Volume
+			volume.Size = ""
+			component.Volume = &volume
+		}
+		genericComponents[i] = component
+	}
+
+	sections := []struct {
+		name string
+		err  error
+	}{
+		{"components", v2Validation.ValidateComponents(genericComponents)},
+		{"commands", v2Validation.ValidateCommands(commands, components)},
+		{"events", v2Validation.ValidateEvents(events, commands)},
+		{"projects", v2Validation.ValidateProjects(projects)},
+		{"starterProjects", v2Validation.ValidateStarterProjects(starterProjects)},
+	}
+	for _, section := range sections {
+		if section.err != nil {
+			// the generic errors can span several lines
+			l.report(RuleDevfileValidation, SeverityError, fieldPath{key(section.name)}, "%s", strings.Join(strings.Fields(section.err.Error()), " "))
+		}
+	}
+}
+
+// lintComponents reports the devfiles without components or without container components
+func (l *linter) lintComponents(components []devfilev1.Component) {
+	switch err := validateComponents(components).(type) {
+	case *NoComponentsError:
+		l.report(RuleNoComponents, SeverityError, fieldPath{key("components")}, "%v", err)
+	case *NoContainerComponentError:
+		l.report(RuleNoContainerComponent, SeverityError, fieldPath{key("components")}, "%v", err)
+	}
+}
+
+// lintCommands reports the commands not supported by odo
+func (l *linter) lintCommands(commands []devfilev1.Command) {
+	for _, command := range commands {
+		switch err := validateCommand(command).(type) {
+		case *UnsupportedOdoCommandError:
+			l.report(RuleUnsupportedCommandType, SeverityError, fieldPath{key("commands"), item(command.Id)}, "%v", err)
+		case *CompositeRunKindError:
+			l.report(RuleCompositeRunKind, SeverityError, fieldPath{key("commands"), item(command.Id), key("composite"), key("group"), key("kind")}, "command %q: %v", command.Id, err)
+		}
+	}
+}
+
+// lintEndpoints reports the endpoints whose name is already used, and the target ports exposed by several containers,
+// which conflict as the containers of the component share the network of the pod
+func (l *linter) lintEndpoints(components []devfilev1.Component) {
+	names := map[string]string{}
+	ports := map[int]string{}
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		componentPorts := map[int]bool{}
+		for _, endpoint := range component.Container.Endpoints {
+			path := fieldPath{key("components"), item(component.Name), key("container"), key("endpoints"), item(endpoint.Name)}
+			if other, ok := names[endpoint.Name]; ok {
+				l.report(RuleEndpointConflict, SeverityError, path, "the endpoint name %q of the component %q is already used by the component %q", endpoint.Name, component.Name, other)
+			} else {
+				names[endpoint.Name] = component.Name
+			}
+			// two endpoints of the same container can expose the same port
+			if componentPorts[endpoint.TargetPort] {
+				continue
+			}
+			componentPorts[endpoint.TargetPort] = true
+			if other, ok := ports[endpoint.TargetPort]; ok {
+				l.report(RuleEndpointConflict, SeverityError, append(path, key("targetPort")), "the target port %d of the component %q is already exposed by the component %q", endpoint.TargetPort, component.Name, other)
+			} else {
+				ports[endpoint.TargetPort] = component.Name
+			}
+		}
+	}
+}
+
+// lintMountSources reports the devfiles without any container mounting the sources, which odo synchronizes the project
+// with, and the exec commands running in containers without the sources
+func (l *linter) lintMountSources(components []devfilev1.Component, commands []devfilev1.Command) {
+	mountSources := map[string]bool{}
+	var firstContainer string
+	for _, component := range components {
+		if component.Container == nil {
+			continue
+		}
+		if firstContainer == "" {
+			firstContainer = component.Name
+		}
+		mountSources[component.Name] = component.Container.MountSources == nil || *component.Container.MountSources
+	}
+	if firstContainer == "" {
+		return
+	}
+
+	mounted := false
+	for _, m := range mountSources {
+		mounted = mounted || m
+	}
+	if !mounted {
+		l.report(RuleMountSources, SeverityError, fieldPath{key("components"), item(firstContainer), key("container"), key("mountSources")}, "none of the container components mounts the sources, odo requires at least one container with mountSources enabled to synchronize the project")
+		return
+	}
+
+	for _, command := range commands {
+		if command.Exec == nil {
+			continue
+		}
+		if m, ok := mountSources[command.Exec.Component]; ok && !m {
+			l.report(RuleMountSources, SeverityWarning, fieldPath{key("commands"), item(command.Id), key("exec"), key("component")}, "the command %q runs in the component %q, which doesn't mount the sources", command.Id, command.Exec.Component)
+		}
+	}
+}
+
+// lintVolumeSizes reports the sizes of the volumes which aren't valid quantities
+func (l *linter) lintVolumeSizes(components []devfilev1.Component) {
+	for _, component := range components {
+		if component.Volume == nil || component.Volume.Size == "" {
+			continue
+		}
+		if _, err := resource.ParseQuantity(component.Volume.Size); err != nil {
+			l.report(RuleVolumeSize, SeverityError, fieldPath{key("components"), item(component.Name), key("volume"), key("size")}, "the size %q of the volume %q is invalid, use a quantity like 1Gi or 512Mi", component.Volume.Size, component.Name)
+		}
+	}
+}
+
+// CountBySeverity returns the number of errors and warnings of the diagnostics
+func CountBySeverity(diagnostics []Diagnostic) (int, int) {
+	errorCount, warningCount := 0, 0
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == SeverityError {
+			errorCount++
+		} else {
+			warningCount++
+		}
+	}
+	return errorCount, warningCount
+}
@@ -0,0 +1,189 @@
+package validate
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+
+	"gopkg.in/yaml.v3"
+)
+
+func TestLintDevfile(t *testing.T) {
+	tests := []struct {
+		name    string
+		devfile string
+		// want are the rules of the diagnostics with their line
+		want []string
+	}{
+		{
+			name: "valid devfile",
+			devfile: `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: nodejs
+      endpoints:
+        - name: http
+          targetPort: 3000
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+      group:
+        kind: run
+`,
+			want: []string{},
+		},
+		{
+			name: "all the odo problems are reported",
+			devfile: `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+  alpha.build-dockerfile: ./Dockerfile
+components:
+  - name: runtime
+    container:
+      image: nodejs
+      mountSources: false
+      endpoints:
+        - name: http
+          targetPort: 3000
+  - name: tools
+    container:
+      image: busybox
+      endpoints:
+        - name: http
+          targetPort: 3000
+  - name: data
+    volume:
+      size: 1Gb
+commands:
+  - id: run
+    exec:
+      component: runtime
+      commandLine: npm start
+  - id: all
+    composite:
+      commands: [run]
+      group:
+        kind: run
+`,
+			want: []string{
+				"deprecated-field:4",
+				"endpoint-conflict:17",
+				"endpoint-conflict:18",
+				"volume-size:21",
+				"mount-sources:25",
+				"composite-run-kind:31",
+			},
+		},
+		{
+			name: "schema errors are located",
+			devfile: `schemaVersion: 2.1.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      endpoints:
+        - name: http
+`,
+			want: []string{"schema:6", "schema:8"},
+		},
+		{
+			name: "no container mounts the sources",
+			devfile: `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+components:
+  - name: runtime
+    container:
+      image: nodejs
+      mountSources: false
+`,
+			want: []string{"mount-sources:8"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "odo-lint")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			devfilePath := filepath.Join(dir, "devfile.yaml")
+			if err = ioutil.WriteFile(devfilePath, []byte(tt.devfile), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			diagnostics, err := LintDevfile(devfilePath)
+			if err != nil {
+				t.Fatalf("LintDevfile() unexpected error: %v", err)
+			}
+			got := []string{}
+			for _, diagnostic := range diagnostics {
+				got = append(got, fmt.Sprintf("%s:%d", diagnostic.Rule, diagnostic.Line))
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("LintDevfile() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestLocate(t *testing.T) {
+	var document yaml.Node
+	err := yaml.Unmarshal([]byte(`components:
+  - name: runtime
+    container:
+      endpoints:
+        - name: http
+          targetPort: 3000
+`), &document)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		path       fieldPath
+		wantString string
+		wantLine   int
+		wantColumn int
+	}{
+		{
+			path:       fieldPath{key("components"), item("runtime"), key("container"), key("endpoints"), item("http"), key("targetPort")},
+			wantString: "components[runtime].container.endpoints[http].targetPort",
+			wantLine:   6,
+			wantColumn: 11,
+		},
+		{
+			path:       parseSchemaField("components.0.container"),
+			wantString: "components[0].container",
+			wantLine:   3,
+			wantColumn: 5,
+		},
+		{
+			path:       fieldPath{key("components"), item("tools"), key("container")},
+			wantString: "components[tools].container",
+			wantLine:   1,
+			wantColumn: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.wantString, func(t *testing.T) {
+			if got := tt.path.String(); got != tt.wantString {
+				t.Errorf("fieldPath.String() = %q, want %q", got, tt.wantString)
+			}
+			line, column := locate(&document, tt.path)
+			if line != tt.wantLine || column != tt.wantColumn {
+				t.Errorf("locate() = %d:%d, want %d:%d", line, column, tt.wantLine, tt.wantColumn)
+			}
+		})
+	}
+}
@@ -0,0 +1,127 @@
+package validate
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+
+	"gopkg.in/yaml.v3"
+)
+
+// pathSegment is a segment of the path of a field of the devfile: the key of a mapping, the index of a sequence item,
+// or the name of a sequence item identified by its name (or id for the commands)
+type pathSegment struct {
+	key   string
+	index int
+	name  string
+}
+
+func key(k string) pathSegment {
+	return pathSegment{key: k, index: -1}
+}
+
+func item(name string) pathSegment {
+	return pathSegment{index: -1, name: name}
+}
+
+func index(i int) pathSegment {
+	return pathSegment{index: i}
+}
+
+// fieldPath is the path of a field of the devfile
+type fieldPath []pathSegment
+
+// String returns the path of the field, like components[runtime].container.endpoints[0].targetPort
+func (p fieldPath) String() string {
+	var b strings.Builder
+	for _, segment := range p {
+		switch {
+		case segment.key != "":
+			if b.Len() > 0 {
+				b.WriteString(".")
+			}
+			b.WriteString(segment.key)
+		case segment.name != "":
+			fmt.Fprintf(&b, "[%s]", segment.name)
+		default:
+			fmt.Fprintf(&b, "[%d]", segment.index)
+		}
+	}
+	return b.String()
+}
+
+// parseSchemaField parses the field of a JSON schema validation error, like components.0.container, into a path
+func parseSchemaField(field string) fieldPath {
+	var path fieldPath
+	for _, part := range strings.Split(field, ".") {
+		if part == "" || part == "(root)" {
+			continue
+		}
+		if i, err := strconv.Atoi(part); err == nil {
+			path = append(path, index(i))
+			continue
+		}
+		path = append(path, key(part))
+	}
+	return path
+}
+
+// locate returns the line and column of the field of the YAML document. If the field doesn't exist, for example because
+// it's inherited from a parent devfile, the position of its closest existing parent is returned
+func locate(document *yaml.Node, path fieldPath) (int, int) {
+	if document == nil {
+		return 0, 0
+	}
+	node := document
+	if node.Kind == yaml.DocumentNode && len(node.Content) > 0 {
+		node = node.Content[0]
+	}
+	line, column := node.Line, node.Column
+	for _, segment := range path {
+		var next *yaml.Node
+		var keyNode *yaml.Node
+		switch {
+		case segment.key != "" && node.Kind == yaml.MappingNode:
+			keyNode, next = mappingValue(node, segment.key)
+		case segment.name != "" && node.Kind == yaml.SequenceNode:
+			for _, child := range node.Content {
+				if child.Kind != yaml.MappingNode {
+					continue
+				}
+				_, name := mappingValue(child, "name")
+				if name == nil {
+					_, name = mappingValue(child, "id")
+				}
+				if name != nil && name.Value == segment.name {
+					next = child
+					break
+				}
+			}
+		case segment.key == "" && segment.name == "" && node.Kind == yaml.SequenceNode:
+			if segment.index >= 0 && segment.index < len(node.Content) {
+				next = node.Content[segment.index]
+			}
+		}
+		if next == nil {
+			break
+		}
+		node = next
+		// the position of a mapping entry is the position of its key
+		if keyNode != nil {
+			line, column = keyNode.Line, keyNode.Column
+		} else {
+			line, column = node.Line, node.Column
+		}
+	}
+	return line, column
+}
+
+// mappingValue returns the key and value nodes of the key of the mapping node
+func mappingValue(node *yaml.Node, k string) (*yaml.Node, *yaml.Node) {
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == k {
+			return node.Content[i], node.Content[i+1]
+		}
+	}
+	return nil, nil
+}
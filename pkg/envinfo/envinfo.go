@@ -13,6 +13,7 @@ import (
 	"github.com/openshift/odo/pkg/testingutil/filesystem"
 
 	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog"
 
@@ -43,6 +44,12 @@ type ComponentSettings struct {
 
 	// RunMode indicates the mode of run used for a successful push
 	RunMode *RUNMode `yaml:"RunMode,omitempty" json:"runMode,omitempty"`
+
+	// Variable overrides the values of the variables of the devfile, by variable name
+	Variable map[string]string `yaml:"Variables,omitempty" json:"variables,omitempty"`
+
+	// StorageSize overrides the sizes of the volumes of the devfile, by volume name
+	StorageSize map[string]string `yaml:"StorageSizes,omitempty" json:"storageSizes,omitempty"`
 }
 
 type RUNMode string
@@ -68,13 +75,20 @@ type EnvInfo struct {
 	devfileObj        parser.DevfileObj
 	isRouteSupported  bool
 	updateURL         bool              // this indicates that the URL create operation should be an update operation
+	contextDir        string            // the context of the component, the local files of the URLs are relative to it
 	componentSettings ComponentSettings `yaml:"ComponentSettings,omitempty"`
 }
 
-// proxyEnvInfo holds all the parameter that envinfo does but exposes all
-// of it, used for serialization.
+// proxyEnvInfo holds the environments of the env file, used for serialization.
 type proxyEnvInfo struct {
-	ComponentSettings ComponentSettings `yaml:"ComponentSettings,omitempty"`
+	// ComponentSettings holds the settings of the env files created before the named environments,
+	// they are migrated to the default environment when reading the file
+	ComponentSettings *ComponentSettings `yaml:"ComponentSettings,omitempty"`
+
+	// CurrentEnvironment is the environment used when no environment is specified
+	CurrentEnvironment string `yaml:"CurrentEnvironment,omitempty"`
+
+	Environments []Environment `yaml:"Environments,omitempty"`
 }
 
 // EnvSpecificInfo wraps the envinfo and provides helpers to
@@ -85,6 +99,8 @@ type EnvSpecificInfo struct {
 	fs                filesystem.Filesystem
 	EnvInfo           `yaml:",omitempty"`
 	envinfoFileExists bool
+	// environment is the name of the environment of the env file this envinfo holds the settings of
+	environment string
 }
 
 func WrapForJSONOutput(compSettings ComponentSettings) JSONEnvInfoRepr {
@@ -124,13 +140,19 @@ func New() (*EnvSpecificInfo, error) {
 	return NewEnvSpecificInfo("")
 }
 
-// NewEnvSpecificInfo retrieves the environment file. If it does not exist, it returns *blank*
+// NewEnvSpecificInfo retrieves the current environment of the environment file. If it does not exist, it returns *blank*
 func NewEnvSpecificInfo(envDir string) (*EnvSpecificInfo, error) {
-	return newEnvSpecificInfo(envDir, filesystem.DefaultFs{})
+	return newEnvSpecificInfo(envDir, "", filesystem.DefaultFs{})
 }
 
-// newEnvSpecificInfo retrieves the env.yaml file, if it does not exist, we return a *BLANK* environment file.
-func newEnvSpecificInfo(envDir string, fs filesystem.Filesystem) (*EnvSpecificInfo, error) {
+// NewEnvSpecificInfoForEnvironment retrieves the given environment of the environment file, or the current one if the
+// environment is empty. If the environment file does not exist, it returns *blank*
+func NewEnvSpecificInfoForEnvironment(envDir, environment string) (*EnvSpecificInfo, error) {
+	return newEnvSpecificInfo(envDir, environment, filesystem.DefaultFs{})
+}
+
+// newEnvSpecificInfo retrieves the environment of the env.yaml file, if it does not exist, we return a *BLANK* environment file.
+func newEnvSpecificInfo(envDir, environment string, fs filesystem.Filesystem) (*EnvSpecificInfo, error) {
 	// Get the path of the environment file
 	envInfoFile, devfilePath, err := getEnvInfoFile(envDir)
 	if err != nil {
@@ -144,34 +166,40 @@ func newEnvSpecificInfo(envDir string, fs filesystem.Filesystem) (*EnvSpecificIn
 		Filename:          envInfoFile,
 		envinfoFileExists: true,
 		fs:                fs,
+		environment:       environment,
 	}
+	e.contextDir = filepath.Dir(devfilePath)
 
 	// If the env.yaml file does not exist then we simply return and set e.envinfoFileExists as false
 	if _, err = e.fs.Stat(envInfoFile); os.IsNotExist(err) {
 		e.envinfoFileExists = false
+		if e.environment == "" {
+			e.environment = DefaultEnvironment
+		}
 		return &e, nil
 	}
 
 	// Retrieve the environment file
-	err = getFromFile(&e.EnvInfo, e.Filename)
+	proxyei, err := readEnvFile(e.Filename)
 	if err != nil {
 		return nil, err
 	}
+	if e.environment == "" {
+		e.environment = proxyei.currentEnvironment()
+	}
+	settings, ok := proxyei.getEnvironment(e.environment)
+	if !ok {
+		if environment != "" {
+			return nil, errors.Errorf("the environment %q doesn't exist, please refer `odo env create --help` to create it", environment)
+		}
+		e.envinfoFileExists = false
+		return &e, nil
+	}
+	e.componentSettings = settings
 
 	return &e, nil
 }
 
-func getFromFile(envinfo *EnvInfo, filename string) error {
-	proxyei := newProxyEnvInfo()
-
-	err := util.GetFromFile(&proxyei, filename)
-	if err != nil {
-		return err
-	}
-	envinfo.componentSettings = proxyei.ComponentSettings
-	return nil
-}
-
 // NewEnvInfo creates an empty EnvSpecificInfo struct with typeMeta populated
 func NewEnvInfo() EnvInfo {
 	return EnvInfo{}
@@ -206,6 +234,27 @@ func (esi *EnvSpecificInfo) SetConfiguration(parameter string, value interface{}
 			} else {
 				esi.componentSettings.URL = &[]localConfigProvider.LocalURL{urlValue}
 			}
+		case "variable":
+			name, val, err := parseOverride(value.(string))
+			if err != nil {
+				return err
+			}
+			if esi.componentSettings.Variable == nil {
+				esi.componentSettings.Variable = map[string]string{}
+			}
+			esi.componentSettings.Variable[name] = val
+		case "storagesize":
+			name, size, err := parseOverride(value.(string))
+			if err != nil {
+				return err
+			}
+			if _, err = resource.ParseQuantity(size); err != nil {
+				return errors.Wrapf(err, "invalid size %q for the volume %s", size, name)
+			}
+			if esi.componentSettings.StorageSize == nil {
+				esi.componentSettings.StorageSize = map[string]string{}
+			}
+			esi.componentSettings.StorageSize[name] = size
 		}
 
 		return esi.writeToFile()
@@ -298,9 +347,20 @@ func (esi *EnvSpecificInfo) SetComponentSettings(cs ComponentSettings) error {
 	return esi.writeToFile()
 }
 
+// writeToFile writes the settings of the environment of the envinfo to the env file, keeping the other environments
 func (esi *EnvSpecificInfo) writeToFile() error {
 	proxyei := newProxyEnvInfo()
-	proxyei.ComponentSettings = esi.componentSettings
+	if util.CheckPathExists(esi.Filename) {
+		var err error
+		proxyei, err = readEnvFile(esi.Filename)
+		if err != nil {
+			return err
+		}
+	}
+	proxyei.setEnvironment(esi.GetEnvironment(), esi.componentSettings)
+	if proxyei.CurrentEnvironment == "" {
+		proxyei.CurrentEnvironment = esi.GetEnvironment()
+	}
 
 	return util.WriteToFile(&proxyei, esi.Filename)
 }
@@ -414,15 +474,25 @@ const (
 	Push = "PUSH"
 	// PushDescription is the description of push parameter
 	PushDescription = "Push parameter is the action to write devfile commands to env.yaml"
+	// Variable is the name of the setting overriding the value of a devfile variable
+	Variable = "Variable"
+	// VariableDescription is the human-readable description for variable setting
+	VariableDescription = "Set this value to NAME=VALUE to override the value of the devfile variable NAME in the environment"
+	// StorageSize is the name of the setting overriding the size of a devfile volume
+	StorageSize = "StorageSize"
+	// StorageSizeDescription is the human-readable description for storage size setting
+	StorageSizeDescription = "Set this value to NAME=SIZE to override the size of the devfile volume NAME in the environment"
 )
 
 var (
 	supportedLocalParameterDescriptions = map[string]string{
-		Name:      NameDescription,
-		Project:   ProjectDescription,
-		DebugPort: DebugPortDescription,
-		URL:       URLDescription,
-		Push:      PushDescription,
+		Name:        NameDescription,
+		Project:     ProjectDescription,
+		DebugPort:   DebugPortDescription,
+		URL:         URLDescription,
+		Push:        PushDescription,
+		Variable:    VariableDescription,
+		StorageSize: StorageSizeDescription,
 	}
 
 	lowerCaseLocalParameters = util.GetLowerCaseParameters(GetLocallySupportedParameters())
@@ -0,0 +1,161 @@
+package envinfo
+
+import (
+	"strings"
+
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+)
+
+// DefaultEnvironment is the name of the environment used when no environment has been created,
+// the env files created before the named environments are migrated to it
+const DefaultEnvironment = "default"
+
+// Environment holds the settings of a named environment of the component, like dev or perf,
+// each environment having its own namespace, application, URLs and overrides
+type Environment struct {
+	Name              string            `yaml:"Name" json:"name"`
+	ComponentSettings ComponentSettings `yaml:"ComponentSettings,omitempty" json:"componentSettings"`
+}
+
+// readEnvFile reads the environments of the env file, the settings of the env files without environments
+// are migrated to the default environment
+func readEnvFile(filename string) (proxyEnvInfo, error) {
+	proxyei := newProxyEnvInfo()
+	err := util.GetFromFile(&proxyei, filename)
+	if err != nil {
+		return proxyei, err
+	}
+	if proxyei.ComponentSettings != nil {
+		if len(proxyei.Environments) == 0 {
+			proxyei.Environments = []Environment{{Name: DefaultEnvironment, ComponentSettings: *proxyei.ComponentSettings}}
+		}
+		proxyei.ComponentSettings = nil
+	}
+	return proxyei, nil
+}
+
+// currentEnvironment returns the name of the current environment of the env file
+func (proxyei *proxyEnvInfo) currentEnvironment() string {
+	if proxyei.CurrentEnvironment == "" {
+		return DefaultEnvironment
+	}
+	return proxyei.CurrentEnvironment
+}
+
+// getEnvironment returns the settings of the environment with the given name
+func (proxyei *proxyEnvInfo) getEnvironment(name string) (ComponentSettings, bool) {
+	for _, environment := range proxyei.Environments {
+		if environment.Name == name {
+			return environment.ComponentSettings, true
+		}
+	}
+	return ComponentSettings{}, false
+}
+
+// setEnvironment sets the settings of the environment with the given name, adding the environment if it doesn't exist
+func (proxyei *proxyEnvInfo) setEnvironment(name string, settings ComponentSettings) {
+	for i := range proxyei.Environments {
+		if proxyei.Environments[i].Name == name {
+			proxyei.Environments[i].ComponentSettings = settings
+			return
+		}
+	}
+	proxyei.Environments = append(proxyei.Environments, Environment{Name: name, ComponentSettings: settings})
+}
+
+// GetEnvironment returns the name of the environment the envinfo holds the settings of
+func (esi *EnvSpecificInfo) GetEnvironment() string {
+	if esi.environment == "" {
+		return DefaultEnvironment
+	}
+	return esi.environment
+}
+
+// ListEnvironments returns the environments of the env file and the name of the current environment
+func (esi *EnvSpecificInfo) ListEnvironments() ([]Environment, string, error) {
+	if !util.CheckPathExists(esi.Filename) {
+		return nil, DefaultEnvironment, nil
+	}
+	proxyei, err := readEnvFile(esi.Filename)
+	if err != nil {
+		return nil, "", err
+	}
+	return proxyei.Environments, proxyei.currentEnvironment(), nil
+}
+
+// CreateEnvironment adds the environment with the given name and settings to the env file
+func (esi *EnvSpecificInfo) CreateEnvironment(name string, settings ComponentSettings) error {
+	if err := util.ValidateK8sResourceName("environment name", name); err != nil {
+		return err
+	}
+	proxyei, err := readEnvFile(esi.Filename)
+	if err != nil {
+		return err
+	}
+	if _, ok := proxyei.getEnvironment(name); ok {
+		return errors.Errorf("the environment %q already exists", name)
+	}
+	proxyei.setEnvironment(name, settings)
+	if proxyei.CurrentEnvironment == "" {
+		// the default environment stays the current one when it exists, the created environment becomes
+		// the current one otherwise, as the commands would use a default environment which doesn't exist
+		if _, ok := proxyei.getEnvironment(DefaultEnvironment); ok {
+			proxyei.CurrentEnvironment = DefaultEnvironment
+		} else {
+			proxyei.CurrentEnvironment = name
+		}
+	}
+	return util.WriteToFile(&proxyei, esi.Filename)
+}
+
+// UseEnvironment makes the environment with the given name the current environment of the env file,
+// used by the commands when no environment is specified
+func (esi *EnvSpecificInfo) UseEnvironment(name string) error {
+	proxyei, err := readEnvFile(esi.Filename)
+	if err != nil {
+		return err
+	}
+	if _, ok := proxyei.getEnvironment(name); !ok {
+		return errors.Errorf("the environment %q doesn't exist, please refer `odo env create --help` to create it", name)
+	}
+	proxyei.CurrentEnvironment = name
+	return util.WriteToFile(&proxyei, esi.Filename)
+}
+
+// GetVariables returns the values of the devfile variables overridden in the environment
+func (ei *EnvInfo) GetVariables() map[string]string {
+	return ei.componentSettings.Variable
+}
+
+// IsOverrideSet returns true if the variable or the volume of the NAME=VALUE override of the given parameter
+// is already overridden in the environment
+func (esi *EnvSpecificInfo) IsOverrideSet(parameter, override string) bool {
+	name, _, err := parseOverride(override)
+	if err != nil {
+		return false
+	}
+	var overrides map[string]string
+	switch strings.ToLower(parameter) {
+	case "variable":
+		overrides = esi.componentSettings.Variable
+	case "storagesize":
+		overrides = esi.componentSettings.StorageSize
+	}
+	_, ok := overrides[name]
+	return ok
+}
+
+// IsOverrideParameter returns true if the value of the parameter is a NAME=VALUE override
+func IsOverrideParameter(parameter string) bool {
+	return strings.EqualFold(parameter, Variable) || strings.EqualFold(parameter, StorageSize)
+}
+
+// parseOverride parses a NAME=VALUE override
+func parseOverride(override string) (string, string, error) {
+	parts := strings.SplitN(override, "=", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", errors.Errorf("invalid value %q, please use the NAME=VALUE format", override)
+	}
+	return parts[0], parts[1], nil
+}
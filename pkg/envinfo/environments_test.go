@@ -0,0 +1,139 @@
+package envinfo
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestEnvironments(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-environments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	envFile := filepath.Join(dir, ".odo", "env", "env.yaml")
+	if err = os.MkdirAll(filepath.Dir(envFile), 0750); err != nil {
+		t.Fatal(err)
+	}
+	// an env file created before the named environments
+	err = ioutil.WriteFile(envFile, []byte("ComponentSettings:\n  Name: nodejs\n  Project: myproject\n  AppName: app\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(envInfoEnvName, envFile)
+	defer os.Unsetenv(envInfoEnvName)
+
+	esi, err := NewEnvSpecificInfo("")
+	if err != nil {
+		t.Fatalf("NewEnvSpecificInfo() unexpected error: %v", err)
+	}
+	if !esi.Exists() || esi.GetEnvironment() != DefaultEnvironment || esi.GetNamespace() != "myproject" {
+		t.Fatalf("the env file is not migrated to the default environment: exists %v, environment %q, namespace %q", esi.Exists(), esi.GetEnvironment(), esi.GetNamespace())
+	}
+
+	perf := esi.GetComponentSettings()
+	perf.Project = "perf"
+	if err = esi.CreateEnvironment("perf", perf); err != nil {
+		t.Fatalf("CreateEnvironment() unexpected error: %v", err)
+	}
+	if err = esi.CreateEnvironment("perf", perf); err == nil {
+		t.Errorf("CreateEnvironment() expected an error creating an existing environment")
+	}
+
+	perfInfo, err := NewEnvSpecificInfoForEnvironment("", "perf")
+	if err != nil {
+		t.Fatalf("NewEnvSpecificInfoForEnvironment() unexpected error: %v", err)
+	}
+	if perfInfo.GetNamespace() != "perf" {
+		t.Errorf("namespace of the perf environment = %q, want perf", perfInfo.GetNamespace())
+	}
+	if err = perfInfo.SetConfiguration("variable", "MEMORY=2Gi"); err != nil {
+		t.Fatalf("SetConfiguration() unexpected error: %v", err)
+	}
+	if err = perfInfo.SetConfiguration("storagesize", "data=big"); err == nil {
+		t.Errorf("SetConfiguration() expected an error for an invalid size")
+	}
+	if !perfInfo.IsOverrideSet(Variable, "MEMORY=4Gi") || perfInfo.IsOverrideSet(Variable, "CPU=2") {
+		t.Errorf("IsOverrideSet() doesn't match the overridden variables %v", perfInfo.GetVariables())
+	}
+
+	// writing an environment keeps the other environments
+	esi, err = NewEnvSpecificInfo("")
+	if err != nil {
+		t.Fatalf("NewEnvSpecificInfo() unexpected error: %v", err)
+	}
+	if esi.GetEnvironment() != DefaultEnvironment || esi.GetVariables() != nil {
+		t.Errorf("the current environment is %q with the variables %v, want the default environment", esi.GetEnvironment(), esi.GetVariables())
+	}
+
+	if err = esi.UseEnvironment("demo"); err == nil {
+		t.Errorf("UseEnvironment() expected an error for an environment which doesn't exist")
+	}
+	if err = esi.UseEnvironment("perf"); err != nil {
+		t.Fatalf("UseEnvironment() unexpected error: %v", err)
+	}
+	esi, err = NewEnvSpecificInfo("")
+	if err != nil {
+		t.Fatalf("NewEnvSpecificInfo() unexpected error: %v", err)
+	}
+	if want := map[string]string{"MEMORY": "2Gi"}; esi.GetEnvironment() != "perf" || !reflect.DeepEqual(esi.GetVariables(), want) {
+		t.Errorf("the current environment is %q with the variables %v, want perf with %v", esi.GetEnvironment(), esi.GetVariables(), want)
+	}
+
+	environments, current, err := esi.ListEnvironments()
+	if err != nil {
+		t.Fatalf("ListEnvironments() unexpected error: %v", err)
+	}
+	var names []string
+	for _, environment := range environments {
+		names = append(names, environment.Name)
+	}
+	if want := []string{DefaultEnvironment, "perf"}; current != "perf" || !reflect.DeepEqual(names, want) {
+		t.Errorf("ListEnvironments() = %v, %q, want %v, perf", names, current, want)
+	}
+
+	if _, err = NewEnvSpecificInfoForEnvironment("", "demo"); err == nil || !strings.Contains(err.Error(), "doesn't exist") {
+		t.Errorf("NewEnvSpecificInfoForEnvironment() expected an error for an environment which doesn't exist, got %v", err)
+	}
+}
+
+func TestCreateEnvironmentWithoutDefault(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-environments")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	envFile := filepath.Join(dir, ".odo", "env", "env.yaml")
+	if err = os.MkdirAll(filepath.Dir(envFile), 0750); err != nil {
+		t.Fatal(err)
+	}
+	// an env file without the default environment
+	err = ioutil.WriteFile(envFile, []byte("Environments:\n- Name: dev\n  ComponentSettings:\n    Name: nodejs\n    Project: dev\n"), 0600)
+	if err != nil {
+		t.Fatal(err)
+	}
+	os.Setenv(envInfoEnvName, envFile)
+	defer os.Unsetenv(envInfoEnvName)
+
+	esi, err := NewEnvSpecificInfoForEnvironment("", "dev")
+	if err != nil {
+		t.Fatalf("NewEnvSpecificInfoForEnvironment() unexpected error: %v", err)
+	}
+	perf := esi.GetComponentSettings()
+	perf.Project = "perf"
+	if err = esi.CreateEnvironment("perf", perf); err != nil {
+		t.Fatalf("CreateEnvironment() unexpected error: %v", err)
+	}
+
+	_, current, err := esi.ListEnvironments()
+	if err != nil {
+		t.Fatalf("ListEnvironments() unexpected error: %v", err)
+	}
+	if current != "perf" {
+		t.Errorf("the current environment is %q, want perf as there is no default environment", current)
+	}
+}
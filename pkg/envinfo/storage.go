@@ -2,18 +2,37 @@ package envinfo
 
 import (
 	"fmt"
+	"strings"
+
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"k8s.io/klog/v2"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
 )
 
 const (
 	// DefaultVolumeSize Default volume size for volumes defined in a devfile
 	DefaultVolumeSize = "1Gi"
+
+	// StorageClassAttribute is the attribute of the devfile volumes setting the storage class of their PVC
+	StorageClassAttribute = "odo.dev/storage-class"
+	// AccessModesAttribute is the attribute of the devfile volumes setting the access modes of their PVC
+	AccessModesAttribute = "odo.dev/access-modes"
+	// VolumeModeAttribute is the attribute of the devfile volumes setting the volume mode of their PVC
+	VolumeModeAttribute = "odo.dev/volume-mode"
 )
 
+// supportedAccessModes are the access modes which can be used by the PVCs of the storage
+var supportedAccessModes = []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce, corev1.ReadOnlyMany, corev1.ReadWriteMany}
+
+// supportedVolumeModes are the volume modes which can be used by the PVCs of the storage
+var supportedVolumeModes = []corev1.PersistentVolumeMode{corev1.PersistentVolumeFilesystem, corev1.PersistentVolumeBlock}
+
 // CompleteStorage completes the given storage
 func (ei *EnvInfo) CompleteStorage(storage *localConfigProvider.LocalStorage) {
 	if storage.Size == "" {
@@ -38,6 +57,20 @@ func (ei *EnvInfo) ValidateStorage(storage localConfigProvider.LocalStorage) err
 		}
 	}
 
+	if storage.StorageClass != "" {
+		if errs := k8svalidation.IsDNS1123Subdomain(storage.StorageClass); len(errs) > 0 {
+			return fmt.Errorf("invalid storage class %q: %s", storage.StorageClass, strings.Join(errs, " "))
+		}
+	}
+	for _, accessMode := range storage.AccessModes {
+		if !isSupportedAccessMode(accessMode) {
+			return fmt.Errorf("invalid access mode %q, the supported access modes are %v", accessMode, supportedAccessModes)
+		}
+	}
+	if storage.VolumeMode != "" && !isSupportedVolumeMode(storage.VolumeMode) {
+		return fmt.Errorf("invalid volume mode %q, the supported volume modes are %v", storage.VolumeMode, supportedVolumeModes)
+	}
+
 	if storage.Container == "" {
 		return nil
 	}
@@ -84,7 +117,8 @@ func (ei *EnvInfo) CreateStorage(storage localConfigProvider.LocalStorage) error
 		},
 	}
 	vc := []devfilev1.Component{{
-		Name: storage.Name,
+		Name:       storage.Name,
+		Attributes: getVolumeAttributes(storage),
 		ComponentUnion: devfilev1.ComponentUnion{
 			Volume: &devfilev1.VolumeComponent{
 				Volume: devfilev1.Volume{
@@ -146,6 +180,7 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 	var storageList []localConfigProvider.LocalStorage
 
 	volumeSizeMap := make(map[string]string)
+	volumeAttributesMap := make(map[string]localConfigProvider.LocalStorage)
 	components, err := ei.devfileObj.Data.GetComponents(common.DevfileOptions{})
 	if err != nil {
 		return storageList, err
@@ -155,10 +190,18 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 		if component.Volume == nil {
 			continue
 		}
+		volumeAttributesMap[component.Name], err = parseVolumeAttributes(component)
+		if err != nil {
+			return storageList, err
+		}
 		if component.Volume.Size == "" {
 			component.Volume.Size = DefaultVolumeSize
 		}
 		volumeSizeMap[component.Name] = component.Volume.Size
+		// the size of the volume can be overridden in the environment
+		if size, ok := ei.componentSettings.StorageSize[component.Name]; ok {
+			volumeSizeMap[component.Name] = size
+		}
 	}
 
 	for _, component := range components {
@@ -168,11 +211,15 @@ func (ei *EnvInfo) ListStorage() ([]localConfigProvider.LocalStorage, error) {
 		for _, volumeMount := range component.Container.VolumeMounts {
 			size, ok := volumeSizeMap[volumeMount.Name]
 			if ok {
+				volumeAttributes := volumeAttributesMap[volumeMount.Name]
 				storageList = append(storageList, localConfigProvider.LocalStorage{
-					Name:      volumeMount.Name,
-					Size:      size,
-					Path:      GetVolumeMountPath(volumeMount),
-					Container: component.Name,
+					Name:         volumeMount.Name,
+					Size:         size,
+					Path:         GetVolumeMountPath(volumeMount),
+					Container:    component.Name,
+					StorageClass: volumeAttributes.StorageClass,
+					AccessModes:  volumeAttributes.AccessModes,
+					VolumeMode:   volumeAttributes.VolumeMode,
 				})
 			}
 		}
@@ -235,3 +282,67 @@ func GetVolumeMountPath(volumeMount devfilev1.VolumeMount) string {
 
 	return volumeMount.Path
 }
+
+// getVolumeAttributes returns the attributes of the devfile volume setting the storage class, access modes
+// and volume mode of the storage
+func getVolumeAttributes(storage localConfigProvider.LocalStorage) attributes.Attributes {
+	if storage.StorageClass == "" && len(storage.AccessModes) == 0 && storage.VolumeMode == "" {
+		return nil
+	}
+	volumeAttributes := attributes.Attributes{}
+	if storage.StorageClass != "" {
+		volumeAttributes.PutString(StorageClassAttribute, storage.StorageClass)
+	}
+	if len(storage.AccessModes) > 0 {
+		var err error
+		volumeAttributes.Put(AccessModesAttribute, storage.AccessModes, &err)
+	}
+	if storage.VolumeMode != "" {
+		volumeAttributes.PutString(VolumeModeAttribute, storage.VolumeMode)
+	}
+	return volumeAttributes
+}
+
+// parseVolumeAttributes returns the storage class, access modes and volume mode set by the attributes of the devfile volume
+func parseVolumeAttributes(component devfilev1.Component) (localConfigProvider.LocalStorage, error) {
+	var storage localConfigProvider.LocalStorage
+	var err error
+	if component.Attributes.Exists(StorageClassAttribute) {
+		storage.StorageClass = component.Attributes.GetString(StorageClassAttribute, &err)
+		if err != nil {
+			return storage, errors.Wrapf(err, "invalid attribute %s of the volume %s", StorageClassAttribute, component.Name)
+		}
+	}
+	if component.Attributes.Exists(AccessModesAttribute) {
+		if err = component.Attributes.GetInto(AccessModesAttribute, &storage.AccessModes); err != nil {
+			return storage, errors.Wrapf(err, "invalid attribute %s of the volume %s", AccessModesAttribute, component.Name)
+		}
+	}
+	if component.Attributes.Exists(VolumeModeAttribute) {
+		storage.VolumeMode = component.Attributes.GetString(VolumeModeAttribute, &err)
+		if err != nil {
+			return storage, errors.Wrapf(err, "invalid attribute %s of the volume %s", VolumeModeAttribute, component.Name)
+		}
+	}
+	return storage, nil
+}
+
+// isSupportedAccessMode returns true if the access mode can be used by the PVCs of the storage
+func isSupportedAccessMode(accessMode string) bool {
+	for _, supported := range supportedAccessModes {
+		if accessMode == string(supported) {
+			return true
+		}
+	}
+	return false
+}
+
+// isSupportedVolumeMode returns true if the volume mode can be used by the PVCs of the storage
+func isSupportedVolumeMode(volumeMode string) bool {
+	for _, supported := range supportedVolumeModes {
+		if volumeMode == string(supported) {
+			return true
+		}
+	}
+	return false
+}
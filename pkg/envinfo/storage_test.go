@@ -114,7 +114,8 @@ func TestEnvInfo_CompleteStorage(t *testing.T) {
 
 func TestEnvInfo_ListStorage(t *testing.T) {
 	type fields struct {
-		devfileObj parser.DevfileObj
+		devfileObj        parser.DevfileObj
+		componentSettings ComponentSettings
 	}
 	tests := []struct {
 		name    string
@@ -323,11 +324,110 @@ func TestEnvInfo_ListStorage(t *testing.T) {
 			},
 			want: nil,
 		},
+		{
+			name: "case 5: the sizes of the volumes overridden in the environment are used",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+						if err != nil {
+							t.Error(err)
+						}
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{
+											VolumeMounts: []devfilev1.VolumeMount{
+												{
+													Name: "volume-0",
+													Path: "/path",
+												},
+											},
+										},
+									},
+								},
+							},
+							testingutil.GetFakeVolumeComponent("volume-0", "5Gi"),
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+				componentSettings: ComponentSettings{
+					StorageSize: map[string]string{"volume-0": "20Gi"},
+				},
+			},
+			want: []localConfigProvider.LocalStorage{
+				{
+					Name:      "volume-0",
+					Size:      "20Gi",
+					Path:      "/path",
+					Container: "container-0",
+				},
+			},
+		},
+		{
+			name: "case 6: list the storage class, access modes and volume mode of the volumes",
+			fields: fields{
+				devfileObj: parser.DevfileObj{
+					Data: func() data.DevfileData {
+						devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+						if err != nil {
+							t.Error(err)
+						}
+						volume := testingutil.GetFakeVolumeComponent("volume-0", "5Gi")
+						volume.Attributes = getVolumeAttributes(localConfigProvider.LocalStorage{
+							StorageClass: "fast",
+							AccessModes:  []string{"ReadWriteMany"},
+							VolumeMode:   "Block",
+						})
+						err = devfileData.AddComponents([]devfilev1.Component{
+							{
+								Name: "container-0",
+								ComponentUnion: devfilev1.ComponentUnion{
+									Container: &devfilev1.ContainerComponent{
+										Container: devfilev1.Container{
+											VolumeMounts: []devfilev1.VolumeMount{
+												{
+													Name: "volume-0",
+													Path: "/dev/xvda",
+												},
+											},
+										},
+									},
+								},
+							},
+							volume,
+						})
+						if err != nil {
+							t.Error(err)
+						}
+						return devfileData
+					}(),
+				},
+			},
+			want: []localConfigProvider.LocalStorage{
+				{
+					Name:         "volume-0",
+					Size:         "5Gi",
+					Path:         "/dev/xvda",
+					Container:    "container-0",
+					StorageClass: "fast",
+					AccessModes:  []string{"ReadWriteMany"},
+					VolumeMode:   "Block",
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
 			ei := &EnvInfo{
-				devfileObj: tt.fields.devfileObj,
+				devfileObj:        tt.fields.devfileObj,
+				componentSettings: tt.fields.componentSettings,
 			}
 			got, err := ei.ListStorage()
 			if (err != nil) != tt.wantErr {
@@ -447,6 +547,59 @@ func TestEnvInfo_ValidateStorage(t *testing.T) {
 			},
 			wantErr: true,
 		},
+		{
+			name: "case 3: storage with a supported storage class, access modes and volume mode",
+			fields: fields{
+				devfileObj: emptyDevfileObj(t),
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:         "volume-0",
+					StorageClass: "fast",
+					AccessModes:  []string{"ReadWriteOnce", "ReadOnlyMany"},
+					VolumeMode:   "Block",
+				},
+			},
+		},
+		{
+			name: "case 4: storage with an invalid storage class",
+			fields: fields{
+				devfileObj: emptyDevfileObj(t),
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:         "volume-0",
+					StorageClass: "Fast_Class",
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 5: storage with an unsupported access mode",
+			fields: fields{
+				devfileObj: emptyDevfileObj(t),
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:        "volume-0",
+					AccessModes: []string{"ReadWriteEverywhere"},
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 6: storage with an unsupported volume mode",
+			fields: fields{
+				devfileObj: emptyDevfileObj(t),
+			},
+			args: args{
+				storage: localConfigProvider.LocalStorage{
+					Name:       "volume-0",
+					VolumeMode: "Raw",
+				},
+			},
+			wantErr: true,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -586,3 +739,12 @@ func TestEnvInfo_GetStorage(t *testing.T) {
 		})
 	}
 }
+
+// emptyDevfileObj returns a devfile without components
+func emptyDevfileObj(t *testing.T) parser.DevfileObj {
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Error(err)
+	}
+	return parser.DevfileObj{Data: devfileData}
+}
@@ -1,7 +1,9 @@
 package envinfo
 
 import (
+	"crypto/tls"
 	"fmt"
+	"path/filepath"
 	"sort"
 	"strconv"
 	"strings"
@@ -13,6 +15,15 @@ import (
 	"github.com/openshift/odo/pkg/odo/util/validation"
 	"github.com/openshift/odo/pkg/util"
 	"github.com/pkg/errors"
+	k8svalidation "k8s.io/apimachinery/pkg/util/validation"
+)
+
+const (
+	// IngressClassAttribute is the attribute of the devfile endpoints setting the class of their ingresses
+	IngressClassAttribute = "odo.dev/ingress-class"
+	// IngressAnnotationsAttribute is the attribute of the devfile endpoints setting the annotations of their ingresses,
+	// the annotations of the URLs of the env file being added to them
+	IngressAnnotationsAttribute = "odo.dev/ingress-annotations"
 )
 
 //getPorts gets the ports from devfile
@@ -212,6 +223,20 @@ func (ei *EnvInfo) ValidateURL(url localConfigProvider.LocalURL) error {
 	if url.TLSSecret != "" && (url.Kind != localConfigProvider.INGRESS || !url.Secure) {
 		errorList = append(errorList, "TLS secret is only available for secure URLs of Ingress kind")
 	}
+	if (url.IngressClass != "" || len(url.Annotations) > 0) && url.Kind != localConfigProvider.INGRESS {
+		errorList = append(errorList, "ingress class and annotations are only available for URLs of Ingress kind")
+	}
+	if url.IngressClass != "" {
+		if err := validation.ValidateName(url.IngressClass); err != nil {
+			errorList = append(errorList, fmt.Sprintf("invalid ingress class: %v", err))
+		}
+	}
+	for _, key := range util.GetSortedKeys(url.Annotations) {
+		if errs := k8svalidation.IsQualifiedName(key); len(errs) > 0 {
+			errorList = append(errorList, fmt.Sprintf("invalid annotation %q: %s", key, strings.Join(errs, " ")))
+		}
+	}
+	errorList = append(errorList, validateURLCertificate(ei.resolveURLFiles(url))...)
 
 	// check if a host is provided for route based URLs
 	if len(url.Host) > 0 {
@@ -301,7 +326,19 @@ func (esi *EnvSpecificInfo) CreateURL(url localConfigProvider.LocalURL) error {
 		}
 	}
 
-	err := esi.SetConfiguration("url", localConfigProvider.LocalURL{Name: url.Name, Host: url.Host, TLSSecret: url.TLSSecret, Kind: url.Kind})
+	err := esi.SetConfiguration("url", localConfigProvider.LocalURL{
+		Name:               url.Name,
+		Host:               url.Host,
+		TLSSecret:          url.TLSSecret,
+		Kind:               url.Kind,
+		IngressClass:       url.IngressClass,
+		Annotations:        url.Annotations,
+		TLSSecretNamespace: url.TLSSecretNamespace,
+		TLSCertFile:        url.TLSCertFile,
+		TLSKeyFile:         url.TLSKeyFile,
+		Issuer:             url.Issuer,
+		IssuerKind:         url.IssuerKind,
+	})
 	if err != nil {
 		return errors.Wrapf(err, "failed to persist the component settings to env file")
 	}
@@ -352,14 +389,36 @@ func (ei *EnvInfo) ListURLs() ([]localConfigProvider.LocalURL, error) {
 				Path:      path,
 				Container: comp.Name,
 			}
+			ingressClass, annotations, err := getIngressAttributes(localEndpoint)
+			if err != nil {
+				return urls, err
+			}
 
 			if envInfoURL, exist := envMap[localEndpoint.Name]; exist {
 				url.Host = envInfoURL.Host
 				url.TLSSecret = envInfoURL.TLSSecret
 				url.Kind = envInfoURL.Kind
+				url.TLSSecretNamespace = envInfoURL.TLSSecretNamespace
+				url.TLSCertFile = ei.resolveURLFile(envInfoURL.TLSCertFile)
+				url.TLSKeyFile = ei.resolveURLFile(envInfoURL.TLSKeyFile)
+				url.Issuer = envInfoURL.Issuer
+				url.IssuerKind = envInfoURL.IssuerKind
+				if envInfoURL.IngressClass != "" {
+					ingressClass = envInfoURL.IngressClass
+				}
+				for key, value := range envInfoURL.Annotations {
+					if annotations == nil {
+						annotations = map[string]string{}
+					}
+					annotations[key] = value
+				}
 			} else {
 				url.Kind = localConfigProvider.ROUTE
 			}
+			if url.Kind == localConfigProvider.INGRESS {
+				url.IngressClass = ingressClass
+				url.Annotations = annotations
+			}
 
 			urls = append(urls, url)
 		}
@@ -368,6 +427,83 @@ func (ei *EnvInfo) ListURLs() ([]localConfigProvider.LocalURL, error) {
 	return urls, nil
 }
 
+// resolveURLFile returns the path of a local file of a URL, relative to the context of the component when not absolute
+func (ei *EnvInfo) resolveURLFile(path string) string {
+	if path == "" || filepath.IsAbs(path) {
+		return path
+	}
+	return filepath.Join(ei.contextDir, filepath.FromSlash(path))
+}
+
+// resolveURLFiles returns the URL with the paths of its certificate and key files resolved
+func (ei *EnvInfo) resolveURLFiles(url localConfigProvider.LocalURL) localConfigProvider.LocalURL {
+	url.TLSCertFile = ei.resolveURLFile(url.TLSCertFile)
+	url.TLSKeyFile = ei.resolveURLFile(url.TLSKeyFile)
+	return url
+}
+
+// validateURLCertificate validates the source of the certificate of the URL: a cert-manager issuer,
+// local certificate and key files or a TLS secret of another namespace
+func validateURLCertificate(url localConfigProvider.LocalURL) []string {
+	var errorList []string
+	sources := 0
+	if url.Issuer != "" {
+		sources++
+		if url.IssuerKind != localConfigProvider.IssuerKind && url.IssuerKind != localConfigProvider.ClusterIssuerKind {
+			errorList = append(errorList, fmt.Sprintf("invalid issuer kind %q, the kind must be %s or %s", url.IssuerKind, localConfigProvider.IssuerKind, localConfigProvider.ClusterIssuerKind))
+		}
+	}
+	if url.TLSCertFile != "" || url.TLSKeyFile != "" {
+		sources++
+		if url.TLSCertFile == "" || url.TLSKeyFile == "" {
+			errorList = append(errorList, "both the certificate and the key files must be provided")
+		} else if _, err := tls.LoadX509KeyPair(url.TLSCertFile, url.TLSKeyFile); err != nil {
+			errorList = append(errorList, fmt.Sprintf("invalid certificate and key files: %v", err))
+		}
+	}
+	if url.TLSSecretNamespace != "" {
+		sources++
+		if url.TLSSecret == "" {
+			errorList = append(errorList, "the TLS secret must be provided with the namespace of the TLS secret")
+		}
+		if err := validation.ValidateName(url.TLSSecretNamespace); err != nil {
+			errorList = append(errorList, fmt.Sprintf("invalid namespace of the TLS secret: %v", err))
+		}
+	}
+	if sources == 0 {
+		return errorList
+	}
+	if url.Kind != localConfigProvider.INGRESS || !url.Secure {
+		errorList = append(errorList, "certificate issuers, files and TLS secrets of other namespaces are only available for secure URLs of Ingress kind")
+	}
+	if sources > 1 {
+		errorList = append(errorList, "only one of a certificate issuer, certificate files or a TLS secret of another namespace can be used")
+	}
+	return errorList
+}
+
+// getIngressAttributes returns the ingress class and annotations set by the attributes of the endpoint
+func getIngressAttributes(endpoint devfilev1.Endpoint) (string, map[string]string, error) {
+	var ingressClass string
+	var annotations map[string]string
+	if endpoint.Attributes.Exists(IngressClassAttribute) {
+		var err error
+		ingressClass = endpoint.Attributes.GetString(IngressClassAttribute, &err)
+		if err != nil {
+			return "", nil, errors.Wrapf(err, "invalid attribute %s of the endpoint %s", IngressClassAttribute, endpoint.Name)
+		}
+	}
+	if endpoint.Attributes.Exists(IngressAnnotationsAttribute) {
+		if err := endpoint.Attributes.GetInto(IngressAnnotationsAttribute, &annotations); err != nil {
+			return "", nil, errors.Wrapf(err, "invalid attribute %s of the endpoint %s", IngressAnnotationsAttribute, endpoint.Name)
+		}
+		if len(annotations) == 0 {
+			annotations = nil
+		}
+	}
+	return ingressClass, annotations, nil
+}
+
 // DeleteURL is used to delete environment specific info for url from envinfo and devfile
 func (esi *EnvSpecificInfo) DeleteURL(name string) error {
 	err := removeEndpointInDevfile(esi.devfileObj, name)
@@ -437,6 +573,25 @@ func removeEndpointInDevfile(devObj parser.DevfileObj, urlName string) error {
 	return devObj.WriteYamlDevfile()
 }
 
+// SetEndpointDefaults fills the empty exposure, path and protocol of the endpoint of a URL with their default values,
+// and returns the names of the fields it has filled
+func SetEndpointDefaults(endpoint *devfilev1.Endpoint) []string {
+	var filled []string
+	if endpoint.Exposure == "" {
+		endpoint.Exposure = devfilev1.PublicEndpointExposure
+		filled = append(filled, "exposure")
+	}
+	if endpoint.Path == "" {
+		endpoint.Path = "/"
+		filled = append(filled, "path")
+	}
+	if endpoint.Protocol == "" {
+		endpoint.Protocol = devfilev1.HTTPEndpointProtocol
+		filled = append(filled, "protocol")
+	}
+	return filled
+}
+
 // updateEndpointInDevfile updates the endpoint of the given URL in the devfile
 func updateEndpointInDevfile(devObj parser.DevfileObj, url localConfigProvider.LocalURL) error {
 	components, err := devObj.Data.GetComponents(common.DevfileOptions{})
@@ -449,16 +604,7 @@ func updateEndpointInDevfile(devObj parser.DevfileObj, url localConfigProvider.L
 				endpoint := component.ComponentUnion.Container.Endpoints[j]
 
 				if endpoint.Name == url.Name {
-					// fill the default values
-					if endpoint.Exposure == "" {
-						endpoint.Exposure = devfilev1.PublicEndpointExposure
-					}
-					if endpoint.Path == "" {
-						endpoint.Path = "/"
-					}
-					if endpoint.Protocol == "" {
-						endpoint.Protocol = devfilev1.HTTPEndpointProtocol
-					}
+					SetEndpointDefaults(&endpoint)
 
 					// prevent write unless required
 					if endpoint.Exposure != devfilev1.PublicEndpointExposure || url.Secure != endpoint.Secure ||
@@ -6,6 +6,7 @@ import (
 	"testing"
 
 	v1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/pkg/devfile/parser"
 	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
@@ -614,6 +615,152 @@ func TestEnvInfo_ValidateURL(t *testing.T) {
 			updateURL: true,
 			wantErr:   false,
 		},
+		{
+			name: "case 14: ingress class used for Route based URL",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:         "http-3000",
+					IngressClass: "nginx",
+					Kind:         localConfigProvider.ROUTE,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 15: invalid annotation of an ingress URL",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:        "http-3000",
+					Host:        "com",
+					Annotations: map[string]string{"invalid annotation": "value"},
+					Kind:        localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 16: ingress class and annotations of an ingress URL",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:         "http-3000",
+					Host:         "com",
+					IngressClass: "nginx",
+					Annotations:  map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"},
+					Kind:         localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "case 17: certificate issuer used for a non secure URL",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:       "http-3000",
+					Host:       "com",
+					Issuer:     "letsencrypt",
+					IssuerKind: localConfigProvider.ClusterIssuerKind,
+					Kind:       localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 18: certificate file without key file",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:        "http-3000",
+					Host:        "com",
+					Secure:      true,
+					TLSCertFile: "tls.crt",
+					Kind:        localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 19: namespace of the TLS secret without TLS secret",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:               "http-3000",
+					Host:               "com",
+					Secure:             true,
+					TLSSecretNamespace: "certs",
+					Kind:               localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 20: certificate issuer and TLS secret of another namespace",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:               "http-3000",
+					Host:               "com",
+					Secure:             true,
+					TLSSecret:          "wildcard",
+					TLSSecretNamespace: "certs",
+					Issuer:             "letsencrypt",
+					IssuerKind:         localConfigProvider.ClusterIssuerKind,
+					Kind:               localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 21: secure URL with a certificate issuer",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:       "http-3000",
+					Host:       "com",
+					Secure:     true,
+					Issuer:     "letsencrypt",
+					IssuerKind: localConfigProvider.ClusterIssuerKind,
+					Kind:       localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: false,
+		},
+		{
+			name: "case 22: secure URL with the TLS secret of another namespace",
+			fields: fields{
+				devfileObj: odoTestingUtil.GetTestDevfileObj(fs),
+			},
+			args: args{
+				url: localConfigProvider.LocalURL{
+					Name:               "http-3000",
+					Host:               "com",
+					Secure:             true,
+					TLSSecret:          "wildcard",
+					TLSSecretNamespace: "certs",
+					Kind:               localConfigProvider.INGRESS,
+				},
+			},
+			wantErr: false,
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -958,6 +1105,37 @@ func TestEnvInfo_ListURLs(t *testing.T) {
 				},
 			},
 		},
+		{
+			name: "case 8: ingress class and annotations set by the endpoint attributes and env.yaml",
+			fields: fields{
+				devfileObj: devfileObjWithIngressAttributes(fs),
+				componentSettings: ComponentSettings{
+					URL: &[]localConfigProvider.LocalURL{
+						{
+							Name:        "port-3030",
+							Kind:        localConfigProvider.INGRESS,
+							Host:        "1.2.3.4.nip.io",
+							Annotations: map[string]string{"nginx.ingress.kubernetes.io/proxy-body-size": "8m"},
+						},
+					},
+				},
+			},
+			want: []localConfigProvider.LocalURL{
+				{
+					Name:         "port-3030",
+					Port:         3000,
+					Container:    "runtime",
+					Path:         "/",
+					Kind:         localConfigProvider.INGRESS,
+					Host:         "1.2.3.4.nip.io",
+					IngressClass: "nginx",
+					Annotations: map[string]string{
+						"nginx.ingress.kubernetes.io/rewrite-target":  "/",
+						"nginx.ingress.kubernetes.io/proxy-body-size": "8m",
+					},
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1187,3 +1365,33 @@ func Test_updateEndpointInDevfile(t *testing.T) {
 		})
 	}
 }
+
+func devfileObjWithIngressAttributes(fs filesystem.Filesystem) parser.DevfileObj {
+	devfileData, _ := data.NewDevfileData(string(data.APISchemaVersion200))
+	var err error
+	_ = devfileData.AddComponents([]v1.Component{
+		{
+			Name: "runtime",
+			ComponentUnion: v1.ComponentUnion{
+				Container: &v1.ContainerComponent{
+					Container: v1.Container{
+						Image: "quay.io/nodejs-12",
+					},
+					Endpoints: []v1.Endpoint{
+						{
+							Name:       "port-3030",
+							TargetPort: 3000,
+							Attributes: attributes.Attributes{}.
+								PutString(IngressClassAttribute, "nginx").
+								Put(IngressAnnotationsAttribute, map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"}, &err),
+						},
+					},
+				},
+			},
+		},
+	})
+	return parser.DevfileObj{
+		Ctx:  devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+		Data: devfileData,
+	}
+}
@@ -18,6 +18,7 @@ import (
 	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
 	"k8s.io/klog"
 
 	componentlabels "github.com/openshift/odo/pkg/component/labels"
@@ -255,6 +256,17 @@ func (c *Client) UpdateDeployment(deploy appsv1.Deployment) (*appsv1.Deployment,
 	return deployment, nil
 }
 
+// ScaleDeployment sets the number of replicas of the deployment
+func (c *Client) ScaleDeployment(name string, replicas int32) (*appsv1.Deployment, error) {
+	patch := fmt.Sprintf(`{"spec":{"replicas":%d}}`, replicas)
+	klog.V(3).Infof("Scaling the Deployment %s to %d replicas", name, replicas)
+	deployment, err := c.KubeClient.AppsV1().Deployments(c.Namespace).Patch(context.TODO(), name, types.MergePatchType, []byte(patch), metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to scale Deployment %s", name)
+	}
+	return deployment, nil
+}
+
 // ApplyDeployment creates or updates a deployment based on the given deployment spec
 // It is using force:true to make sure that if someone changed one of the values that odo manages,
 // odo overrides it with the value it expects instead of failing due to conflict.
@@ -378,6 +390,13 @@ func (c *Client) GetDynamicResource(group, version, resource, name string) (*uns
 	return res, nil
 }
 
+// WatchDynamicResource watches an instance, specified by name, of a Custom Resource
+func (c *Client) WatchDynamicResource(group, version, resource, name string) (watch.Interface, error) {
+	deploymentRes := schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
+
+	return c.DynamicClient.Resource(deploymentRes).Namespace(c.Namespace).Watch(context.TODO(), metav1.ListOptions{FieldSelector: "metadata.name=" + name})
+}
+
 // UpdateDynamicResource updates a dynamic resource
 func (c *Client) UpdateDynamicResource(group, version, resource, name string, u *unstructured.Unstructured) error {
 	deploymentRes := schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
@@ -389,6 +408,15 @@ func (c *Client) UpdateDynamicResource(group, version, resource, name string, u
 	return nil
 }
 
+// PatchDynamicResource applies a JSON merge patch to an instance, specified by name, of a Custom Resource
+func (c *Client) PatchDynamicResource(group, version, resource, name string, patch []byte) error {
+	deploymentRes := schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
+
+	klog.V(5).Infof("Patching resource %s/%s with: %s", resource, name, string(patch))
+	_, err := c.DynamicClient.Resource(deploymentRes).Namespace(c.Namespace).Patch(context.TODO(), name, types.MergePatchType, patch, metav1.PatchOptions{FieldManager: FieldManager})
+	return err
+}
+
 // DeleteDynamicResource deletes an instance, specified by name, of a Custom Resource
 func (c *Client) DeleteDynamicResource(name, group, version, resource string) error {
 	deploymentRes := schema.GroupVersionResource{Group: group, Version: version, Resource: resource}
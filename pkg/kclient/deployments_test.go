@@ -1,6 +1,7 @@
 package kclient
 
 import (
+	"context"
 	"testing"
 
 	"github.com/devfile/library/pkg/devfile/parser/data"
@@ -316,3 +317,31 @@ func TestDeleteDeployment(t *testing.T) {
 		})
 	}
 }
+
+func TestScaleDeployment(t *testing.T) {
+	fkclient, fkclientset := FakeNew()
+	fkclient.Namespace = "default"
+
+	replicas := int32(1)
+	_, err := fkclientset.Kubernetes.AppsV1().Deployments("default").Create(context.TODO(), &appsv1.Deployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nodejs-app"},
+		Spec:       appsv1.DeploymentSpec{Replicas: &replicas},
+	}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	for _, want := range []int32{0, 2} {
+		deployment, err := fkclient.ScaleDeployment("nodejs-app", want)
+		if err != nil {
+			t.Fatalf("ScaleDeployment() unexpected error %v", err)
+		}
+		if deployment.Spec.Replicas == nil || *deployment.Spec.Replicas != want {
+			t.Errorf("ScaleDeployment() replicas = %v, want %d", deployment.Spec.Replicas, want)
+		}
+	}
+
+	if _, err = fkclient.ScaleDeployment("missing", 0); err == nil {
+		t.Errorf("ScaleDeployment() expected an error for a missing deployment")
+	}
+}
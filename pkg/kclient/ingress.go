@@ -5,7 +5,10 @@ import (
 	"fmt"
 	"github.com/openshift/odo/pkg/unions"
 
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 )
 
 // GetOneIngressFromSelector gets one ingress with the given selector
@@ -144,3 +147,26 @@ func (c *Client) GetIngress(name string) (*unions.KubernetesIngress, error) {
 	}
 	return nil, fmt.Errorf("could not get supported type of ingress")
 }
+
+// openShiftIngressConfigRes is the cluster-wide ingress configuration of OpenShift clusters
+var openShiftIngressConfigRes = schema.GroupVersionResource{Group: "config.openshift.io", Version: "v1", Resource: "ingresses"}
+
+// GetClusterIngressDomain returns the default domain of the ingresses of the cluster set in the OpenShift
+// ingress configuration, an empty domain is returned when the cluster doesn't provide it
+func (c *Client) GetClusterIngressDomain() (string, error) {
+	if c.DynamicClient == nil {
+		return "", nil
+	}
+	config, err := c.DynamicClient.Resource(openShiftIngressConfigRes).Get(context.TODO(), "cluster", metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) || kerrors.IsForbidden(err) {
+			return "", nil
+		}
+		return "", err
+	}
+	domain, _, err := unstructured.NestedString(config.Object, "spec", "domain")
+	if err != nil {
+		return "", err
+	}
+	return domain, nil
+}
@@ -7,8 +7,11 @@ import (
 
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/klog"
 )
 
@@ -146,3 +149,21 @@ func (c *Client) GetCSVWithCR(name string) (*olm.ClusterServiceVersion, error) {
 	}
 	return &olm.ClusterServiceVersion{}, fmt.Errorf("could not find any Operator containing requested CR: %s", name)
 }
+
+// GetCustomResourceDefinition returns the CustomResourceDefinition of the given name (e.g. etcdclusters.etcd.database.coreos.com)
+// CRDs are cluster scoped, so the user needs to be allowed to read them at the cluster level
+func (c *Client) GetCustomResourceDefinition(name string) (*apiextensionsv1.CustomResourceDefinition, error) {
+	crdResource := schema.GroupVersionResource{Group: "apiextensions.k8s.io", Version: "v1", Resource: "customresourcedefinitions"}
+
+	u, err := c.DynamicClient.Resource(crdResource).Get(context.TODO(), name, v1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+
+	var crd apiextensionsv1.CustomResourceDefinition
+	err = runtime.DefaultUnstructuredConverter.FromUnstructured(u.UnstructuredContent(), &crd)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the CustomResourceDefinition %q", name)
+	}
+	return &crd, nil
+}
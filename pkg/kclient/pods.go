@@ -188,6 +188,20 @@ func (c *Client) ExtractProjectToComponent(containerName, podName string, target
 	return nil
 }
 
+// CreatePod creates the pod in the namespace of the client
+func (c *Client) CreatePod(pod *corev1.Pod) (*corev1.Pod, error) {
+	created, err := c.KubeClient.CoreV1().Pods(c.Namespace).Create(context.TODO(), pod, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create the pod %s", pod.Name)
+	}
+	return created, nil
+}
+
+// DeletePod deletes the pod of the given name
+func (c *Client) DeletePod(name string) error {
+	return c.KubeClient.CoreV1().Pods(c.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
 // GetOnePod gets a pod using the component and app name
 func (c *Client) GetOnePod(componentName, appName string) (*corev1.Pod, error) {
 	return c.GetOnePodFromSelector(componentlabels.GetSelector(componentName, appName))
@@ -18,6 +18,7 @@ import (
 
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -47,6 +48,40 @@ func (c *Client) CreateTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objec
 	return secret, nil
 }
 
+// ApplyTLSSecret creates the TLS Secret with the given certificate and private key, or updates its certificate,
+// private key, labels and owner references when it already exists
+func (c *Client) ApplyTLSSecret(tlsCertificate []byte, tlsPrivKey []byte, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+	secret, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Get(context.TODO(), objectMeta.Name, metav1.GetOptions{})
+	if kerrors.IsNotFound(err) {
+		return c.CreateTLSSecret(tlsCertificate, tlsPrivKey, objectMeta)
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the secret %s", objectMeta.Name)
+	}
+	if secret.Type != corev1.SecretTypeTLS {
+		return nil, errors.Errorf("the secret %s already exists and is not a TLS secret", objectMeta.Name)
+	}
+	secret.Data = map[string][]byte{
+		corev1.TLSCertKey:       tlsCertificate,
+		corev1.TLSPrivateKeyKey: tlsPrivKey,
+	}
+	secret.Labels = objectMeta.Labels
+	secret.OwnerReferences = objectMeta.OwnerReferences
+	return c.UpdateSecret(secret)
+}
+
+// CopyTLSSecret copies the TLS Secret of the given name of another namespace to the namespace of the client,
+// for instance to reuse a wildcard certificate, the copy being updated when it already exists
+func (c *Client) CopyTLSSecret(name, fromNamespace string, objectMeta metav1.ObjectMeta) (*corev1.Secret, error) {
+	source, err := c.KubeClient.CoreV1().Secrets(fromNamespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the secret %s of the namespace %s", name, fromNamespace)
+	}
+	if source.Type != corev1.SecretTypeTLS {
+		return nil, errors.Errorf("the secret %s of the namespace %s is not a TLS secret", name, fromNamespace)
+	}
+	return c.ApplyTLSSecret(source.Data[corev1.TLSCertKey], source.Data[corev1.TLSPrivateKeyKey], objectMeta)
+}
+
 // SelfSignedCertificate struct is the return type of function GenerateSelfSignedCertificate
 // CertPem is the byte array for certificate pem encode
 // KeyPem is the byte array for key pem encode
@@ -109,14 +144,14 @@ func (c *Client) GetSecret(name, namespace string) (*corev1.Secret, error) {
 
 // CreateSecret generates and creates the secret
 // commonObjectMeta is the ObjectMeta for the service
-func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReference metav1.OwnerReference) error {
+func (c *Client) CreateSecret(objectMeta metav1.ObjectMeta, data map[string]string, ownerReferences ...metav1.OwnerReference) error {
 
 	secret := corev1.Secret{
 		ObjectMeta: objectMeta,
 		Type:       corev1.SecretTypeOpaque,
 		StringData: data,
 	}
-	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReference))
+	secret.SetOwnerReferences(append(secret.GetOwnerReferences(), ownerReferences...))
 	_, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Create(context.TODO(), &secret, metav1.CreateOptions{FieldManager: FieldManager})
 	if err != nil {
 		return errors.Wrapf(err, "unable to create secret for %s", objectMeta.Name)
@@ -161,6 +196,24 @@ func (c *Client) CreateSecrets(componentName string, commonObjectMeta metav1.Obj
 	return nil
 }
 
+// UpdateSecret updates the given Secret
+func (c *Client) UpdateSecret(secret *corev1.Secret) (*corev1.Secret, error) {
+	updated, err := c.KubeClient.CoreV1().Secrets(c.Namespace).Update(context.TODO(), secret, metav1.UpdateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to update the secret %s", secret.Name)
+	}
+	return updated, nil
+}
+
+// DeleteSecret deletes the Secret of the given name
+func (c *Client) DeleteSecret(name string) error {
+	err := c.KubeClient.CoreV1().Secrets(c.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+	if err != nil {
+		return errors.Wrapf(err, "unable to delete the secret %s", name)
+	}
+	return nil
+}
+
 // ListSecrets lists all the secrets based on the given label selector
 func (c *Client) ListSecrets(labelSelector string) ([]corev1.Secret, error) {
 	listOptions := metav1.ListOptions{}
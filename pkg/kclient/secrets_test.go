@@ -1,6 +1,7 @@
 package kclient
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
@@ -301,3 +302,55 @@ func TestWaitAndGetSecret(t *testing.T) {
 		})
 	}
 }
+
+func TestCopyTLSSecret(t *testing.T) {
+	fakeClient, fakeClientSet := FakeNew()
+	fakeClient.Namespace = "myproject"
+
+	wildcard := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "wildcard", Namespace: "certs"},
+		Type:       corev1.SecretTypeTLS,
+		Data: map[string][]byte{
+			corev1.TLSCertKey:       []byte("cert"),
+			corev1.TLSPrivateKeyKey: []byte("key"),
+		},
+	}
+	opaque := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{Name: "opaque", Namespace: "certs"},
+		Type:       corev1.SecretTypeOpaque,
+	}
+	for _, secret := range []*corev1.Secret{wildcard, opaque} {
+		if _, err := fakeClientSet.Kubernetes.CoreV1().Secrets("certs").Create(context.TODO(), secret, metav1.CreateOptions{}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	objectMeta := metav1.ObjectMeta{Name: "wildcard", Labels: map[string]string{"component": "nodejs"}}
+	copied, err := fakeClient.CopyTLSSecret("wildcard", "certs", objectMeta)
+	if err != nil {
+		t.Fatalf("CopyTLSSecret() unexpected error: %v", err)
+	}
+	if copied.Namespace != "myproject" || string(copied.Data[corev1.TLSCertKey]) != "cert" || copied.Labels["component"] != "nodejs" {
+		t.Errorf("unexpected copy of the secret: %v", copied)
+	}
+
+	// the copy is updated when the source changes
+	wildcard.Data[corev1.TLSCertKey] = []byte("renewed")
+	if _, err = fakeClientSet.Kubernetes.CoreV1().Secrets("certs").Update(context.TODO(), wildcard, metav1.UpdateOptions{}); err != nil {
+		t.Fatal(err)
+	}
+	copied, err = fakeClient.CopyTLSSecret("wildcard", "certs", objectMeta)
+	if err != nil {
+		t.Fatalf("CopyTLSSecret() unexpected error: %v", err)
+	}
+	if string(copied.Data[corev1.TLSCertKey]) != "renewed" {
+		t.Errorf("the copy of the secret has not been updated: %v", copied)
+	}
+
+	if _, err = fakeClient.CopyTLSSecret("opaque", "certs", metav1.ObjectMeta{Name: "opaque"}); err == nil {
+		t.Errorf("CopyTLSSecret() expected an error for a secret which is not a TLS secret")
+	}
+	if _, err = fakeClient.CopyTLSSecret("missing", "certs", metav1.ObjectMeta{Name: "missing"}); err == nil {
+		t.Errorf("CopyTLSSecret() expected an error for a missing secret")
+	}
+}
@@ -0,0 +1,215 @@
+package kclient
+
+import (
+	"context"
+	"time"
+
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/klog"
+)
+
+// constants for volume snapshots
+const (
+	VolumeSnapshotGroup    = "snapshot.storage.k8s.io"
+	VolumeSnapshotKind     = "VolumeSnapshot"
+	volumeSnapshotResource = "volumesnapshots"
+)
+
+// volumeSnapshotVersions are the versions of the VolumeSnapshot resource supported by odo, by order of preference
+var volumeSnapshotVersions = []string{"v1", "v1beta1"}
+
+// ErrVolumeSnapshotNotSupported is returned when the CSI snapshot CRDs are not installed on the cluster
+var ErrVolumeSnapshotNotSupported = errors.New("the VolumeSnapshot resource is not available on the cluster, " +
+	"please install the CSI snapshot CRDs and the snapshot controller to snapshot the storage")
+
+// VolumeSnapshot is a snapshot of the volume of a PVC, taken by the CSI snapshot controller
+type VolumeSnapshot struct {
+	Name              string
+	Labels            map[string]string
+	CreationTimestamp metav1.Time
+	// PVCName is the name of the PVC whose volume is snapshotted
+	PVCName string
+	// SnapshotClass is the name of the VolumeSnapshotClass of the snapshot, empty for the default class
+	SnapshotClass string
+	// ReadyToUse is true when the snapshot can be used to restore a volume
+	ReadyToUse bool
+	// RestoreSize is the minimum size of a volume restored from the snapshot, nil until it is known
+	RestoreSize *resource.Quantity
+	// Error is the last error of the snapshot controller when taking the snapshot
+	Error string
+}
+
+// getVolumeSnapshotResource returns the preferred version of the VolumeSnapshot resource supported by the cluster,
+// or ErrVolumeSnapshotNotSupported if the CSI snapshot CRDs are not installed
+func (c *Client) getVolumeSnapshotResource() (schema.GroupVersionResource, error) {
+	for _, version := range volumeSnapshotVersions {
+		supported, err := c.IsResourceSupported(VolumeSnapshotGroup, version, volumeSnapshotResource)
+		if err != nil {
+			return schema.GroupVersionResource{}, errors.Wrap(err, "unable to check if the VolumeSnapshot resource is available on the cluster")
+		}
+		if supported {
+			return schema.GroupVersionResource{Group: VolumeSnapshotGroup, Version: version, Resource: volumeSnapshotResource}, nil
+		}
+	}
+	return schema.GroupVersionResource{}, ErrVolumeSnapshotNotSupported
+}
+
+// IsVolumeSnapshotSupported checks if the VolumeSnapshot resource of the CSI snapshotter is present on the cluster
+func (c *Client) IsVolumeSnapshotSupported() (bool, error) {
+	_, err := c.getVolumeSnapshotResource()
+	if err == ErrVolumeSnapshotNotSupported {
+		return false, nil
+	}
+	return err == nil, err
+}
+
+// CreateVolumeSnapshot creates a snapshot of the volume of the PVC, using the given VolumeSnapshotClass or the default one if empty
+func (c *Client) CreateVolumeSnapshot(name, pvcName, snapshotClass string, labels map[string]string) (*VolumeSnapshot, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	snapshot := newVolumeSnapshot(gvr.GroupVersion().String(), name, pvcName, snapshotClass, labels)
+	klog.V(3).Infof("Creating the VolumeSnapshot %s of the PVC %s", name, pvcName)
+	created, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Create(context.TODO(), snapshot, metav1.CreateOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to create the VolumeSnapshot %s", name)
+	}
+	vs := convertVolumeSnapshot(created)
+	return &vs, nil
+}
+
+// GetVolumeSnapshot returns the VolumeSnapshot with the given name
+func (c *Client) GetVolumeSnapshot(name string) (*VolumeSnapshot, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	u, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Get(context.TODO(), name, metav1.GetOptions{})
+	if err != nil {
+		return nil, err
+	}
+	vs := convertVolumeSnapshot(u)
+	return &vs, nil
+}
+
+// ListVolumeSnapshots returns the VolumeSnapshots matching the given label selector
+func (c *Client) ListVolumeSnapshots(selector string) ([]VolumeSnapshot, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	list, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).List(context.TODO(), metav1.ListOptions{LabelSelector: selector})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list the VolumeSnapshots")
+	}
+	var snapshots []VolumeSnapshot
+	for i := range list.Items {
+		snapshots = append(snapshots, convertVolumeSnapshot(&list.Items[i]))
+	}
+	return snapshots, nil
+}
+
+// DeleteVolumeSnapshot deletes the VolumeSnapshot with the given name
+func (c *Client) DeleteVolumeSnapshot(name string) error {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return err
+	}
+	klog.V(3).Infof("Deleting the VolumeSnapshot %s", name)
+	return c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Delete(context.TODO(), name, metav1.DeleteOptions{})
+}
+
+// WaitForVolumeSnapshotReady blocks and waits until the VolumeSnapshot is ready to be used to restore a volume,
+// returning an error as soon as the snapshot controller reports one
+func (c *Client) WaitForVolumeSnapshotReady(name string, timeout time.Duration) (*VolumeSnapshot, error) {
+	gvr, err := c.getVolumeSnapshotResource()
+	if err != nil {
+		return nil, err
+	}
+	klog.V(3).Infof("Waiting for the VolumeSnapshot %s to be ready", name)
+
+	w, err := c.DynamicClient.Resource(gvr).Namespace(c.Namespace).Watch(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.Set{"metadata.name": name}.AsSelector().String(),
+	})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to watch the VolumeSnapshot %s", name)
+	}
+	defer w.Stop()
+
+	// the snapshot may have been taken before the start of the watch
+	snapshot, err := c.GetVolumeSnapshot(name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the VolumeSnapshot %s", name)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		if snapshot.ReadyToUse {
+			return snapshot, nil
+		}
+		if snapshot.Error != "" {
+			return nil, errors.Errorf("unable to snapshot the PVC %s: %s", snapshot.PVCName, snapshot.Error)
+		}
+
+		select {
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return nil, errors.Errorf("watch channel was closed while waiting for the VolumeSnapshot %s", name)
+			}
+			if u, ok := val.Object.(*unstructured.Unstructured); ok {
+				vs := convertVolumeSnapshot(u)
+				snapshot = &vs
+			}
+		case <-timer.C:
+			return nil, errors.Errorf("timed out waiting for the VolumeSnapshot %s to be ready", name)
+		}
+	}
+}
+
+// newVolumeSnapshot returns the VolumeSnapshot of the PVC, in the given apiVersion
+func newVolumeSnapshot(apiVersion, name, pvcName, snapshotClass string, labels map[string]string) *unstructured.Unstructured {
+	spec := map[string]interface{}{
+		"source": map[string]interface{}{
+			"persistentVolumeClaimName": pvcName,
+		},
+	}
+	if snapshotClass != "" {
+		spec["volumeSnapshotClassName"] = snapshotClass
+	}
+	snapshot := &unstructured.Unstructured{
+		Object: map[string]interface{}{
+			"apiVersion": apiVersion,
+			"kind":       VolumeSnapshotKind,
+			"spec":       spec,
+		},
+	}
+	snapshot.SetName(name)
+	snapshot.SetLabels(labels)
+	return snapshot
+}
+
+// convertVolumeSnapshot converts the unstructured VolumeSnapshot returned by the cluster
+func convertVolumeSnapshot(u *unstructured.Unstructured) VolumeSnapshot {
+	snapshot := VolumeSnapshot{
+		Name:              u.GetName(),
+		Labels:            u.GetLabels(),
+		CreationTimestamp: u.GetCreationTimestamp(),
+	}
+	snapshot.PVCName, _, _ = unstructured.NestedString(u.Object, "spec", "source", "persistentVolumeClaimName")
+	snapshot.SnapshotClass, _, _ = unstructured.NestedString(u.Object, "spec", "volumeSnapshotClassName")
+	snapshot.ReadyToUse, _, _ = unstructured.NestedBool(u.Object, "status", "readyToUse")
+	snapshot.Error, _, _ = unstructured.NestedString(u.Object, "status", "error", "message")
+	if restoreSize, found, _ := unstructured.NestedString(u.Object, "status", "restoreSize"); found {
+		if quantity, err := resource.ParseQuantity(restoreSize); err == nil {
+			snapshot.RestoreSize = &quantity
+		}
+	}
+	return snapshot
+}
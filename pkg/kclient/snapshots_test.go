@@ -0,0 +1,136 @@
+package kclient
+
+import (
+	"reflect"
+	"testing"
+
+	odoFake "github.com/openshift/odo/pkg/kclient/fake"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestIsVolumeSnapshotSupported(t *testing.T) {
+	tests := []struct {
+		name          string
+		supported     bool
+		wantSupported bool
+	}{
+		{
+			name:          "Case 1: CSI snapshot CRDs installed",
+			supported:     true,
+			wantSupported: true,
+		},
+		{
+			name: "Case 2: CSI snapshot CRDs not installed",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, _ := FakeNew()
+			fd := odoFake.NewFakeDiscovery()
+			if tt.supported {
+				gvr := metav1.GroupVersionResource{Group: VolumeSnapshotGroup, Version: "v1", Resource: volumeSnapshotResource}
+				fd.AddResourceList(gvr.String(), &metav1.APIResourceList{
+					GroupVersion: VolumeSnapshotGroup + "/v1",
+					APIResources: []metav1.APIResource{{
+						Name:       volumeSnapshotResource,
+						Namespaced: true,
+						Kind:       VolumeSnapshotKind,
+					}},
+				})
+			}
+			fakeClient.SetDiscoveryInterface(fd)
+
+			supported, err := fakeClient.IsVolumeSnapshotSupported()
+			if err != nil {
+				t.Fatalf("IsVolumeSnapshotSupported() unexpected error %v", err)
+			}
+			if supported != tt.wantSupported {
+				t.Errorf("IsVolumeSnapshotSupported() = %v, want %v", supported, tt.wantSupported)
+			}
+
+			if !tt.supported {
+				_, err = fakeClient.CreateVolumeSnapshot("mysnapshot", "mypvc", "", nil)
+				if err != ErrVolumeSnapshotNotSupported {
+					t.Errorf("CreateVolumeSnapshot() error = %v, want %v", err, ErrVolumeSnapshotNotSupported)
+				}
+			}
+		})
+	}
+}
+
+func TestConvertVolumeSnapshot(t *testing.T) {
+	restoreSize := resource.MustParse("2Gi")
+	tests := []struct {
+		name     string
+		snapshot *unstructured.Unstructured
+		want     VolumeSnapshot
+	}{
+		{
+			name:     "Case 1: snapshot being taken",
+			snapshot: newVolumeSnapshot("snapshot.storage.k8s.io/v1", "mysnapshot", "mypvc", "csi-snapclass", map[string]string{"component": "nodejs"}),
+			want: VolumeSnapshot{
+				Name:          "mysnapshot",
+				Labels:        map[string]string{"component": "nodejs"},
+				PVCName:       "mypvc",
+				SnapshotClass: "csi-snapclass",
+			},
+		},
+		{
+			name: "Case 2: snapshot ready to use",
+			snapshot: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"apiVersion": "snapshot.storage.k8s.io/v1",
+					"kind":       VolumeSnapshotKind,
+					"metadata": map[string]interface{}{
+						"name": "mysnapshot",
+					},
+					"spec": map[string]interface{}{
+						"source": map[string]interface{}{
+							"persistentVolumeClaimName": "mypvc",
+						},
+					},
+					"status": map[string]interface{}{
+						"readyToUse":  true,
+						"restoreSize": "2Gi",
+					},
+				},
+			},
+			want: VolumeSnapshot{
+				Name:        "mysnapshot",
+				PVCName:     "mypvc",
+				ReadyToUse:  true,
+				RestoreSize: &restoreSize,
+			},
+		},
+		{
+			name: "Case 3: failed snapshot",
+			snapshot: &unstructured.Unstructured{
+				Object: map[string]interface{}{
+					"metadata": map[string]interface{}{
+						"name": "mysnapshot",
+					},
+					"status": map[string]interface{}{
+						"readyToUse": false,
+						"error": map[string]interface{}{
+							"message": "the storage class doesn't support snapshots",
+						},
+					},
+				},
+			},
+			want: VolumeSnapshot{
+				Name:  "mysnapshot",
+				Error: "the storage class doesn't support snapshots",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got := convertVolumeSnapshot(tt.snapshot)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("convertVolumeSnapshot() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
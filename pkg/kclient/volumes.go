@@ -2,11 +2,21 @@ package kclient
 
 import (
 	"context"
+	"fmt"
+	"strings"
+	"time"
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/watch"
+	"k8s.io/klog"
 )
 
 // constants for volumes
@@ -29,6 +39,43 @@ func (c *Client) DeletePVC(pvcName string) error {
 	return c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Delete(context.TODO(), pvcName, metav1.DeleteOptions{})
 }
 
+// WaitForPVCDeletion blocks and waits until the PVC has been deleted, its deletion being delayed
+// by the kubernetes.io/pvc-protection finalizer as long as a pod uses it
+func (c *Client) WaitForPVCDeletion(pvcName string, timeout time.Duration) error {
+	klog.V(3).Infof("Waiting for the deletion of the PVC %s", pvcName)
+
+	w, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Watch(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.Set{"metadata.name": pvcName}.AsSelector().String(),
+	})
+	if err != nil {
+		return errors.Wrapf(err, "unable to watch the PVC %s", pvcName)
+	}
+	defer w.Stop()
+
+	// the PVC may have been deleted before the start of the watch
+	if _, err = c.GetPVCFromName(pvcName); kerrors.IsNotFound(err) {
+		return nil
+	} else if err != nil {
+		return errors.Wrapf(err, "unable to get the PVC %s", pvcName)
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return errors.Errorf("watch channel was closed while waiting for the deletion of the PVC %s", pvcName)
+			}
+			if val.Type == watch.Deleted {
+				return nil
+			}
+		case <-timer.C:
+			return errors.Errorf("timed out waiting for the deletion of the PVC %s", pvcName)
+		}
+	}
+}
+
 // ListPVCs returns the PVCs based on the given selector
 func (c *Client) ListPVCs(selector string) ([]corev1.PersistentVolumeClaim, error) {
 	pvcList, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).List(context.TODO(), metav1.ListOptions{
@@ -111,3 +158,134 @@ func (c *Client) UpdateStorageOwnerReference(pvc *corev1.PersistentVolumeClaim,
 	}
 	return nil
 }
+
+// ListStorageClasses returns the storage classes of the cluster
+func (c *Client) ListStorageClasses() ([]storagev1.StorageClass, error) {
+	storageClasses, err := c.KubeClient.StorageV1().StorageClasses().List(context.TODO(), metav1.ListOptions{})
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to list the storage classes")
+	}
+	return storageClasses.Items, nil
+}
+
+// ValidateStorageClass returns an error listing the storage classes of the cluster
+// when the storage class of the given name doesn't exist
+func (c *Client) ValidateStorageClass(name string) error {
+	storageClasses, err := c.ListStorageClasses()
+	if err != nil {
+		return err
+	}
+	var names []string
+	for _, storageClass := range storageClasses {
+		if storageClass.Name == name {
+			return nil
+		}
+		names = append(names, storageClass.Name)
+	}
+	if len(names) == 0 {
+		return errors.Errorf("the storage class %s doesn't exist, the cluster has no storage class", name)
+	}
+	return errors.Errorf("the storage class %s doesn't exist, the storage classes of the cluster are: %s", name, strings.Join(names, ", "))
+}
+
+// ExpandPVC requests the expansion of the volume of the PVC to the given size,
+// returning an error if the storage class of the PVC doesn't allow the expansion of its volumes
+func (c *Client) ExpandPVC(pvcName string, size resource.Quantity) (*corev1.PersistentVolumeClaim, error) {
+	pvc, err := c.GetPVCFromName(pvcName)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the PVC %s", pvcName)
+	}
+
+	if pvc.Spec.StorageClassName == nil || *pvc.Spec.StorageClassName == "" {
+		return nil, errors.Errorf("the PVC %s has no storage class, its volume can't be expanded", pvcName)
+	}
+	storageClassName := *pvc.Spec.StorageClassName
+	storageClass, err := c.KubeClient.StorageV1().StorageClasses().Get(context.TODO(), storageClassName, metav1.GetOptions{})
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.Errorf("the storage class %s of the PVC %s doesn't exist, its volume can't be expanded", storageClassName, pvcName)
+		}
+		return nil, errors.Wrapf(err, "unable to get the storage class %s", storageClassName)
+	}
+	if storageClass.AllowVolumeExpansion == nil || !*storageClass.AllowVolumeExpansion {
+		return nil, errors.Errorf("the storage class %s of the PVC %s doesn't allow the expansion of its volumes (allowVolumeExpansion is not set)", storageClassName, pvcName)
+	}
+
+	patch := fmt.Sprintf(`{"spec":{"resources":{"requests":{"storage":%q}}}}`, size.String())
+	klog.V(3).Infof("Expanding the PVC %s to %s", pvcName, size.String())
+	pvc, err = c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Patch(context.TODO(), pvcName, types.MergePatchType, []byte(patch), metav1.PatchOptions{FieldManager: FieldManager})
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to expand the PVC %s", pvcName)
+	}
+	return pvc, nil
+}
+
+// WaitForPVCResize blocks and waits until the volume of the PVC has been resized to the given size,
+// or until the resize of its file system is pending on the node, in which case true is returned
+func (c *Client) WaitForPVCResize(pvcName string, size resource.Quantity, timeout time.Duration) (fileSystemResizePending bool, err error) {
+	klog.V(3).Infof("Waiting for the resize of the PVC %s to %s", pvcName, size.String())
+
+	w, err := c.KubeClient.CoreV1().PersistentVolumeClaims(c.Namespace).Watch(context.TODO(), metav1.ListOptions{
+		FieldSelector: fields.Set{"metadata.name": pvcName}.AsSelector().String(),
+	})
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to watch the PVC %s", pvcName)
+	}
+	defer w.Stop()
+
+	// the PVC may have been resized before the start of the watch
+	pvc, err := c.GetPVCFromName(pvcName)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to get the PVC %s", pvcName)
+	}
+	if resized, pending := isPVCResized(pvc, size); resized || pending {
+		return pending, nil
+	}
+
+	timer := time.NewTimer(timeout)
+	defer timer.Stop()
+	for {
+		select {
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return false, errors.Errorf("watch channel was closed while waiting for the resize of the PVC %s", pvcName)
+			}
+			if e, ok := val.Object.(*corev1.PersistentVolumeClaim); ok {
+				pvc = e
+				if resized, pending := isPVCResized(pvc, size); resized || pending {
+					return pending, nil
+				}
+			}
+		case <-timer.C:
+			return false, errors.Errorf("timed out waiting for the resize of the PVC %s to %s%s", pvcName, size.String(), getPVCConditionsMessage(pvc))
+		}
+	}
+}
+
+// isPVCResized returns whether the capacity of the volume of the PVC has reached the given size
+// and whether the resize of its file system is pending on the node
+func isPVCResized(pvc *corev1.PersistentVolumeClaim, size resource.Quantity) (resized bool, fileSystemResizePending bool) {
+	for _, condition := range pvc.Status.Conditions {
+		if condition.Type == corev1.PersistentVolumeClaimFileSystemResizePending && condition.Status == corev1.ConditionTrue {
+			fileSystemResizePending = true
+		}
+	}
+	capacity, ok := pvc.Status.Capacity[corev1.ResourceStorage]
+	return ok && capacity.Cmp(size) >= 0, fileSystemResizePending
+}
+
+// getPVCConditionsMessage returns the messages of the conditions of the PVC, to explain why its resize is not finished
+func getPVCConditionsMessage(pvc *corev1.PersistentVolumeClaim) string {
+	var messages []string
+	for _, condition := range pvc.Status.Conditions {
+		message := string(condition.Type)
+		if condition.Message != "" {
+			message = fmt.Sprintf("%s: %s", condition.Type, condition.Message)
+		}
+		messages = append(messages, message)
+	}
+	if len(messages) == 0 {
+		return ""
+	}
+	return fmt.Sprintf(", the conditions of the PVC are: %s", strings.Join(messages, "; "))
+}
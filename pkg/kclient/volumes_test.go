@@ -1,15 +1,18 @@
 package kclient
 
 import (
+	"context"
 	"fmt"
 	"reflect"
 	"testing"
+	"time"
 
 	"github.com/openshift/odo/pkg/testingutil"
 
 	"github.com/pkg/errors"
 
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ktesting "k8s.io/client-go/testing"
 
@@ -402,3 +405,179 @@ func TestUpdateStorageOwnerReference(t *testing.T) {
 		})
 	}
 }
+
+func TestValidateStorageClass(t *testing.T) {
+	tests := []struct {
+		name           string
+		storageClasses []string
+		storageClass   string
+		wantErr        string
+	}{
+		{
+			name:           "Case 1: existing storage class",
+			storageClasses: []string{"standard", "fast-ssd"},
+			storageClass:   "fast-ssd",
+		},
+		{
+			name:           "Case 2: missing storage class",
+			storageClasses: []string{"standard", "fast-ssd"},
+			storageClass:   "slow",
+			wantErr:        "the storage class slow doesn't exist, the storage classes of the cluster are: standard, fast-ssd",
+		},
+		{
+			name:         "Case 3: cluster without storage class",
+			storageClass: "slow",
+			wantErr:      "the storage class slow doesn't exist, the cluster has no storage class",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, fakeClientSet := FakeNew()
+			fakeClientSet.Kubernetes.PrependReactor("list", "storageclasses", func(action ktesting.Action) (bool, runtime.Object, error) {
+				list := &storagev1.StorageClassList{}
+				for _, name := range tt.storageClasses {
+					list.Items = append(list.Items, storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: name}})
+				}
+				return true, list, nil
+			})
+			err := fakeClient.ValidateStorageClass(tt.storageClass)
+			if tt.wantErr == "" && err != nil {
+				t.Errorf("ValidateStorageClass() unexpected error: %v", err)
+			}
+			if tt.wantErr != "" && (err == nil || err.Error() != tt.wantErr) {
+				t.Errorf("ValidateStorageClass() error = %v, want %q", err, tt.wantErr)
+			}
+		})
+	}
+}
+
+func TestExpandPVC(t *testing.T) {
+	allow, deny := true, false
+	tests := []struct {
+		name                 string
+		storageClass         string
+		allowVolumeExpansion *bool
+		wantErr              bool
+	}{
+		{
+			name:                 "Case 1: storage class allowing the expansion of the volumes",
+			storageClass:         "expandable",
+			allowVolumeExpansion: &allow,
+		},
+		{
+			name:                 "Case 2: storage class not allowing the expansion of the volumes",
+			storageClass:         "fixed",
+			allowVolumeExpansion: &deny,
+			wantErr:              true,
+		},
+		{
+			name:         "Case 3: storage class without allowVolumeExpansion",
+			storageClass: "standard",
+			wantErr:      true,
+		},
+		{
+			name:    "Case 4: PVC without storage class",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fakeClient, fakeClientSet := FakeNew()
+			fakeClient.Namespace = "default"
+
+			pvc := testingutil.FakePVC("mypvc", "1Gi", nil)
+			pvc.Namespace = "default"
+			if tt.storageClass != "" {
+				pvc.Spec.StorageClassName = &tt.storageClass
+				_, err := fakeClientSet.Kubernetes.StorageV1().StorageClasses().Create(context.TODO(), &storagev1.StorageClass{
+					ObjectMeta:           metav1.ObjectMeta{Name: tt.storageClass},
+					AllowVolumeExpansion: tt.allowVolumeExpansion,
+				}, metav1.CreateOptions{})
+				if err != nil {
+					t.Fatal(err)
+				}
+			}
+			_, err := fakeClientSet.Kubernetes.CoreV1().PersistentVolumeClaims("default").Create(context.TODO(), pvc, metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			expanded, err := fakeClient.ExpandPVC("mypvc", resource.MustParse("5Gi"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ExpandPVC() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			size := expanded.Spec.Resources.Requests[corev1.ResourceStorage]
+			if size.String() != "5Gi" {
+				t.Errorf("ExpandPVC() size = %s, want 5Gi", size.String())
+			}
+		})
+	}
+}
+
+func TestIsPVCResized(t *testing.T) {
+	size := resource.MustParse("5Gi")
+	tests := []struct {
+		name        string
+		status      corev1.PersistentVolumeClaimStatus
+		wantResized bool
+		wantPending bool
+	}{
+		{
+			name: "Case 1: resize in progress",
+			status: corev1.PersistentVolumeClaimStatus{
+				Capacity:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				Conditions: []corev1.PersistentVolumeClaimCondition{{Type: corev1.PersistentVolumeClaimResizing, Status: corev1.ConditionTrue}},
+			},
+		},
+		{
+			name: "Case 2: file system resize pending on the node",
+			status: corev1.PersistentVolumeClaimStatus{
+				Capacity:   corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+				Conditions: []corev1.PersistentVolumeClaimCondition{{Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue}},
+			},
+			wantPending: true,
+		},
+		{
+			name: "Case 3: volume resized",
+			status: corev1.PersistentVolumeClaimStatus{
+				Capacity: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("5120Mi")},
+			},
+			wantResized: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			resized, pending := isPVCResized(&corev1.PersistentVolumeClaim{Status: tt.status}, size)
+			if resized != tt.wantResized || pending != tt.wantPending {
+				t.Errorf("isPVCResized() = %v, %v, want %v, %v", resized, pending, tt.wantResized, tt.wantPending)
+			}
+		})
+	}
+}
+
+func TestWaitForPVCDeletion(t *testing.T) {
+	fakeClient, fakeClientSet := FakeNew()
+	fakeClient.Namespace = "default"
+
+	pvc := testingutil.FakePVC("mypvc", "1Gi", nil)
+	pvc.Namespace = "default"
+	_, err := fakeClientSet.Kubernetes.CoreV1().PersistentVolumeClaims("default").Create(context.TODO(), pvc, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// the PVC is still present, protected by its finalizer
+	if err = fakeClient.WaitForPVCDeletion("mypvc", 100*time.Millisecond); err == nil {
+		t.Errorf("WaitForPVCDeletion() expected a timeout error while the PVC exists")
+	}
+
+	if err = fakeClient.DeletePVC("mypvc"); err != nil {
+		t.Fatal(err)
+	}
+	if err = fakeClient.WaitForPVCDeletion("mypvc", 100*time.Millisecond); err != nil {
+		t.Errorf("WaitForPVCDeletion() unexpected error %v", err)
+	}
+}
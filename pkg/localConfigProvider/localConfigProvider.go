@@ -8,6 +8,13 @@ const (
 	ROUTE   URLKind = "route"
 )
 
+const (
+	// IssuerKind is the kind of the namespaced cert-manager issuers
+	IssuerKind = "Issuer"
+	// ClusterIssuerKind is the kind of the cluster-wide cert-manager issuers
+	ClusterIssuerKind = "ClusterIssuer"
+)
+
 // LocalURL holds URL related information
 type LocalURL struct {
 	// Name of the URL
@@ -24,6 +31,20 @@ type LocalURL struct {
 	ExposedPort int `yaml:"ExposedPort,omitempty" json:"exposedPort,omitempty"`
 	// Kind is the kind of the URL
 	Kind URLKind `yaml:"Kind,omitempty" json:"kind,omitempty"`
+	// IngressClass is the class of the ingress of the URL, for URLs of Ingress kind
+	IngressClass string `yaml:"IngressClass,omitempty" json:"ingressClass,omitempty"`
+	// Annotations are the annotations of the ingress of the URL, for URLs of Ingress kind
+	Annotations map[string]string `yaml:"Annotations,omitempty" json:"annotations,omitempty"`
+	// TLSSecretNamespace is the namespace the TLS secret is copied from, for secure URLs reusing a secret of another namespace
+	TLSSecretNamespace string `yaml:"TLSSecretNamespace,omitempty" json:"tlsSecretNamespace,omitempty"`
+	// TLSCertFile is the certificate file the TLS secret of the URL is created from
+	TLSCertFile string `yaml:"TLSCertFile,omitempty" json:"tlsCertFile,omitempty"`
+	// TLSKeyFile is the private key file the TLS secret of the URL is created from
+	TLSKeyFile string `yaml:"TLSKeyFile,omitempty" json:"tlsKeyFile,omitempty"`
+	// Issuer is the name of the cert-manager issuer the certificate of the URL is requested from
+	Issuer string `yaml:"Issuer,omitempty" json:"issuer,omitempty"`
+	// IssuerKind is the kind of the cert-manager issuer, Issuer or ClusterIssuer
+	IssuerKind string `yaml:"IssuerKind,omitempty" json:"issuerKind,omitempty"`
 	// Path is the path of the URL
 	Path string `yaml:"-" json:"-"`
 	// Container is the container of the URL
@@ -40,6 +61,12 @@ type LocalStorage struct {
 	Size string `yaml:"Size,omitempty"`
 	// Path of the storage to which it will be mounted on the container
 	Path string `yaml:"Path,omitempty"`
+	// StorageClass is the storage class of the PVC of the storage, the default class of the cluster is used when empty
+	StorageClass string `yaml:"StorageClass,omitempty"`
+	// AccessModes are the access modes of the PVC of the storage, like ReadWriteOnce or ReadWriteMany
+	AccessModes []string `yaml:"AccessModes,omitempty"`
+	// VolumeMode is the volume mode of the PVC of the storage, Filesystem or Block
+	VolumeMode string `yaml:"VolumeMode,omitempty"`
 	// Container is the container name on which this storage is mounted
 	Container string `yaml:"-" json:"-"`
 }
@@ -383,13 +383,13 @@ func ExplicitSpinner(status string, preventSpinning bool) *Status {
 	return s
 }
 
-// IsJSON returns true if we are in machine output mode..
-// under NO circumstances should we output any logging.. as we are only outputting json
+// IsJSON returns true if we are in machine output mode, with any of the output formats of -o..
+// under NO circumstances should we output any logging.. as we are only outputting the machine readable output
 func IsJSON() bool {
 
 	flag := pflag.Lookup("o")
 	if flag != nil && flag.Changed {
-		return strings.Contains(pflag.Lookup("o").Value.String(), "json")
+		return pflag.Lookup("o").Value.String() != ""
 	}
 
 	return false
@@ -89,6 +89,11 @@ func (c *NoOpMachineEventLoggingClient) KubernetesPodStatus(pods []KubernetesPod
 
 }
 
+// ServiceStatus ignores the provided event.
+func (c *NoOpMachineEventLoggingClient) ServiceStatus(name string, kind string, state string, conditions []ServiceStatusCondition, timestamp string) {
+
+}
+
 // NewConsoleMachineEventLoggingClient creates a new instance of ConsoleMachineEventLoggingClient,
 // which will output events as JSON to the console.
 func NewConsoleMachineEventLoggingClient() *ConsoleMachineEventLoggingClient {
@@ -227,6 +232,22 @@ func (c *ConsoleMachineEventLoggingClient) KubernetesPodStatus(pods []Kubernetes
 	c.outputJSON(json)
 }
 
+// ServiceStatus outputs the provided event as JSON to the console.
+func (c *ConsoleMachineEventLoggingClient) ServiceStatus(name string, kind string, state string, conditions []ServiceStatusCondition, timestamp string) {
+	json := MachineEventWrapper{
+		ServiceStatus: &ServiceStatus{
+			Name:       name,
+			Kind:       kind,
+			State:      state,
+			Conditions: conditions,
+			AbstractLogEvent: AbstractLogEvent{
+				Timestamp: timestamp,
+			},
+		},
+	}
+	c.outputJSON(json)
+}
+
 func (c *ConsoleMachineEventLoggingClient) outputJSON(machineOutput MachineEventWrapper) {
 
 	if c.logFunc != nil {
@@ -265,6 +286,9 @@ func (w MachineEventWrapper) GetEntry() (MachineEventLogEntry, error) {
 	} else if w.URLReachable != nil {
 		return w.URLReachable, nil
 
+	} else if w.ServiceStatus != nil {
+		return w.ServiceStatus, nil
+
 	} else {
 		return nil, errors.New("unexpected machine event log entry")
 	}
@@ -302,6 +326,9 @@ func (c URLReachable) GetType() MachineEventLogEntryType { return TypeURLReachab
 // GetType returns the event type for this event.
 func (c KubernetesPodStatus) GetType() MachineEventLogEntryType { return TypeKubernetesPodStatus }
 
+// GetType returns the event type for this event.
+func (c ServiceStatus) GetType() MachineEventLogEntryType { return TypeServiceStatus }
+
 // MachineEventLogEntryType indicates the machine-readable event type from an ODO operation
 type MachineEventLogEntryType int
 
@@ -322,6 +349,8 @@ const (
 	TypeURLReachable MachineEventLogEntryType = 6
 	// TypeKubernetesPodStatus is the entry type for that event.
 	TypeKubernetesPodStatus MachineEventLogEntryType = 7
+	// TypeServiceStatus is the entry type for that event.
+	TypeServiceStatus MachineEventLogEntryType = 8
 )
 
 // GetCommandName returns a command if the MLE supports that field (otherwise empty string is returned).
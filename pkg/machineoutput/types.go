@@ -3,6 +3,8 @@ package machineoutput
 import (
 	"encoding/json"
 	"fmt"
+	"io"
+	"os"
 	"sync"
 
 	"github.com/openshift/odo/pkg/log"
@@ -50,15 +52,39 @@ func OutputSuccessUnindented(machineOutput interface{}) {
 	}
 }
 
-// OutputSuccess outputs a "successful" machine-readable output format in json
-func OutputSuccess(machineOutput interface{}) {
-	printableOutput, err := marshalJSONIndented(machineOutput)
+// Printer prints the machine readable output of a command in an output format
+type Printer interface {
+	// PrintObj prints the object to the writer
+	PrintObj(obj interface{}, w io.Writer) error
+}
 
-	// If we error out... there's no way to output it (since we disable logging when using -o json)
+// JSONPrinter prints the machine readable output in indented json
+type JSONPrinter struct{}
+
+// PrintObj prints the object in indented json
+func (p JSONPrinter) PrintObj(obj interface{}, w io.Writer) error {
+	printableOutput, err := marshalJSONIndented(obj)
 	if err != nil {
-		fmt.Fprintf(log.GetStderr(), "Unable to unmarshal JSON: %s\n", err.Error())
-	} else {
-		fmt.Fprintf(log.GetStdout(), "%s\n", string(printableOutput))
+		return fmt.Errorf("unable to marshal JSON: %w", err)
+	}
+	_, err = fmt.Fprintf(w, "%s\n", string(printableOutput))
+	return err
+}
+
+// printer is the printer used by OutputSuccess, set from the -o flag of the command
+var printer Printer = JSONPrinter{}
+
+// SetPrinter sets the printer used to output the machine readable output of the command
+func SetPrinter(p Printer) {
+	printer = p
+}
+
+// OutputSuccess outputs a "successful" machine-readable output format with the printer of the -o flag, json by default
+func OutputSuccess(machineOutput interface{}) {
+	// If we error out... there's no way to output it (since we disable logging when using -o json)
+	if err := printer.PrintObj(machineOutput, log.GetStdout()); err != nil {
+		fmt.Fprintf(log.GetStderr(), "Unable to print the output: %s\n", err.Error())
+		os.Exit(1)
 	}
 }
 
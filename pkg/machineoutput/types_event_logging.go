@@ -23,6 +23,8 @@ type MachineEventLoggingClient interface {
 
 	KubernetesPodStatus(pods []KubernetesPodStatusEntry, timestamp string)
 
+	ServiceStatus(name string, kind string, state string, conditions []ServiceStatusCondition, timestamp string)
+
 	// CreateContainerOutputWriter is used to capture output from container processes, and synchronously write it to the screen as LogText. See implementation comments for details.
 	CreateContainerOutputWriter() (*io.PipeWriter, chan interface{}, *io.PipeWriter, chan interface{})
 }
@@ -38,6 +40,7 @@ type MachineEventWrapper struct {
 	ContainerStatus                 *ContainerStatus                 `json:"containerStatus,omitempty"`
 	URLReachable                    *URLReachable                    `json:"urlReachable,omitempty"`
 	KubernetesPodStatus             *KubernetesPodStatus             `json:"kubernetesPodStatus,omitempty"`
+	ServiceStatus                   *ServiceStatus                   `json:"serviceStatus,omitempty"`
 }
 
 // DevFileCommandExecutionBegin is the JSON event that is emitted when a dev file command begins execution.
@@ -124,6 +127,23 @@ type KubernetesPodStatusEntry struct {
 	// vast majority are useful.
 }
 
+// ServiceStatus is the JSON event that is emitted when the status of an operator backed service changes
+type ServiceStatus struct {
+	Name       string                   `json:"name"`
+	Kind       string                   `json:"kind"`
+	State      string                   `json:"state"`
+	Conditions []ServiceStatusCondition `json:"conditions,omitempty"`
+	AbstractLogEvent
+}
+
+// ServiceStatusCondition is an individual status condition reported by an operator backed service
+type ServiceStatusCondition struct {
+	Type    string `json:"type"`
+	Status  string `json:"status"`
+	Reason  string `json:"reason,omitempty"`
+	Message string `json:"message,omitempty"`
+}
+
 // AbstractLogEvent is the base struct for all events; all events must at a minimum contain a timestamp.
 type AbstractLogEvent struct {
 	Timestamp string `json:"timestamp"`
@@ -138,6 +158,7 @@ var _ MachineEventLogEntry = &SupervisordStatus{}
 var _ MachineEventLogEntry = &ContainerStatus{}
 var _ MachineEventLogEntry = &URLReachable{}
 var _ MachineEventLogEntry = &KubernetesPodStatus{}
+var _ MachineEventLogEntry = &ServiceStatus{}
 
 // MachineEventLogEntry contains the expected methods for every event that is emitted.
 // (This is mainly used for test purposes.)
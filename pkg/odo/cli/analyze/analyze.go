@@ -0,0 +1,158 @@
+package analyze
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/openshift/odo/pkg/analyze"
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended analyze command name
+const RecommendedCommandName = "analyze"
+
+var (
+	analyzeLongDesc = ktemplates.LongDesc(`Detect the languages, frameworks and build tools of the project in the context directory,
+and rank the devfile stacks of the registries matching them.
+
+The project is analyzed from its build files, like package.json, pom.xml, build.gradle, go.mod or requirements.txt,
+the frameworks they reference, like Spring Boot, Quarkus, Express or Django, and the ratios of its source files per language.
+The stacks are matched on their language, project type, name and tags.`)
+
+	analyzeExample = ktemplates.Examples(`
+	# Analyze the project in the current directory
+	%[1]s
+
+	# Analyze the project in the directory ./frontend, and only match the stacks of the registry DefaultDevfileRegistry
+	%[1]s --context ./frontend --registry DefaultDevfileRegistry -o json
+	`)
+)
+
+// ProjectAnalysis is the JSON output of odo analyze -o json
+type ProjectAnalysis struct {
+	metav1.TypeMeta  `json:",inline"`
+	analyze.Analysis `json:",inline"`
+	Stacks           []analyze.StackMatch `json:"stacks"`
+}
+
+// AnalyzeOptions encapsulates the options for the odo analyze command
+type AnalyzeOptions struct {
+	contextFlag  string
+	registryFlag string
+
+	analysis analyze.Analysis
+	stacks   []catalog.DevfileComponentType
+}
+
+// NewAnalyzeOptions creates a new AnalyzeOptions instance
+func NewAnalyzeOptions() *AnalyzeOptions {
+	return &AnalyzeOptions{}
+}
+
+// Complete completes AnalyzeOptions after they've been created
+func (o *AnalyzeOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if o.contextFlag == "" {
+		o.contextFlag, err = os.Getwd()
+		if err != nil {
+			return err
+		}
+	}
+	o.analysis, err = analyze.Analyze(o.contextFlag)
+	if err != nil {
+		return err
+	}
+
+	catalogDevfileList, err := catalog.ListDevfileComponents(o.registryFlag)
+	if err != nil {
+		return err
+	}
+	if catalogDevfileList.DevfileRegistries == nil {
+		log.Warning("Please run 'odo registry add <registry name> <registry URL>' to add registry for matching devfile stacks\n")
+	}
+	o.stacks = catalogDevfileList.Items
+	return nil
+}
+
+// Validate validates the AnalyzeOptions based on completed values
+func (o *AnalyzeOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo analyze command
+func (o *AnalyzeOptions) Run(cmd *cobra.Command) (err error) {
+	matches := analyze.MatchStacks(o.analysis, o.stacks)
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(ProjectAnalysis{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "ProjectAnalysis",
+				APIVersion: machineoutput.APIVersion,
+			},
+			Analysis: o.analysis,
+			Stacks:   matches,
+		})
+		return nil
+	}
+
+	if len(o.analysis.Languages) == 0 {
+		log.Warningf("No language detected in the directory %s", o.contextFlag)
+		return nil
+	}
+
+	log.Info("Detected languages")
+	for _, language := range o.analysis.Languages {
+		details := fmt.Sprintf("%.0f%% of the source files", language.Ratio*100)
+		if len(language.Markers) > 0 {
+			details += ", " + strings.Join(language.Markers, ", ")
+		}
+		fmt.Printf("  %s (%s)\n", language.Name, details)
+	}
+	if len(o.analysis.Frameworks) > 0 {
+		log.Infof("Detected frameworks: %s", strings.Join(o.analysis.Frameworks, ", "))
+	}
+	if len(o.analysis.Tools) > 0 {
+		log.Infof("Detected tools: %s", strings.Join(o.analysis.Tools, ", "))
+	}
+
+	if len(matches) == 0 {
+		log.Warning("No devfile stack matches the project")
+		return nil
+	}
+	fmt.Println()
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "STACK", "\t", "REGISTRY", "\t", "SCORE", "\t", "MATCHES")
+	for _, match := range matches {
+		fmt.Fprintln(w, match.Name, "\t", match.Registry, "\t", match.Score, "\t", strings.Join(match.Reasons, ", "))
+	}
+	w.Flush()
+	log.Italicf("\nRun `odo create %s` to create a component of the best matching stack", matches[0].Name)
+	return nil
+}
+
+// NewCmdAnalyze implements the odo analyze command
+func NewCmdAnalyze(name, fullName string) *cobra.Command {
+	o := NewAnalyzeOptions()
+	analyzeCmd := &cobra.Command{
+		Use:         name,
+		Short:       "Detect the project type and suggest devfile stacks",
+		Long:        analyzeLongDesc,
+		Example:     fmt.Sprintf(analyzeExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json", "command": "component"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	analyzeCmd.Flags().StringVar(&o.registryFlag, "registry", "", "Only match the stacks of the registry")
+	genericclioptions.AddContextFlag(analyzeCmd, &o.contextFlag)
+	return analyzeCmd
+}
@@ -26,20 +26,28 @@ func NewCmdApplication(name, fullName string) *cobra.Command {
 	delete := NewCmdDelete(deleteRecommendedCommandName, odoutil.GetFullName(fullName, deleteRecommendedCommandName))
 	describe := NewCmdDescribe(describeRecommendedCommandName, odoutil.GetFullName(fullName, describeRecommendedCommandName))
 	list := NewCmdList(listRecommendedCommandName, odoutil.GetFullName(fullName, listRecommendedCommandName))
+	push := NewCmdPush(pushRecommendedCommandName, odoutil.GetFullName(fullName, pushRecommendedCommandName))
+	watch := NewCmdWatch(watchRecommendedCommandName, odoutil.GetFullName(fullName, watchRecommendedCommandName))
+	export := NewCmdExport(exportRecommendedCommandName, odoutil.GetFullName(fullName, exportRecommendedCommandName))
+	importCmd := NewCmdImport(importRecommendedCommandName, odoutil.GetFullName(fullName, importRecommendedCommandName))
 	applicationCmd := &cobra.Command{
 		Use:   name,
 		Short: "Perform application operations",
 		Long:  `Performs application operations related to your project.`,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			delete.Example,
 			describe.Example,
-			list.Example),
+			list.Example,
+			push.Example,
+			watch.Example,
+			export.Example,
+			importCmd.Example),
 		Aliases: []string{"application"},
 		Run: func(cmd *cobra.Command, args []string) {
 		},
 	}
 
-	applicationCmd.AddCommand(delete, describe, list)
+	applicationCmd.AddCommand(delete, describe, list, push, watch, export, importCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	applicationCmd.Annotations = map[string]string{"command": "main"}
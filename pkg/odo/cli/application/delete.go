@@ -8,11 +8,13 @@ import (
 
 	"github.com/openshift/odo/pkg/application"
 	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
 	"github.com/openshift/odo/pkg/odo/cli/project"
 	"github.com/openshift/odo/pkg/odo/cli/ui"
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	"github.com/openshift/odo/pkg/odo/util/completion"
 	"github.com/openshift/odo/pkg/util"
+	"github.com/openshift/odo/pkg/workspace"
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
@@ -21,13 +23,17 @@ const deleteRecommendedCommandName = "delete"
 
 var (
 	deleteExample = ktemplates.Examples(`  # Delete the application
-  %[1]s myapp`)
+  %[1]s myapp
+
+  # Delete the components listed in the odo-workspace.yaml file of the current directory
+  %[1]s --workspace odo-workspace.yaml`)
 )
 
 // DeleteOptions encapsulates the options for the odo command
 type DeleteOptions struct {
 	appName string
 	force   bool
+	workspaceOptions
 	*genericclioptions.Context
 }
 
@@ -38,6 +44,13 @@ func NewDeleteOptions() *DeleteOptions {
 
 // Complete completes DeleteOptions after they've been created
 func (o *DeleteOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if o.workspacePath != "" {
+		if len(args) == 1 {
+			return fmt.Errorf("the application name can't be used with the --%s flag", workspaceFlagName)
+		}
+		return o.completeWorkspace()
+	}
+
 	if util.CheckPathExists(filepath.Join(".odo", "config.yaml")) {
 		o.Context, err = genericclioptions.NewContext(cmd)
 	} else {
@@ -57,6 +70,9 @@ func (o *DeleteOptions) Complete(name string, cmd *cobra.Command, args []string)
 
 // Validate validates the DeleteOptions based on completed values
 func (o *DeleteOptions) Validate() (err error) {
+	if o.workspace != nil {
+		return o.validateWorkspace()
+	}
 	if o.Context.Project == "" || o.appName == "" {
 		return odoUtil.ThrowContextError()
 	}
@@ -73,6 +89,10 @@ func (o *DeleteOptions) Validate() (err error) {
 
 // Run contains the logic for the odo command
 func (o *DeleteOptions) Run(cmd *cobra.Command) (err error) {
+	if o.workspace != nil {
+		return o.deleteWorkspace()
+	}
+
 	if log.IsJSON() {
 		err = application.Delete(o.Client, o.appName)
 		if err != nil {
@@ -99,6 +119,38 @@ func (o *DeleteOptions) Run(cmd *cobra.Command) (err error) {
 	return
 }
 
+// deleteWorkspace deletes the components of the workspace, each component being deleted
+// before the components it depends on
+func (o *DeleteOptions) deleteWorkspace() error {
+	if !log.IsJSON() {
+		log.Info("The following components will be deleted")
+		for _, c := range o.workspace.Components {
+			log.Info("component named", c.Name, "of the context", c.Context)
+		}
+		if !o.force && !ui.Proceed("Are you sure you want to delete the components of the workspace") {
+			log.Info("Aborting deletion of the components of the workspace")
+			return nil
+		}
+	}
+
+	ctx, isInterrupted, stop := interruptibleContext()
+	defer stop()
+
+	results := o.workspace.Reversed().Run(ctx, odoTask(nil, "delete", "--force"), workspace.RunOptions{
+		Concurrency: o.concurrency,
+		OnDone:      printResult,
+	})
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(workspace.GetMachineReadableFormatForList(results))
+	} else {
+		printSummary(results)
+	}
+	if isInterrupted() {
+		return fmt.Errorf("the deletion of the components has been interrupted")
+	}
+	return resultsError("delete", results)
+}
+
 // NewCmdDelete implements the odo command.
 func NewCmdDelete(name, fullName string) *cobra.Command {
 	o := NewDeleteOptions()
@@ -114,6 +166,8 @@ func NewCmdDelete(name, fullName string) *cobra.Command {
 	}
 
 	command.Flags().BoolVarP(&o.force, "force", "f", false, "Delete application without prompting")
+	command.Flags().StringVar(&o.workspacePath, workspaceFlagName, "", "Delete the components listed in the workspace file, or in the workspace file of the directory, instead of the application")
+	command.Flags().IntVar(&o.concurrency, concurrencyFlagName, defaultConcurrency, "Maximum number of components of the workspace deleted at the same time, 0 for no limit")
 
 	project.AddProjectFlag(command)
 	completion.RegisterCommandHandler(command, completion.AppCompletionHandler)
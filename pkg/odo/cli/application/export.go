@@ -0,0 +1,122 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/workspace"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const exportRecommendedCommandName = "export"
+
+var (
+	exportLongDesc = ktemplates.LongDesc(`Export the components of the application listed in a workspace file to a bundle archive.
+
+	The bundle contains the context of each component: its devfile, with its storage, operator services and links,
+	and its env file, with its URLs and settings. The source files of the contexts are exported too, except the files
+	ignored by their .odoignore or .gitignore file.
+	The certificate and key files of the URLs are always exported, the files outside of a context being exported to
+	its .odo/tls directory.
+	The data of the storage of the components can be exported too, the components must have been pushed.
+
+	The bundle can be imported with the import command, in another project or on another cluster.`)
+
+	exportExample = ktemplates.Examples(`  # Export the components listed in the odo-workspace.yaml file of the current directory to myapp.zip
+  %[1]s myapp.zip
+
+  # Export the devfiles and env files of the components without their source files
+  %[1]s myapp.zip --exclude-source
+
+  # Export the components with the data of their storage
+  %[1]s myapp.zip --include-data`)
+)
+
+// ExportOptions encapsulates the options for the odo app export command
+type ExportOptions struct {
+	workspaceOptions
+	bundlePath    string
+	excludeSource bool
+	includeData   bool
+}
+
+// NewExportOptions creates a new ExportOptions instance
+func NewExportOptions() *ExportOptions {
+	return &ExportOptions{}
+}
+
+// Complete completes ExportOptions after they've been created
+func (o *ExportOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.bundlePath, err = filepath.Abs(args[0])
+	if err != nil {
+		return err
+	}
+	return o.completeWorkspace()
+}
+
+// Validate validates the ExportOptions based on completed values
+func (o *ExportOptions) Validate() (err error) {
+	if filepath.Ext(o.bundlePath) != ".zip" {
+		return fmt.Errorf("the bundle %s must be a zip archive with the .zip extension", o.bundlePath)
+	}
+	return nil
+}
+
+// Run contains the logic for the odo app export command
+func (o *ExportOptions) Run(cmd *cobra.Command) (err error) {
+	options := workspace.ExportOptions{
+		Source: !o.excludeSource,
+	}
+	if o.includeData {
+		options.ExportData = func(c workspace.Component, contextDir, storage, dir string) error {
+			spinner := log.Spinnerf("Exporting the data of the storage %s of the component %s", storage, c.Name)
+			defer spinner.End(false)
+			output, err := odoTask(nil, "storage", "cp", storage+":", dir)(context.Background(), c, contextDir)
+			if err != nil {
+				return fmt.Errorf("%v: %s", err, output)
+			}
+			spinner.End(true)
+			return nil
+		}
+	}
+
+	log.Infof("Exporting %d components to %s", len(o.workspace.Components), o.bundlePath)
+	bundle, err := o.workspace.Export(o.bundlePath, options)
+	if err != nil {
+		return err
+	}
+
+	for _, c := range bundle.Components {
+		if len(c.Storages) > 0 {
+			log.Successf("Exported the component %s with the data of the storage %s", c.Name, strings.Join(c.Storages, ", "))
+		} else {
+			log.Successf("Exported the component %s", c.Name)
+		}
+	}
+	return nil
+}
+
+// NewCmdExport implements the odo app export command
+func NewCmdExport(name, fullName string) *cobra.Command {
+	o := NewExportOptions()
+	command := &cobra.Command{
+		Use:     fmt.Sprintf("%s <bundle>", name),
+		Short:   "Export the components of the application listed in a workspace file to a bundle archive",
+		Long:    exportLongDesc,
+		Example: fmt.Sprintf(exportExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	command.Flags().StringVar(&o.workspacePath, workspaceFlagName, workspace.FileName, "Workspace file listing the contexts of the components of the application, or directory containing it")
+	command.Flags().BoolVar(&o.excludeSource, "exclude-source", false, "Export only the devfiles and env files of the components, without their source files")
+	command.Flags().BoolVar(&o.includeData, "include-data", false, "Export the data of the storage of the components")
+	return command
+}
@@ -0,0 +1,177 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/workspace"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const importRecommendedCommandName = "import"
+
+var (
+	importLongDesc = ktemplates.LongDesc(`Import the components of a bundle archive created by the export command.
+
+	The context of each component is created in a directory named after the component, with a workspace file listing
+	them. The components are then pushed as with the push command, and the data of their storage is copied to the
+	storage if the bundle contains it.
+
+	The components can be imported into another project: the project of the env files of the components is replaced,
+	as well as the namespaces of the Kubernetes manifests of their devfiles, like the ServiceBindings of their links.
+	The project must exist. The cluster host of the URLs can be replaced too, when importing on another cluster.`)
+
+	importExample = ktemplates.Examples(`  # Import the components of myapp.zip in the current directory and push them
+  %[1]s myapp.zip
+
+  # Import the components of myapp.zip in the directory myapp without pushing them
+  %[1]s myapp.zip myapp --skip-push
+
+  # Import the components into the project myproject of another cluster
+  %[1]s myapp.zip --project myproject --host apps.mycluster.example.com`)
+)
+
+// ImportOptions encapsulates the options for the odo app import command
+type ImportOptions struct {
+	workspaceOptions
+	bundlePath  string
+	dir         string
+	project     string
+	application string
+	host        string
+	skipPush    bool
+}
+
+// NewImportOptions creates a new ImportOptions instance
+func NewImportOptions() *ImportOptions {
+	return &ImportOptions{}
+}
+
+// Complete completes ImportOptions after they've been created
+func (o *ImportOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.bundlePath = args[0]
+	o.dir = "."
+	if len(args) == 2 {
+		o.dir = args[1]
+	}
+	o.dir, err = filepath.Abs(o.dir)
+	return err
+}
+
+// Validate validates the ImportOptions based on completed values
+func (o *ImportOptions) Validate() (err error) {
+	if _, err := os.Stat(o.bundlePath); err != nil {
+		return fmt.Errorf("the bundle %s doesn't exist", o.bundlePath)
+	}
+	if _, err := os.Stat(filepath.Join(o.dir, workspace.FileName)); err == nil {
+		return fmt.Errorf("the directory %s already contains a workspace file", o.dir)
+	}
+	return o.validateWorkspace()
+}
+
+// Run contains the logic for the odo app import command
+func (o *ImportOptions) Run(cmd *cobra.Command) (err error) {
+	bundle, err := workspace.ExtractBundle(o.bundlePath)
+	if err != nil {
+		return err
+	}
+	defer bundle.Close() // #nosec G307
+
+	if err := os.MkdirAll(o.dir, os.ModePerm); err != nil {
+		return err
+	}
+	o.workspace, err = bundle.Import(o.dir, workspace.ImportOptions{
+		Project:     o.project,
+		Application: o.application,
+		Host:        o.host,
+	})
+	if err != nil {
+		return err
+	}
+	for _, c := range o.workspace.Components {
+		log.Successf("Created the context %s of the component %s", o.workspace.GetContext(c), c.Name)
+	}
+	if o.skipPush {
+		log.Italicf("\nRun 'odo app push --workspace %s' to push the components", filepath.Join(o.dir, workspace.FileName))
+		return nil
+	}
+
+	ctx, isInterrupted, stop := interruptibleContext()
+	defer stop()
+
+	fmt.Println()
+	log.Infof("Pushing %d components", len(o.workspace.Components))
+	results := o.workspace.Run(ctx, odoTask(nil, "push"), workspace.RunOptions{
+		Concurrency: o.concurrency,
+		FailFast:    o.failFast,
+		OnDone:      printResult,
+	})
+	printSummary(results)
+	if isInterrupted() {
+		return fmt.Errorf("the push of the components has been interrupted")
+	}
+	if err := resultsError("push", results); err != nil {
+		return err
+	}
+
+	return o.importData(bundle)
+}
+
+// importData copies the data of the bundle to the storage of the pushed components
+func (o *ImportOptions) importData(bundle *workspace.ExtractedBundle) error {
+	for _, bc := range bundle.Components {
+		for _, storage := range bc.Storages {
+			c := bc.Component
+			c.Context = bc.Name
+			dataDir := bundle.GetDataDir(bc.Name, storage)
+			files, err := ioutil.ReadDir(dataDir)
+			if err != nil {
+				return err
+			}
+			if len(files) == 0 {
+				continue
+			}
+
+			spinner := log.Spinnerf("Importing the data of the storage %s of the component %s", storage, bc.Name)
+			// the files are copied one by one to the root of the storage
+			for _, file := range files {
+				output, err := odoTask(nil, "storage", "cp", filepath.Join(dataDir, file.Name()), storage+":")(context.Background(), c, o.workspace.GetContext(c))
+				if err != nil {
+					spinner.End(false)
+					return fmt.Errorf("unable to import the data of the storage %s of the component %s: %v: %s", storage, bc.Name, err, output)
+				}
+			}
+			spinner.End(true)
+		}
+	}
+	return nil
+}
+
+// NewCmdImport implements the odo app import command
+func NewCmdImport(name, fullName string) *cobra.Command {
+	o := NewImportOptions()
+	command := &cobra.Command{
+		Use:     fmt.Sprintf("%s <bundle> [directory]", name),
+		Short:   "Import the components of a bundle archive",
+		Long:    importLongDesc,
+		Example: fmt.Sprintf(importExample, fullName),
+		Args:    cobra.RangeArgs(1, 2),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	command.Flags().StringVar(&o.project, genericclioptions.ProjectFlagName, "", "Project the components are imported into, the projects of the bundle are kept if not specified")
+	command.Flags().StringVar(&o.application, genericclioptions.ApplicationFlagName, "", "Application of the imported components, the applications of the bundle are kept if not specified")
+	command.Flags().StringVar(&o.host, "host", "", "Cluster host of the URLs of the imported components, the hosts of the bundle are kept if not specified")
+	command.Flags().BoolVar(&o.skipPush, "skip-push", false, "Create the contexts of the components without pushing them")
+	command.Flags().IntVar(&o.concurrency, concurrencyFlagName, defaultConcurrency, "Maximum number of components pushed at the same time, 0 for no limit")
+	command.Flags().BoolVar(&o.failFast, "fail-fast", false, "Cancel all the pushes as soon as one of them fails, instead of only skipping the components depending on it")
+	return command
+}
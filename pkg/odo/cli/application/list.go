@@ -73,12 +73,9 @@ func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
 		} else {
 			log.Infof("The project '%v' has the following applications:", o.Project)
 			tabWriter := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-			_, err := fmt.Fprintln(tabWriter, "NAME")
-			if err != nil {
-				return err
-			}
+			genericclioptions.PrintTableHeader(tabWriter, "NAME")
 			for _, app := range apps {
-				_, err := fmt.Fprintln(tabWriter, app)
+				_, err = fmt.Fprintln(tabWriter, app)
 				if err != nil {
 					return err
 				}
@@ -0,0 +1,104 @@
+package application
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/workspace"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const pushRecommendedCommandName = "push"
+
+var (
+	pushLongDesc = ktemplates.LongDesc(`Push all the components of the application listed in a workspace file.
+
+	The workspace file lists the contexts of the components, relative to the workspace file, and the components each
+	component depends on. The components are pushed in parallel, a component being pushed once all the components it
+	depends on have been pushed successfully:
+
+	  kind: Workspace
+	  apiVersion: odo.dev/v1alpha1
+	  components:
+	  - context: ./database
+	  - context: ./backend
+	    dependsOn: [database]
+	  - context: ./frontend
+	    env: dev
+
+	The components are identified by the name of the component of their context, or by their "name" field.`)
+
+	pushExample = ktemplates.Examples(`  # Push the components listed in the odo-workspace.yaml file of the current directory
+  %[1]s
+
+  # Push the components of the workspace file ~/myapp/workspace.yaml, 5 at a time
+  %[1]s --workspace ~/myapp/workspace.yaml --concurrency 5
+
+  # Push the components and cancel the other pushes as soon as one of them fails
+  %[1]s --fail-fast`)
+)
+
+// PushOptions encapsulates the options for the odo app push command
+type PushOptions struct {
+	workspaceOptions
+}
+
+// NewPushOptions creates a new PushOptions instance
+func NewPushOptions() *PushOptions {
+	return &PushOptions{}
+}
+
+// Complete completes PushOptions after they've been created
+func (o *PushOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	return o.completeWorkspace()
+}
+
+// Validate validates the PushOptions based on completed values
+func (o *PushOptions) Validate() (err error) {
+	return o.validateWorkspace()
+}
+
+// Run contains the logic for the odo app push command
+func (o *PushOptions) Run(cmd *cobra.Command) (err error) {
+	ctx, isInterrupted, stop := interruptibleContext()
+	defer stop()
+
+	log.Infof("Pushing %d components", len(o.workspace.Components))
+	results := o.workspace.Run(ctx, odoTask(nil, "push"), workspace.RunOptions{
+		Concurrency: o.concurrency,
+		FailFast:    o.failFast,
+		OnDone:      printResult,
+	})
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(workspace.GetMachineReadableFormatForList(results))
+	} else {
+		printSummary(results)
+	}
+	if isInterrupted() {
+		return fmt.Errorf("the push of the components has been interrupted")
+	}
+	return resultsError("push", results)
+}
+
+// NewCmdPush implements the odo app push command
+func NewCmdPush(name, fullName string) *cobra.Command {
+	o := NewPushOptions()
+	command := &cobra.Command{
+		Use:         name,
+		Short:       "Push the components of the application listed in a workspace file",
+		Long:        pushLongDesc,
+		Example:     fmt.Sprintf(pushExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	o.addWorkspaceFlags(command)
+	return command
+}
@@ -0,0 +1,110 @@
+package application
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sync"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/workspace"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const watchRecommendedCommandName = "watch"
+
+var (
+	watchLongDesc = ktemplates.LongDesc(`Watch all the components of the application listed in a workspace file.
+
+	The components are first pushed in the order of their dependencies, as with the push command,
+	then the changes of all the components are watched and pushed at the same time.
+	The output of each component is prefixed with its name.`)
+
+	watchExample = ktemplates.Examples(`  # Push and watch the components listed in the odo-workspace.yaml file of the current directory
+  %[1]s
+
+  # Watch the components of the workspace file ~/myapp/workspace.yaml without pushing them first
+  %[1]s --workspace ~/myapp/workspace.yaml --skip-push`)
+)
+
+// WatchOptions encapsulates the options for the odo app watch command
+type WatchOptions struct {
+	workspaceOptions
+	skipPush bool
+}
+
+// NewWatchOptions creates a new WatchOptions instance
+func NewWatchOptions() *WatchOptions {
+	return &WatchOptions{}
+}
+
+// Complete completes WatchOptions after they've been created
+func (o *WatchOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	return o.completeWorkspace()
+}
+
+// Validate validates the WatchOptions based on completed values
+func (o *WatchOptions) Validate() (err error) {
+	return o.validateWorkspace()
+}
+
+// Run contains the logic for the odo app watch command
+func (o *WatchOptions) Run(cmd *cobra.Command) (err error) {
+	ctx, isInterrupted, stop := interruptibleContext()
+	defer stop()
+
+	if !o.skipPush {
+		log.Infof("Pushing %d components", len(o.workspace.Components))
+		results := o.workspace.Run(ctx, odoTask(nil, "push"), workspace.RunOptions{
+			Concurrency: o.concurrency,
+			FailFast:    o.failFast,
+			OnDone:      printResult,
+		})
+		printSummary(results)
+		if isInterrupted() {
+			return nil
+		}
+		if err := resultsError("push", results); err != nil {
+			return err
+		}
+		fmt.Println()
+	}
+
+	log.Infof("Waiting for something to change in the contexts of the %d components", len(o.workspace.Components))
+	log.Italic("Press Ctrl+c to exit")
+
+	// the watchers never end, they all run at the same time and are all stopped when one of them fails
+	var outputMutex sync.Mutex
+	output := func(c workspace.Component) io.Writer {
+		return newPrefixWriter(c.Name, os.Stdout, &outputMutex)
+	}
+	results := o.workspace.Run(ctx, odoTask(output, "watch"), workspace.RunOptions{
+		FailFast:           true,
+		IgnoreDependencies: true,
+	})
+	if isInterrupted() {
+		return nil
+	}
+	return resultsError("watch", results)
+}
+
+// NewCmdWatch implements the odo app watch command
+func NewCmdWatch(name, fullName string) *cobra.Command {
+	o := NewWatchOptions()
+	command := &cobra.Command{
+		Use:     name,
+		Short:   "Watch the components of the application listed in a workspace file",
+		Long:    watchLongDesc,
+		Example: fmt.Sprintf(watchExample, fullName),
+		Args:    cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	o.addWorkspaceFlags(command)
+	command.Flags().BoolVar(&o.skipPush, "skip-push", false, "Watch the components without pushing them first")
+	return command
+}
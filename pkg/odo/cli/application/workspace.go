@@ -0,0 +1,194 @@
+package application
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"os/signal"
+	"strings"
+	"sync"
+	"syscall"
+	"text/tabwriter"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/workspace"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+)
+
+const (
+	workspaceFlagName   = "workspace"
+	concurrencyFlagName = "concurrency"
+	defaultConcurrency  = 3
+)
+
+// workspaceOptions are the options of the commands working on the components of a workspace
+type workspaceOptions struct {
+	workspacePath string
+	concurrency   int
+	failFast      bool
+	workspace     *workspace.Workspace
+}
+
+// addWorkspaceFlags adds the flags of the commands working on the components of a workspace
+func (wo *workspaceOptions) addWorkspaceFlags(cmd *cobra.Command) {
+	cmd.Flags().StringVar(&wo.workspacePath, workspaceFlagName, workspace.FileName, "Workspace file listing the contexts of the components of the application, or directory containing it")
+	cmd.Flags().IntVar(&wo.concurrency, concurrencyFlagName, defaultConcurrency, "Maximum number of components handled at the same time, 0 for no limit")
+	cmd.Flags().BoolVar(&wo.failFast, "fail-fast", false, "Cancel all the components as soon as one of them fails, instead of only skipping the components depending on it")
+}
+
+// completeWorkspace reads the workspace file
+func (wo *workspaceOptions) completeWorkspace() (err error) {
+	wo.workspace, err = workspace.Read(wo.workspacePath)
+	return err
+}
+
+// validateWorkspace validates the workspace options
+func (wo *workspaceOptions) validateWorkspace() error {
+	if wo.concurrency < 0 {
+		return fmt.Errorf("the value of the --%s flag must be positive", concurrencyFlagName)
+	}
+	return nil
+}
+
+// odoTask returns the task running odo with the given arguments on the context of a component,
+// and returning its combined output. When out is not nil, the output is written to it instead of being returned
+func odoTask(out func(c workspace.Component) io.Writer, args ...string) workspace.Task {
+	return func(ctx context.Context, c workspace.Component, contextDir string) (string, error) {
+		odoPath, err := os.Executable()
+		if err != nil {
+			return "", errors.Wrap(err, "unable to find the odo executable")
+		}
+		cmdArgs := append(append([]string{}, args...), "--"+genericclioptions.ContextFlagName, contextDir)
+		if c.Env != "" {
+			cmdArgs = append(cmdArgs, "--"+genericclioptions.EnvFlagName, c.Env)
+		}
+
+		cmd := exec.CommandContext(ctx, odoPath, cmdArgs...)
+		var output bytes.Buffer
+		if out != nil {
+			cmd.Stdout = out(c)
+			cmd.Stderr = cmd.Stdout
+		} else {
+			cmd.Stdout = &output
+			cmd.Stderr = &output
+		}
+		if err := cmd.Run(); err != nil {
+			return output.String(), errors.Wrapf(err, "odo %s failed", strings.Join(args, " "))
+		}
+		return output.String(), nil
+	}
+}
+
+// interruptibleContext returns a context cancelled when the user interrupts odo, and a function returning
+// true if the context has been cancelled because of an interruption
+func interruptibleContext() (context.Context, func() bool, func()) {
+	ctx, cancel := context.WithCancel(context.Background())
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+
+	var mutex sync.Mutex
+	interrupted := false
+	go func() {
+		if _, ok := <-signals; ok {
+			mutex.Lock()
+			interrupted = true
+			mutex.Unlock()
+			cancel()
+		}
+	}()
+
+	isInterrupted := func() bool {
+		mutex.Lock()
+		defer mutex.Unlock()
+		return interrupted
+	}
+	stop := func() {
+		signal.Stop(signals)
+		close(signals)
+		cancel()
+	}
+	return ctx, isInterrupted, stop
+}
+
+// printResult prints the output of the task run on a component
+func printResult(result workspace.Result) {
+	if log.IsJSON() {
+		return
+	}
+	log.Namef("%s (%s): %s", result.Name, result.Context, result.Status)
+	if result.Output != "" {
+		fmt.Print(result.Output)
+		if !strings.HasSuffix(result.Output, "\n") {
+			fmt.Println()
+		}
+	}
+	if result.Error != "" && result.Status != workspace.StatusFailed {
+		log.Italic(result.Error)
+	}
+	fmt.Println()
+}
+
+// printSummary prints the status of each component
+func printSummary(results []workspace.Result) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "COMPONENT", "\t", "CONTEXT", "\t", "STATUS", "\t", "DURATION")
+	for _, result := range results {
+		fmt.Fprintln(w, result.Name, "\t", result.Context, "\t", result.Status, "\t", result.Duration.Duration)
+	}
+	w.Flush()
+}
+
+// resultsError returns the error listing the components whose task didn't succeed
+func resultsError(operation string, results []workspace.Result) error {
+	var failed []string
+	for _, result := range results {
+		if result.Status != workspace.StatusSucceeded {
+			failed = append(failed, fmt.Sprintf("%s (%s)", result.Name, strings.ToLower(string(result.Status))))
+		}
+	}
+	if len(failed) == 0 {
+		return nil
+	}
+	return fmt.Errorf("unable to %s the components %s", operation, strings.Join(failed, ", "))
+}
+
+// prefixWriter writes the lines of a component to the shared output, prefixed with the name of the component
+type prefixWriter struct {
+	prefix string
+	out    io.Writer
+	mutex  *sync.Mutex
+	buffer []byte
+}
+
+// newPrefixWriter returns a writer prefixing the lines with the name of the component
+func newPrefixWriter(name string, out io.Writer, mutex *sync.Mutex) *prefixWriter {
+	return &prefixWriter{prefix: fmt.Sprintf("[%s] ", name), out: out, mutex: mutex}
+}
+
+// Write writes the complete lines, the last incomplete line is kept until its end is written
+func (pw *prefixWriter) Write(p []byte) (int, error) {
+	pw.buffer = append(pw.buffer, p...)
+	for {
+		i := bytes.IndexByte(pw.buffer, '\n')
+		if i < 0 {
+			break
+		}
+		pw.mutex.Lock()
+		_, err := fmt.Fprintf(pw.out, "%s%s", pw.prefix, pw.buffer[:i+1])
+		pw.mutex.Unlock()
+		if err != nil {
+			return 0, err
+		}
+		pw.buffer = pw.buffer[i+1:]
+	}
+	return len(p), nil
+}
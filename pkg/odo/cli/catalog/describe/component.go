@@ -16,6 +16,7 @@ import (
 	"github.com/openshift/odo/pkg/devfile/validate"
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/machineoutput"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	"github.com/openshift/odo/pkg/util"
 
@@ -33,10 +34,13 @@ const componentRecommendedCommandName = "component"
 
 var (
 	componentExample = ktemplates.Examples(`  # Describe a component
-    %[1]s nodejs`)
+    %[1]s nodejs
+
+    # List the versions of a devfile component provided by the registries
+    %[1]s nodejs --versions`)
 
 	componentLongDesc = ktemplates.LongDesc(`Describe a component type.
-This describes the component and its associated starter projects.
+This describes the component and its associated starter projects, or the versions of the devfile component provided by the registries.
 `)
 )
 
@@ -48,6 +52,8 @@ type DescribeComponentOptions struct {
 	devfileComponents []catalog.DevfileComponentType
 	// if componentName is a classic/odov1 component
 	component string
+	// versionsFlag lists the versions of the devfile components
+	versionsFlag bool
 	// generic context options common to all commands
 	*genericclioptions.Context
 }
@@ -112,8 +118,19 @@ type DevfileComponentDescription struct {
 	Devfile      data.DevfileData `json:"Devfile"`
 }
 
+// DevfileComponentVersions represents the JSON output of the versions of a Devfile component
+// used in odo catalog describe component <name> --versions -o json
+type DevfileComponentVersions struct {
+	RegistryName string                 `json:"RegistryName"`
+	Versions     []catalog.StackVersion `json:"Versions"`
+}
+
 // Run contains the logic for the command associated with DescribeComponentOptions
 func (o *DescribeComponentOptions) Run(cmd *cobra.Command) (err error) {
+	if o.versionsFlag {
+		return o.printVersions()
+	}
+
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 	if log.IsJSON() {
 		if len(o.devfileComponents) > 0 {
@@ -166,6 +183,44 @@ func (o *DescribeComponentOptions) Run(cmd *cobra.Command) (err error) {
 	return nil
 }
 
+// printVersions prints the versions of the devfile components provided by each registry
+func (o *DescribeComponentOptions) printVersions() error {
+	if len(o.devfileComponents) == 0 {
+		return fmt.Errorf("there are no devfile components with the name %q", o.componentName)
+	}
+
+	out := []DevfileComponentVersions{}
+	for _, devfileComponent := range o.devfileComponents {
+		versions, err := catalog.GetStackVersions(devfileComponent.Registry, devfileComponent.Name)
+		if err != nil {
+			return err
+		}
+		out = append(out, DevfileComponentVersions{RegistryName: devfileComponent.Registry.Name, Versions: versions})
+	}
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(out)
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "REGISTRY", "\t", "VERSION", "\t", "DEFAULT", "\t", "SCHEMA VERSION", "\t", "STARTER PROJECTS")
+	for _, registryVersions := range out {
+		for _, version := range registryVersions.Versions {
+			fmt.Fprintln(w, registryVersions.RegistryName, "\t", valueOrDash(version.Version), "\t", version.Default, "\t", valueOrDash(version.SchemaVersion), "\t", strings.Join(version.StarterProjects, ", "))
+		}
+	}
+	w.Flush()
+	return nil
+}
+
+// valueOrDash returns the value, or a dash if the value is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 // NewCmdCatalogDescribeComponent implements the odo catalog describe component command
 func NewCmdCatalogDescribeComponent(name, fullName string) *cobra.Command {
 	o := NewDescribeComponentOptions()
@@ -180,6 +235,7 @@ func NewCmdCatalogDescribeComponent(name, fullName string) *cobra.Command {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	command.Flags().BoolVar(&o.versionsFlag, "versions", false, "List the versions of the devfile component provided by the registries")
 
 	return command
 }
@@ -198,7 +254,20 @@ func GetDevfile(devfileComponent catalog.DevfileComponentType) (parser.DevfileOb
 	var devObj parser.DevfileObj
 	var err error
 
-	if strings.Contains(devfileComponent.Registry.URL, "github") {
+	if registryUtil.IsLocalRegistry(devfileComponent.Registry.URL) {
+		version, err := catalog.GetStackVersion(devfileComponent.Registry, devfileComponent.Name, "")
+		if err != nil {
+			return devObj, err
+		}
+		devfileData, err := catalog.GetStackVersionDevfile(devfileComponent.Registry, devfileComponent.Name, version)
+		if err != nil {
+			return devObj, errors.Wrapf(err, "Failed to read devfile.yaml from local registry for devfile component: %s", devfileComponent.Name)
+		}
+		devObj, err = devfile.ParseFromData(devfileData)
+		if err != nil {
+			return devObj, err
+		}
+	} else if strings.Contains(devfileComponent.Registry.URL, "github") {
 		devObj, err = devfile.ParseFromURL(devfileComponent.Registry.URL + devfileComponent.Link)
 		if err != nil {
 			return devObj, errors.Wrapf(err, "Failed to download devfile.yaml from Github-based registry for devfile component: %s", devfileComponent.Name)
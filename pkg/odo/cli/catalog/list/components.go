@@ -15,7 +15,6 @@ import (
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	"github.com/openshift/odo/pkg/util"
 	"github.com/spf13/cobra"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 const componentsRecommendedCommandName = "components"
@@ -86,13 +85,6 @@ func (o *ListComponentsOptions) Validate() (err error) {
 	return err
 }
 
-type combinedCatalogList struct {
-	metav1.TypeMeta   `json:",inline"`
-	metav1.ObjectMeta `json:"metadata,omitempty"`
-	S2iItems          []catalog.ComponentType        `json:"s2iItems,omitempty"`
-	DevfileItems      []catalog.DevfileComponentType `json:"devfileItems,omitempty"`
-}
-
 // Run contains the logic for the command associated with ListComponentsOptions
 func (o *ListComponentsOptions) Run(cmd *cobra.Command) (err error) {
 	if log.IsJSON() {
@@ -101,15 +93,7 @@ func (o *ListComponentsOptions) Run(cmd *cobra.Command) (err error) {
 			supported, _ := catalog.SliceSupportedTags(image)
 			o.catalogList.Items[i].Spec.SupportedTags = supported
 		}
-		combinedList := combinedCatalogList{
-			TypeMeta: metav1.TypeMeta{
-				Kind:       "List",
-				APIVersion: "odo.dev/v1alpha1",
-			},
-			S2iItems:     o.catalogList.Items,
-			DevfileItems: o.catalogDevfileList.Items,
-		}
-		machineoutput.OutputSuccess(combinedList)
+		machineoutput.OutputSuccess(catalogutil.NewCombinedCatalogList(o.catalogList.Items, o.catalogDevfileList.Items))
 	} else {
 		w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 		var supCatalogList, unsupCatalogList []catalog.ComponentType
@@ -130,7 +114,7 @@ func (o *ListComponentsOptions) Run(cmd *cobra.Command) (err error) {
 
 		if len(o.catalogDevfileList.Items) != 0 {
 			fmt.Fprintln(w, "Odo Devfile Components:")
-			fmt.Fprintln(w, "NAME", "\t", "DESCRIPTION", "\t", "REGISTRY")
+			genericclioptions.PrintTableHeader(w, "NAME", "\t", "DESCRIPTION", "\t", "REGISTRY")
 
 			o.printDevfileCatalogList(w, o.catalogDevfileList.Items, "")
 		}
@@ -141,7 +125,7 @@ func (o *ListComponentsOptions) Run(cmd *cobra.Command) (err error) {
 				fmt.Fprintln(w)
 			}
 			fmt.Fprintln(w, "Odo S2I Components:")
-			fmt.Fprintln(w, "NAME", "\t", "PROJECT", "\t", "TAGS", "\t", "SUPPORTED")
+			genericclioptions.PrintTableHeader(w, "NAME", "\t", "PROJECT", "\t", "TAGS", "\t", "SUPPORTED")
 
 			if len(supCatalogList) != 0 {
 				supported = "YES"
@@ -2,22 +2,39 @@ package search
 
 import (
 	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
 
 	"github.com/openshift/odo/pkg/catalog"
-	"github.com/openshift/odo/pkg/odo/cli/catalog/util"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	catalogutil "github.com/openshift/odo/pkg/odo/cli/catalog/util"
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
 	"github.com/spf13/cobra"
+	"k8s.io/klog"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
 
 const componentRecommendedCommandName = "component"
 
-var componentExample = `  # Search for a component
-  %[1]s python`
+var componentExample = ktemplates.Examples(`  # Search for a component
+  %[1]s python
+
+  # Search for the Java components of the Quarkus project type
+  %[1]s --language java --project-type quarkus
+
+  # Search for the components with both the Java and Maven tags in the registry DefaultDevfileRegistry
+  %[1]s --tags java,maven --registry DefaultDevfileRegistry`)
 
 // SearchComponentOptions encapsulates the options for the odo catalog describe service command
 type SearchComponentOptions struct {
 	searchTerm string
-	components []string
+	filter     catalog.DevfileComponentFilter
+
+	components        []catalog.ComponentType
+	devfileComponents []catalog.DevfileComponentType
 	// generic context options common to all commands
 	*genericclioptions.Context
 }
@@ -29,46 +46,149 @@ func NewSearchComponentOptions() *SearchComponentOptions {
 
 // Complete completes SearchComponentOptions after they've been created
 func (o *SearchComponentOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
-	o.Context, err = genericclioptions.NewContext(cmd)
-	if err != nil {
-		return err
+	if len(args) > 0 {
+		o.searchTerm = args[0]
+		o.filter.Term = args[0]
+	}
+	if o.filter.IsEmpty() {
+		return nil
+	}
+
+	tasks := util.NewConcurrentTasks(2)
+
+	// the s2i components are only searched by name, as they don't have the fields of the other filters
+	fieldFilter := o.filter
+	fieldFilter.Term = ""
+	if fieldFilter.IsEmpty() && util.IsValidKubeConfigPath() {
+		o.Context, err = genericclioptions.NewContext(cmd)
+		if err != nil {
+			return err
+		}
+		supported, err := o.Client.IsImageStreamSupported()
+		if err != nil {
+			klog.V(4).Info("ignoring error while checking imagestream support:", err.Error())
+		}
+		if supported {
+			tasks.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
+				components, err := catalog.SearchComponentTypes(o.Client, o.searchTerm)
+				if err != nil {
+					errChannel <- err
+					return
+				}
+				o.components = catalogutil.FilterHiddenComponents(components)
+			}})
+		}
 	}
-	o.searchTerm = args[0]
 
-	o.components, err = catalog.SearchComponent(o.Client, o.searchTerm)
-	return err
+	tasks.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
+		catalogDevfileList, err := catalog.ListDevfileComponents("")
+		if catalogDevfileList.DevfileRegistries == nil {
+			log.Warning("Please run 'odo registry add <registry name> <registry URL>' to add registry for searching devfile components\n")
+		}
+		if err != nil {
+			errChannel <- err
+			return
+		}
+		o.devfileComponents = catalog.SearchDevfileComponents(catalogDevfileList.Items, o.filter)
+	}})
+
+	return tasks.Run()
 }
 
 // Validate validates the SearchComponentOptions based on completed values
 func (o *SearchComponentOptions) Validate() (err error) {
-	if len(o.components) == 0 {
-		return fmt.Errorf("no component matched the query: %s", o.searchTerm)
+	if o.filter.IsEmpty() {
+		return fmt.Errorf("please provide a search term or at least one of the --language, --project-type, --provider, --registry and --tags flags")
+	}
+	if len(o.components) == 0 && len(o.devfileComponents) == 0 {
+		return fmt.Errorf("no component matched the query: %s", o.describeQuery())
 	}
 
 	return
 }
 
+// describeQuery returns the search term and the filters of the query
+func (o *SearchComponentOptions) describeQuery() string {
+	var query []string
+	if o.searchTerm != "" {
+		query = append(query, o.searchTerm)
+	}
+	for _, filter := range []struct{ name, value string }{
+		{"language", o.filter.Language},
+		{"project type", o.filter.ProjectType},
+		{"provider", o.filter.Provider},
+		{"registry", o.filter.Registry},
+		{"tags", strings.Join(o.filter.Tags, ",")},
+	} {
+		if filter.value != "" {
+			query = append(query, fmt.Sprintf("%s=%s", filter.name, filter.value))
+		}
+	}
+	return strings.Join(query, " ")
+}
+
 // Run contains the logic for the command associated with SearchComponentOptions
 func (o *SearchComponentOptions) Run(cmd *cobra.Command) (err error) {
-	util.DisplayComponents(o.components)
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(catalogutil.NewCombinedCatalogList(o.components, o.devfileComponents))
+		return
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	if len(o.devfileComponents) != 0 {
+		fmt.Fprintln(w, "Odo Devfile Components:")
+		genericclioptions.PrintTableHeader(w, "NAME", "\t", "DESCRIPTION", "\t", "REGISTRY", "\t", "LANGUAGE", "\t", "PROJECT TYPE", "\t", "TAGS")
+		for _, component := range o.devfileComponents {
+			fmt.Fprintln(w, component.Name, "\t", util.TruncateString(component.Description, 60, "..."), "\t", component.Registry.Name, "\t",
+				valueOrDash(component.Language), "\t", valueOrDash(component.ProjectType), "\t", valueOrDash(strings.Join(component.Tags, ",")))
+		}
+	}
+	if len(o.components) != 0 {
+		if len(o.devfileComponents) != 0 {
+			fmt.Fprintln(w)
+		}
+		fmt.Fprintln(w, "Odo S2I Components:")
+		genericclioptions.PrintTableHeader(w, "NAME", "\t", "PROJECT", "\t", "TAGS")
+		for _, component := range o.components {
+			fmt.Fprintln(w, component.Name, "\t", component.Namespace, "\t", strings.Join(component.Spec.NonHiddenTags, ","))
+		}
+	}
+	w.Flush()
 	return
 }
 
+// valueOrDash returns the value, or a dash if the value is empty
+func valueOrDash(value string) string {
+	if value == "" {
+		return "-"
+	}
+	return value
+}
+
 // NewCmdCatalogSearchComponent implements the odo catalog search component command
 func NewCmdCatalogSearchComponent(name, fullName string) *cobra.Command {
 	o := NewSearchComponentOptions()
-	return &cobra.Command{
-		Use:   name,
+	componentSearchCmd := &cobra.Command{
+		Use:   fmt.Sprintf("%s [search term]", name),
 		Short: "Search component type in catalog",
 		Long: `Search component type in catalog.
 
-This searches for a partial match for the given search term in all the available
-components.
+This searches for a fuzzy match for the given search term in the names, display names, tags and
+descriptions of all the available components, and ranks the results from the best match.
+The devfile components can also be filtered on their language, project type, provider, registry
+and tags, which match case-insensitively with a tolerance for typos. The filters are combined.
 `,
-		Args:    cobra.ExactArgs(1),
-		Example: fmt.Sprintf(componentExample, fullName),
+		Args:        cobra.MaximumNArgs(1),
+		Example:     fmt.Sprintf(componentExample, fullName),
+		Annotations: map[string]string{"machineoutput": "json"},
 		Run: func(cmd *cobra.Command, args []string) {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	componentSearchCmd.Flags().StringVar(&o.filter.Language, "language", "", "Language of the devfile components")
+	componentSearchCmd.Flags().StringVar(&o.filter.ProjectType, "project-type", "", "Project type of the devfile components")
+	componentSearchCmd.Flags().StringVar(&o.filter.Provider, "provider", "", "Provider of the devfile components")
+	componentSearchCmd.Flags().StringVar(&o.filter.Registry, "registry", "", "Registry of the devfile components")
+	componentSearchCmd.Flags().StringSliceVar(&o.filter.Tags, "tags", []string{}, "Tags the devfile components have, all the tags are required")
+	return componentSearchCmd
 }
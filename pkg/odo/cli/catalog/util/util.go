@@ -8,15 +8,37 @@ import (
 
 	"github.com/openshift/odo/pkg/catalog"
 	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+// CombinedCatalogList is the JSON output of the commands listing the s2i and devfile components
+type CombinedCatalogList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	S2iItems          []catalog.ComponentType        `json:"s2iItems,omitempty"`
+	DevfileItems      []catalog.DevfileComponentType `json:"devfileItems,omitempty"`
+}
+
+// NewCombinedCatalogList returns the JSON output of the s2i and devfile components
+func NewCombinedCatalogList(s2iItems []catalog.ComponentType, devfileItems []catalog.DevfileComponentType) CombinedCatalogList {
+	return CombinedCatalogList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: "odo.dev/v1alpha1",
+		},
+		S2iItems:     s2iItems,
+		DevfileItems: devfileItems,
+	}
+}
+
 // DisplayServices displays the specified services
 func DisplayServices(services catalog.ServiceTypeList) {
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 	fmt.Fprintln(w) // this newline helps when cluster has both Operator and Service Catalog enabled
 	log.Info("Services available through Service Catalog")
-	fmt.Fprintln(w, "NAME", "\t", "PLANS")
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "PLANS")
 	for _, service := range services.Items {
 		fmt.Fprintln(w, service.ObjectMeta.Name, "\t", strings.Join(service.Spec.PlanList, ","))
 	}
@@ -26,7 +48,7 @@ func DisplayServices(services catalog.ServiceTypeList) {
 // DisplayComponents displays the specified  components
 func DisplayComponents(components []string) {
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME")
+	genericclioptions.PrintTableHeader(w, "NAME")
 	for _, component := range components {
 		fmt.Fprintln(w, component)
 	}
@@ -68,7 +90,7 @@ func FilterHiddenComponents(input []catalog.ComponentType) []catalog.ComponentTy
 func DisplayClusterServiceVersions(csvs *olm.ClusterServiceVersionList) {
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 	log.Info("Services available through Operators")
-	fmt.Fprintln(w, "NAME", "\t", "CRDs")
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "CRDs")
 	for _, csv := range csvs.Items {
 		fmt.Fprintln(w, csv.ObjectMeta.Name, "\t", CsvOperators(csv.Spec.CustomResourceDefinitions))
 	}
@@ -0,0 +1,195 @@
+package cleanup
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/odo/pkg/cleanup"
+	"github.com/openshift/odo/pkg/lclient"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/cli/project"
+	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended cleanup command name
+const RecommendedCommandName = "cleanup"
+
+var (
+	cleanupLongDesc = ktemplates.LongDesc(`Delete the orphaned resources created by odo
+
+A resource is orphaned when it has been created by odo for a component which doesn't exist anymore, after a crash,
+a renamed component or a failed push. The PVCs, Ingresses, TLS secrets and ServiceBindings of the current project
+are orphaned when their owner Deployment doesn't exist, or when they have no owner and the component of their labels
+doesn't exist. The Docker volumes created by odo are orphaned when their component has no containers.
+
+The deletion can't be confirmed with a machine readable output, the --force or --dry-run flag is required with -o.`)
+
+	cleanupExample = ktemplates.Examples(`  # List the orphaned resources of the current project without deleting them
+  %[1]s --dry-run
+
+  # Delete the orphaned resources of the project myproject without prompting
+  %[1]s --project myproject --force
+
+  # Delete the orphaned Docker volumes
+  %[1]s --docker`)
+)
+
+// CleanupOptions encapsulates the options for the odo cleanup command
+type CleanupOptions struct {
+	dryRunFlag bool
+	forceFlag  bool
+	dockerFlag bool
+
+	client cleanup.Client
+
+	*genericclioptions.Context
+}
+
+// NewCleanupOptions creates a new CleanupOptions instance
+func NewCleanupOptions() *CleanupOptions {
+	return &CleanupOptions{}
+}
+
+// Complete completes CleanupOptions after they've been created
+func (o *CleanupOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if o.dockerFlag {
+		if cmd.Flags().Changed(genericclioptions.ProjectFlagName) {
+			return errors.New("the --project flag can't be used with the --docker flag")
+		}
+		dockerClient, err := lclient.New()
+		if err != nil {
+			return errors.Wrap(err, "unable to connect to the Docker daemon")
+		}
+		o.client = cleanup.NewDockerClient(dockerClient)
+		return nil
+	}
+
+	o.Context, err = genericclioptions.NewContext(cmd)
+	if err != nil {
+		return err
+	}
+	o.client = cleanup.NewKubernetesClient(o.Client)
+	return nil
+}
+
+// Validate validates the CleanupOptions based on completed values
+func (o *CleanupOptions) Validate() (err error) {
+	if o.dryRunFlag && o.forceFlag {
+		return errors.New("the --dry-run and --force flags can't be used together")
+	}
+	// the deletion can't be confirmed with a machine readable output, it has to be requested explicitly
+	if log.IsJSON() && !o.dryRunFlag && !o.forceFlag {
+		return errors.New("the --force or --dry-run flag is required with the -o flag")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo cleanup command
+func (o *CleanupOptions) Run(cmd *cobra.Command) (err error) {
+	orphans, err := o.client.ListOrphans()
+	if err != nil {
+		return err
+	}
+
+	if len(orphans) == 0 {
+		if log.IsJSON() {
+			machineoutput.OutputSuccess(cleanup.GetMachineReadableFormatForList(orphans))
+			return nil
+		}
+		log.Info("No orphaned resources found")
+		return nil
+	}
+
+	if !log.IsJSON() {
+		printOrphans(orphans)
+	}
+	if o.dryRunFlag {
+		if log.IsJSON() {
+			machineoutput.OutputSuccess(cleanup.GetMachineReadableFormatForList(orphans))
+			return nil
+		}
+		log.Italic("\nRun the command without --dry-run to delete them")
+		return nil
+	}
+
+	deleteMsg := fmt.Sprintf("Are you sure you want to delete these %d orphaned resources", len(orphans))
+	if !o.forceFlag && !ui.Proceed(deleteMsg) {
+		return errors.New("aborting deletion of the orphaned resources")
+	}
+
+	var deleted []cleanup.Orphan
+	var failures []string
+	for _, orphan := range orphans {
+		err = o.client.DeleteOrphan(orphan)
+		if err != nil {
+			failures = append(failures, fmt.Sprintf("%s %s: %v", orphan.Kind, orphan.Name, err))
+			continue
+		}
+		deleted = append(deleted, orphan)
+		if !log.IsJSON() {
+			log.Successf("Deleted %s %s", orphan.Kind, orphan.Name)
+		}
+	}
+	if len(failures) > 0 {
+		return fmt.Errorf("unable to delete the orphaned resources:\n%s", strings.Join(failures, "\n"))
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(cleanup.GetMachineReadableFormatForList(deleted))
+	}
+	return nil
+}
+
+// printOrphans prints the orphaned resources with their age, size and the reason why they are orphaned
+func printOrphans(orphans []cleanup.Orphan) {
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "KIND", "\t", "NAME", "\t", "COMPONENT", "\t", "APPLICATION", "\t", "SIZE", "\t", "AGE", "\t", "REASON")
+	for _, orphan := range orphans {
+		age := "<unknown>"
+		if !orphan.CreationTimestamp.IsZero() {
+			age = duration.HumanDuration(time.Since(orphan.CreationTimestamp))
+		}
+		fmt.Fprintln(w, orphan.Kind, "\t", orphan.Name, "\t", valueOrNone(orphan.Component), "\t", valueOrNone(orphan.Application), "\t", valueOrNone(orphan.Size), "\t", age, "\t", orphan.Reason)
+	}
+	w.Flush()
+}
+
+// valueOrNone returns the value, or <none> if it is empty
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// NewCmdCleanup implements the odo cleanup command
+func NewCmdCleanup(name, fullName string) *cobra.Command {
+	o := NewCleanupOptions()
+	cleanupCmd := &cobra.Command{
+		Use:         name,
+		Short:       "Delete the orphaned resources created by odo",
+		Long:        cleanupLongDesc,
+		Example:     fmt.Sprintf(cleanupExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json", "command": "utility"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	cleanupCmd.Flags().BoolVar(&o.dryRunFlag, "dry-run", false, "List the orphaned resources without deleting them")
+	cleanupCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete the orphaned resources without prompting")
+	cleanupCmd.Flags().BoolVar(&o.dockerFlag, "docker", false, "Delete the orphaned volumes of the Docker daemon instead of the resources of the cluster")
+	project.AddProjectFlag(cleanupCmd)
+
+	return cleanupCmd
+}
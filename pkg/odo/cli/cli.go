@@ -8,11 +8,14 @@ import (
 
 	"github.com/openshift/odo/pkg/odo/cli/telemetry"
 
+	"github.com/openshift/odo/pkg/odo/cli/analyze"
 	"github.com/openshift/odo/pkg/odo/cli/application"
 	"github.com/openshift/odo/pkg/odo/cli/catalog"
+	"github.com/openshift/odo/pkg/odo/cli/cleanup"
 	"github.com/openshift/odo/pkg/odo/cli/component"
 	"github.com/openshift/odo/pkg/odo/cli/config"
 	"github.com/openshift/odo/pkg/odo/cli/debug"
+	"github.com/openshift/odo/pkg/odo/cli/devfile"
 	"github.com/openshift/odo/pkg/odo/cli/env"
 	"github.com/openshift/odo/pkg/odo/cli/login"
 	"github.com/openshift/odo/pkg/odo/cli/logout"
@@ -25,6 +28,7 @@ import (
 	"github.com/openshift/odo/pkg/odo/cli/url"
 	"github.com/openshift/odo/pkg/odo/cli/utils"
 	"github.com/openshift/odo/pkg/odo/cli/version"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	"github.com/openshift/odo/pkg/odo/util"
 
 	"github.com/spf13/cobra"
@@ -148,7 +152,8 @@ func odoRootCmd(name, fullName string) *cobra.Command {
 	// We use "flag" in order to make this accessible throughtout ALL of odo, rather than the
 	// above traditional "persistentflags" usage that does not make it a pointer within the 'pflag'
 	// package
-	flag.CommandLine.String("o", "", "Specify output format, supported format: json")
+	flag.CommandLine.String("o", "", "Specify output format, supported formats: "+strings.Join(genericclioptions.SupportedOutputFormats, ", "))
+	flag.CommandLine.Bool(genericclioptions.NoHeadersFlagName, false, "Don't print the headers of the tables of the default output")
 
 	// Here we add the necessary "logging" flags.. However, we choose to hide some of these from the user
 	// as they are not necessarily needed and more for advanced debugging
@@ -168,6 +173,7 @@ func odoRootCmd(name, fullName string) *cobra.Command {
 	// We will mark the command as hidden and then re-enable if the command
 	// supports json output
 	_ = pflag.CommandLine.MarkHidden("o")
+	_ = pflag.CommandLine.MarkHidden(genericclioptions.NoHeadersFlagName)
 
 	// Override the verbosity flag description
 	verbosity := pflag.Lookup("v")
@@ -177,8 +183,10 @@ func odoRootCmd(name, fullName string) *cobra.Command {
 	cobra.AddTemplateFunc("CapitalizeFlagDescriptions", util.CapitalizeFlagDescriptions)
 	cobra.AddTemplateFunc("ModifyAdditionalFlags", util.ModifyAdditionalFlags)
 
-	rootCmdList := append([]*cobra.Command{}, application.NewCmdApplication(application.RecommendedCommandName, util.GetFullName(fullName, application.RecommendedCommandName)),
+	rootCmdList := append([]*cobra.Command{}, analyze.NewCmdAnalyze(analyze.RecommendedCommandName, util.GetFullName(fullName, analyze.RecommendedCommandName)),
+		application.NewCmdApplication(application.RecommendedCommandName, util.GetFullName(fullName, application.RecommendedCommandName)),
 		catalog.NewCmdCatalog(catalog.RecommendedCommandName, util.GetFullName(fullName, catalog.RecommendedCommandName)),
+		cleanup.NewCmdCleanup(cleanup.RecommendedCommandName, util.GetFullName(fullName, cleanup.RecommendedCommandName)),
 		component.NewCmdComponent(component.RecommendedCommandName, util.GetFullName(fullName, component.RecommendedCommandName)),
 		component.NewCmdCreate(component.CreateRecommendedCommandName, util.GetFullName(fullName, component.CreateRecommendedCommandName)),
 		component.NewCmdDelete(component.DeleteRecommendedCommandName, util.GetFullName(fullName, component.DeleteRecommendedCommandName)),
@@ -203,6 +211,7 @@ func odoRootCmd(name, fullName string) *cobra.Command {
 		config.NewCmdConfiguration(config.RecommendedCommandName, util.GetFullName(fullName, config.RecommendedCommandName)),
 		preference.NewCmdPreference(preference.RecommendedCommandName, util.GetFullName(fullName, preference.RecommendedCommandName)),
 		debug.NewCmdDebug(debug.RecommendedCommandName, util.GetFullName(fullName, debug.RecommendedCommandName)),
+		devfile.NewCmdDevfile(devfile.RecommendedCommandName, util.GetFullName(fullName, devfile.RecommendedCommandName)),
 		registry.NewCmdRegistry(registry.RecommendedCommandName, util.GetFullName(fullName, registry.RecommendedCommandName)),
 		component.NewCmdTest(component.TestRecommendedCommandName, util.GetFullName(fullName, component.TestRecommendedCommandName)),
 		env.NewCmdEnv(env.RecommendedCommandName, util.GetFullName(fullName, env.RecommendedCommandName)),
@@ -3,6 +3,9 @@ package component
 import (
 	"encoding/json"
 	"fmt"
+	"path"
+	"sort"
+	"strconv"
 	"strings"
 
 	"github.com/openshift/odo/pkg/component"
@@ -46,12 +49,25 @@ type commonLinkOptions struct {
 	*genericclioptions.Context
 	// choose between Operator Hub and Service Catalog. If true, Operator Hub
 	csvSupport bool
+	// componentTarget is true when a devfile component is linked to another component, the link is then applied by odo itself
+	componentTarget bool
+	// linkVariables are the names of the variables holding the information of the linked component
+	linkVariables []string
+	// migrate replaces an existing link to the component created with the Service Binding Operator by a link applied by odo
+	migrate bool
+	// migratedLink is the name of the link created with the Service Binding Operator replaced by the link
+	migratedLink string
 }
 
 func newCommonLinkOptions() *commonLinkOptions {
 	return &commonLinkOptions{}
 }
 
+// isDevfileLink returns true when the link is stored in the devfile
+func (o *commonLinkOptions) isDevfileLink() bool {
+	return o.Context.EnvSpecificInfo != nil && (o.csvSupport || o.componentTarget)
+}
+
 func (o *commonLinkOptions) getLinkType() string {
 	linkType := "component"
 	if o.isTargetAService {
@@ -94,7 +110,15 @@ func (o *commonLinkOptions) complete(name string, cmd *cobra.Command, args []str
 		return err
 	}
 
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.Context.EnvSpecificInfo != nil {
+		// the links between devfile components don't require the Operators
+		o.componentTarget, err = o.isComponentTarget()
+		if err != nil {
+			return err
+		}
+	}
+
+	if o.isDevfileLink() {
 		return o.completeForOperator()
 	}
 
@@ -134,7 +158,7 @@ func (o *commonLinkOptions) complete(name string, cmd *cobra.Command, args []str
 }
 
 func (o *commonLinkOptions) validate(wait bool) (err error) {
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		return o.validateForOperator()
 	}
 
@@ -167,7 +191,7 @@ func (o *commonLinkOptions) validate(wait bool) (err error) {
 }
 
 func (o *commonLinkOptions) run() (err error) {
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		if o.operationName == unlink {
 			return o.unlinkOperator()
 		}
@@ -242,7 +266,7 @@ $%s is now available as a variable within component %s`, exampleEnv, component)
 
 func (o *commonLinkOptions) waitForLinkToComplete() (err error) {
 	var component string
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		component = o.EnvSpecificInfo.GetName()
 	} else {
 		component = o.Component()
@@ -279,8 +303,12 @@ func (o *commonLinkOptions) getServiceBindingName(componentName string) string {
 	return strings.Join([]string{componentName, strings.ToLower(o.serviceType), o.serviceName}, "-")
 }
 
-// completeForOperator completes the options when svc is supported
+// completeForOperator completes the options when the link is stored in the devfile
 func (o *commonLinkOptions) completeForOperator() (err error) {
+	if o.componentTarget {
+		return o.completeForComponent()
+	}
+
 	serviceBindingSupport, err := o.Client.GetKubeClient().IsServiceBindingSupported()
 	if err != nil {
 		return err
@@ -339,8 +367,173 @@ func (o *commonLinkOptions) completeForOperator() (err error) {
 	return nil
 }
 
-// validateForOperator validates the options when svc is supported
+// isComponentTarget returns true when the link is a link applied by odo between the devfile component and another
+// devfile component of the same application. The target must not be a Service Catalog service, and the links created
+// with the Service Binding Operator are left to the operator unless they are migrated
+func (o *commonLinkOptions) isComponentTarget() (bool, error) {
+	if _, _, err := svc.IsOperatorServiceNameValid(o.suppliedName); err == nil {
+		return false, nil
+	}
+
+	serviceName, err := util.NamespaceKubernetesObjectWithTrim(o.suppliedName, o.EnvSpecificInfo.GetApplication())
+	if err != nil {
+		return false, err
+	}
+	linkName, found, err := svc.FindDevfileServiceBinding(o.EnvSpecificInfo.GetDevfileObj(), "Service", serviceName)
+	if err != nil {
+		return false, err
+	}
+	if found {
+		managed, err := svc.IsDevfileComponentLink(o.EnvSpecificInfo.GetDevfileObj(), linkName)
+		if err != nil {
+			return false, err
+		}
+		return managed || (o.migrate && o.operationName != unlink), nil
+	}
+	if o.operationName == unlink {
+		return false, nil
+	}
+
+	svcExists, err := svc.SvcExists(o.Client, o.suppliedName, o.EnvSpecificInfo.GetApplication())
+	if err != nil {
+		klog.V(4).Infof("Unable to determine if %s is a service: %v", o.suppliedName, err)
+	} else if svcExists {
+		return false, nil
+	}
+
+	// the devfile components are deployed as Deployments, the s2i components as DeploymentConfigs
+	_, err = o.Client.GetKubeClient().GetOneDeployment(o.suppliedName, o.EnvSpecificInfo.GetApplication())
+	if err != nil {
+		if _, ok := err.(*kclient.DeploymentNotFoundError); ok {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
+
+// completeForComponent completes the options when a devfile component is linked to another component.
+// The link doesn't require the Service Binding Operator, odo injects the information of the target component itself
+func (o *commonLinkOptions) completeForComponent() (err error) {
+	o.isTargetAService = false
+	o.serviceType = "Service"
+	// the Service of the target component is named after the component and its application
+	o.serviceName, err = util.NamespaceKubernetesObjectWithTrim(o.suppliedName, o.EnvSpecificInfo.GetApplication())
+	if err != nil {
+		return err
+	}
+
+	if o.operationName == unlink {
+		// rest of the code is specific to link operation
+		return nil
+	}
+
+	o.serviceBinding = &servicebinding.ServiceBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: strings.Join([]string{kclient.ServiceBindingGroup, kclient.ServiceBindingVersion}, "/"),
+			Kind:       kclient.ServiceBindingKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: o.getServiceBindingName(o.EnvSpecificInfo.GetName()),
+		},
+		Spec: servicebinding.ServiceBindingSpec{
+			BindAsFiles: o.bindAsFiles,
+		},
+	}
+	o.serviceBinding.Annotations = map[string]string{svc.ComponentLinkAnnotation: "true"}
+	if o.port != "" {
+		o.serviceBinding.Annotations[svc.ComponentLinkPortAnnotation] = o.port
+	}
+	return nil
+}
+
+// validateForComponent validates the options when a devfile component is linked to another component
+func (o *commonLinkOptions) validateForComponent() (err error) {
+	if o.suppliedName == o.EnvSpecificInfo.GetName() {
+		if o.operationName == unlink {
+			return fmt.Errorf("the component %q cannot be unlinked from itself", o.suppliedName)
+		}
+		return fmt.Errorf("the component %q cannot be linked with itself", o.suppliedName)
+	}
+
+	linkName, found, err := svc.FindDevfileServiceBinding(o.EnvSpecificInfo.GetDevfileObj(), o.serviceType, o.serviceName)
+	if err != nil {
+		return err
+	}
+	if o.operationName == unlink {
+		if !found {
+			return fmt.Errorf("failed to unlink the component %q since no link was found in the configuration referring this component", o.suppliedName)
+		}
+		return nil
+	}
+	if found {
+		managed, err := svc.IsDevfileComponentLink(o.EnvSpecificInfo.GetDevfileObj(), linkName)
+		if err != nil {
+			return err
+		}
+		if managed || !o.migrate {
+			return fmt.Errorf("component %q is already linked with the component %q", o.EnvSpecificInfo.GetName(), o.suppliedName)
+		}
+		// the link created with the Service Binding Operator is replaced by the link applied by odo
+		o.migratedLink = linkName
+	}
+
+	// TODO find the service using an app name to link components in other apps
+	// requires modification of the app flag or finding some other way
+	service, err := o.KClient.GetOneService(o.suppliedName, o.EnvSpecificInfo.GetApplication())
+	if kerrors.IsNotFound(err) {
+		return fmt.Errorf("couldn't find component named %q. Refer %q to see list of running components", o.suppliedName, "odo list")
+	}
+	if err != nil {
+		return err
+	}
+	o.serviceName = service.Name
+
+	var port int32
+	if o.port != "" {
+		p, err := strconv.ParseInt(o.port, 10, 32)
+		if err != nil {
+			return fmt.Errorf("invalid port %q", o.port)
+		}
+		port = int32(p)
+	} else if len(service.Spec.Ports) > 1 {
+		var ports []string
+		for _, p := range service.Spec.Ports {
+			ports = append(ports, strconv.Itoa(int(p.Port)))
+		}
+		return fmt.Errorf("the component %q exposes several ports (%s), select the port to link to with --port", o.suppliedName, strings.Join(ports, ", "))
+	}
+
+	// the variables are injected on push, the values are checked now to report the errors early
+	data, err := svc.GetComponentLinkData(*service, port)
+	if err != nil {
+		return err
+	}
+	for name := range data {
+		o.linkVariables = append(o.linkVariables, name)
+	}
+	sort.Strings(o.linkVariables)
+
+	o.serviceBinding.Spec.Services = []servicebinding.Service{
+		{
+			NamespacedRef: servicebinding.NamespacedRef{
+				Ref: servicebinding.Ref{
+					Version: "v1",
+					Kind:    "Service",
+					Name:    o.serviceName,
+				},
+			},
+		},
+	}
+	return nil
+}
+
+// validateForOperator validates the options when the link is stored in the devfile
 func (o *commonLinkOptions) validateForOperator() (err error) {
+	if o.componentTarget {
+		return o.validateForComponent()
+	}
+
 	var svcFullName string
 
 	if o.isTargetAService {
@@ -452,6 +645,14 @@ func (o *commonLinkOptions) linkOperator() (err error) {
 		return err
 	}
 
+	if o.migratedLink != "" {
+		err = svc.DeleteKubernetesComponentFromDevfile(o.migratedLink, o.EnvSpecificInfo.GetDevfileObj())
+		if err != nil {
+			return err
+		}
+		log.Successf("Removed the link %q created with the Service Binding Operator", o.migratedLink)
+	}
+
 	_, found, err := svc.FindDevfileServiceBinding(o.EnvSpecificInfo.GetDevfileObj(), o.serviceType, o.serviceName)
 	if err != nil {
 		return err
@@ -465,6 +666,16 @@ func (o *commonLinkOptions) linkOperator() (err error) {
 	}
 
 	log.Successf("Successfully created link between component %q and %s %q\n", o.Context.EnvSpecificInfo.GetName(), o.getLinkType(), o.suppliedName)
+	if len(o.linkVariables) > 0 {
+		if o.bindAsFiles {
+			log.Infof("The below files will be mounted in the directory %q of the '%s' component:\n", path.Join("/bindings", o.serviceBinding.Name), o.Context.EnvSpecificInfo.GetName())
+		} else {
+			log.Infof("The below environment variables will be added to the '%s' component:\n", o.Context.EnvSpecificInfo.GetName())
+		}
+		for _, name := range o.linkVariables {
+			fmt.Printf("· %v\n", name)
+		}
+	}
 	log.Italic("To apply the link, please use `odo push`")
 	return err
 }
@@ -0,0 +1,111 @@
+package component
+
+import (
+	"fmt"
+	"os"
+	"os/signal"
+	"syscall"
+	"text/tabwriter"
+	"time"
+
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/openshift/odo/pkg/debug"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/url"
+	"github.com/pkg/errors"
+	k8sgenclioptions "k8s.io/cli-runtime/pkg/genericclioptions"
+	"k8s.io/klog"
+)
+
+// endpointForwarder forwards the endpoints of a devfile component to localhost
+type endpointForwarder struct {
+	endpoints []debug.ForwardedEndpoint
+	forwarder *debug.DefaultPortForwarder
+}
+
+// newEndpointForwarder selects the local ports for the forwardable endpoints of the devfile
+func newEndpointForwarder(context *genericclioptions.Context, componentName string, devObj parser.DevfileObj) (*endpointForwarder, error) {
+	endpoints, err := debug.GetForwardableEndpoints(devObj)
+	if err != nil {
+		return nil, err
+	}
+	if len(endpoints) == 0 {
+		return nil, fmt.Errorf("no endpoints with public or internal exposure found in the devfile of component %q", componentName)
+	}
+
+	endpoints, err = debug.AssignLocalPorts(endpoints, debug.DefaultEndpointLocalPortStart)
+	if err != nil {
+		return nil, err
+	}
+
+	// Using Discard streams because nothing important is logged
+	forwarder := debug.NewDefaultPortForwarder(componentName, context.Application, context.KClient.Namespace, context.Client, context.KClient, k8sgenclioptions.NewTestIOStreamsDiscard())
+	return &endpointForwarder{
+		endpoints: endpoints,
+		forwarder: forwarder,
+	}, nil
+}
+
+// printEndpoints prints the table of the local URLs of the forwarded endpoints
+func (ef *endpointForwarder) printEndpoints() {
+	if log.IsJSON() {
+		return
+	}
+	log.Info("\nForwarding the following endpoints to localhost")
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "CONTAINER", "\t", "EXPOSURE", "\t", "CONTAINER PORT", "\t", "LOCAL URL")
+	for _, endpoint := range ef.endpoints {
+		fmt.Fprintln(w, endpoint.Name, "\t", endpoint.ContainerName, "\t", endpoint.Exposure, "\t", endpoint.ContainerPort, "\t", endpoint.LocalURL())
+	}
+	w.Flush()
+}
+
+// forward forwards the endpoints until stopChan is closed, reconnecting to the component's pod
+// whenever the connection is lost e.g. because the pod was recreated by a push
+func (ef *endpointForwarder) forward(stopChan chan struct{}) {
+	url.StartURLHttpRequestStatusWatchForPortForward(ef.endpoints, machineoutput.NewMachineEventLoggingClient())
+
+	for {
+		readyChan := make(chan struct{})
+		err := ef.forwarder.ForwardEndpoints(ef.endpoints, stopChan, readyChan)
+		if err != nil {
+			klog.V(4).Infof("port forwarding stopped: %v", err)
+		}
+
+		select {
+		case <-stopChan:
+			return
+		case <-time.After(url.URLFailureWaitTime):
+		}
+	}
+}
+
+// forwardEndpointsUntilInterrupted forwards the endpoints of the devfile component to localhost
+// and blocks until the user interrupts odo
+func forwardEndpointsUntilInterrupted(context *genericclioptions.Context, componentName string, devObj parser.DevfileObj) error {
+	ef, err := newEndpointForwarder(context, componentName, devObj)
+	if err != nil {
+		return errors.Wrap(err, "unable to forward the component endpoints")
+	}
+	ef.printEndpoints()
+
+	signals := make(chan os.Signal, 1)
+	signal.Notify(signals, os.Interrupt,
+		syscall.SIGHUP,
+		syscall.SIGINT,
+		syscall.SIGTERM,
+		syscall.SIGQUIT)
+	defer signal.Stop(signals)
+
+	stopChan := make(chan struct{})
+	go func() {
+		<-signals
+		close(stopChan)
+	}()
+
+	log.Info("Press Ctrl+c to stop port forwarding")
+	ef.forward(stopChan)
+	return nil
+}
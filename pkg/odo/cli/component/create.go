@@ -11,7 +11,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/zalando/go-keyring"
 
-	registryLibrary "github.com/devfile/registry-support/registry-library/library"
+	"github.com/openshift/odo/pkg/analyze"
 	"github.com/openshift/odo/pkg/catalog"
 	"github.com/openshift/odo/pkg/component"
 	"github.com/openshift/odo/pkg/config"
@@ -28,10 +28,10 @@ import (
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	odoutil "github.com/openshift/odo/pkg/odo/util"
 	"github.com/openshift/odo/pkg/odo/util/completion"
-	"github.com/openshift/odo/pkg/preference"
 	scontext "github.com/openshift/odo/pkg/segment/context"
 	"github.com/openshift/odo/pkg/util"
 
+	"k8s.io/klog"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 )
 
@@ -72,6 +72,10 @@ type DevfileMetadata struct {
 	starter            string
 	token              string
 	starterToken       string
+	starterForce       bool
+	stackVersion       string
+	// devfileStackVersion is the version of the stack of the registry the component is created from
+	devfileStackVersion catalog.StackVersion
 }
 
 // CreateRecommendedCommandName is the recommended watch command name
@@ -108,6 +112,12 @@ odo catalog list components
 # Download an example devfile and application before deploying
 %[1]s nodejs --starter
 
+# Download the starter project into a directory containing files, overwriting the files of the starter project
+%[1]s nodejs --starter --force
+
+# Create a component from a specific version of the stack of the registry
+%[1]s nodejs --stack-version 1.0.1
+
 # Using a specific devfile
 %[1]s mynodejs --devfile ./devfile.yaml
 %[1]s mynodejs --devfile https://raw.githubusercontent.com/odo-devfiles/registry/master/devfiles/nodejs/devfile.yaml
@@ -231,6 +241,21 @@ func (co *CreateOptions) setComponentName(args []string) (err error) {
 	return
 }
 
+// suggestDevfileComponentTypes returns the devfile component types matching the project in the context directory,
+// the best match first
+func (co *CreateOptions) suggestDevfileComponentTypes(components []catalog.DevfileComponentType) []analyze.StackMatch {
+	contextDir := co.componentContext
+	if contextDir == "" {
+		contextDir = "."
+	}
+	analysis, err := analyze.Analyze(contextDir)
+	if err != nil {
+		klog.V(4).Infof("Unable to analyze the project in %s: %v", contextDir, err)
+		return nil
+	}
+	return analyze.MatchStacks(analysis, components)
+}
+
 func getSourceLocation(componentContext string, currentDirectory string) (string, error) {
 
 	// After getting the path relative to the current directory, we set the SourceLocation
@@ -329,6 +354,10 @@ func (co *CreateOptions) checkConflictingDevfileFlags() error {
 			flagName = "token"
 		} else if len(co.devfileMetadata.starter) != 0 {
 			flagName = "starter"
+		} else if len(co.devfileMetadata.stackVersion) != 0 {
+			flagName = "stack-version"
+		} else if co.devfileMetadata.starterForce {
+			flagName = "force"
 		}
 
 		if len(flagName) != 0 {
@@ -460,8 +489,9 @@ func (co *CreateOptions) Complete(name string, cmd *cobra.Command, args []string
 			}
 
 			if isDevfileRegistryPresent {
-				// Component type: We provide devfile component list to let user choose
-				componentType = ui.SelectDevfileComponentType(catalogDevfileList.Items)
+				// Component type: We suggest the devfile component types matching the project in the context directory,
+				// and provide devfile component list to let user choose
+				componentType = ui.SelectDevfileComponentTypeWithSuggestion(catalogDevfileList.Items, co.suggestDevfileComponentTypes(catalogDevfileList.Items))
 
 				// Component name: User needs to specify the component name, by default it is component type that user chooses
 				componentName = ui.EnterDevfileComponentName(componentType)
@@ -723,6 +753,19 @@ func (co *CreateOptions) Validate() (err error) {
 
 		spinner.End(true)
 
+		if co.devfileMetadata.devfilePath.value != "" || util.CheckPathExists(co.DevfilePath) {
+			if co.devfileMetadata.stackVersion != "" {
+				return errors.New("the --stack-version flag can only be used when creating a component from a devfile registry")
+			}
+			return nil
+		}
+
+		// Validate the version of the stack, its default version is used if no version is specified
+		co.devfileMetadata.devfileStackVersion, err = catalog.GetStackVersion(co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType, co.devfileMetadata.stackVersion)
+		if err != nil {
+			return err
+		}
+
 		return nil
 	}
 
@@ -801,40 +844,10 @@ func (co *CreateOptions) devfileRun(cmd *cobra.Command) (err error) {
 				return errors.Wrapf(err, "failed to read devfile from %s", DevfilePath)
 			}
 		} else {
-			// Download devfile from registry
-			var params util.HTTPRequestParams
-
-			if strings.Contains(co.devfileMetadata.devfileRegistry.URL, "github") {
-				// Github-based registry
-				params = util.HTTPRequestParams{
-					URL: co.devfileMetadata.devfileRegistry.URL + co.devfileMetadata.devfileLink,
-				}
-				if registryUtil.IsSecure(co.devfileMetadata.devfileRegistry.Name) {
-					token, err := keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, co.devfileMetadata.devfileRegistry.Name), registryUtil.RegistryUser)
-					if err != nil {
-						return errors.Wrap(err, "unable to get secure registry credential from keyring")
-					}
-					params.Token = token
-				}
-			} else {
-				err = registryLibrary.PullStackFromRegistry(co.devfileMetadata.devfileRegistry.URL, co.devfileMetadata.componentType, co.componentContext)
-				if err != nil {
-					return err
-				}
-			}
-
-			cfg, err := preference.New()
+			// Read the devfile of the version of the stack from the registry
+			devfileData, err = catalog.GetStackVersionDevfile(co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType, co.devfileMetadata.devfileStackVersion)
 			if err != nil {
-				return err
-			}
-
-			if strings.Contains(co.devfileMetadata.devfileRegistry.URL, "github") {
-				devfileData, err = util.DownloadFileInMemoryWithCache(params, cfg.GetRegistryCacheTime())
-			} else {
-				devfileData, err = ioutil.ReadFile(DevfilePath)
-			}
-			if err != nil {
-				return errors.Wrapf(err, "failed to download devfile for devfile component from %s", co.devfileMetadata.devfileRegistry.URL+co.devfileMetadata.devfileLink)
+				return errors.Wrap(err, "failed to download devfile for devfile component")
 			}
 		}
 	}
@@ -864,19 +877,38 @@ func (co *CreateOptions) devfileRun(cmd *cobra.Command) (err error) {
 		co.devfileMetadata.starterToken = token
 	}
 
-	err = decideAndDownloadStarterProject(devObj, co.devfileMetadata.starter, co.devfileMetadata.starterToken, co.interactive, co.componentContext)
+	fromRegistry := co.devfileMetadata.devfilePath.value == "" && !devfileExist
+
+	// the starter projects mirrored into a local registry are used in place of their original sources
+	var starterArchives map[string]string
+	if fromRegistry {
+		starterArchives, err = catalog.GetLocalStarterProjectArchives(co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType)
+		if err != nil {
+			return errors.Wrap(err, "unable to list the starter projects of the local registry")
+		}
+	}
+
+	err = decideAndDownloadStarterProject(devObj, co.devfileMetadata.starter, co.devfileMetadata.starterToken, co.devfileMetadata.starterForce, co.interactive, co.componentContext, starterArchives)
 	if err != nil {
 		return errors.Wrap(err, "failed to download project for devfile component")
 	}
 
 	// save devfile and corresponding resources if possible
+	if fromRegistry && !strings.Contains(co.devfileMetadata.devfileRegistry.URL, "github") {
+		err = catalog.PullStackVersion(co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType, co.devfileMetadata.devfileStackVersion, co.componentContext)
+		if err != nil {
+			return err
+		}
+	}
 	// use original devfileData to persist original formatting of the devfile file
 	err = ioutil.WriteFile(DevfilePath, devfileData, 0644) // #nosec G306
 	if err != nil {
 		return errors.Wrapf(err, "unable to save devfile to %s", DevfilePath)
 	}
-	if co.devfileMetadata.devfilePath.value == "" && !devfileExist && !strings.Contains(co.devfileMetadata.devfileRegistry.URL, "github") {
-		err = registryLibrary.PullStackFromRegistry(co.devfileMetadata.devfileRegistry.URL, co.devfileMetadata.componentType, co.componentContext)
+	if fromRegistry {
+		// record the version of the stack, so that the component can be created again and upgraded from the same stack
+		lock := catalog.NewStackLock(co.devfileMetadata.devfileRegistry, co.devfileMetadata.componentType, co.devfileMetadata.devfileStackVersion, devfileData)
+		err = catalog.WriteStackLock(co.componentContext, lock, devfileData)
 		if err != nil {
 			return err
 		}
@@ -1038,6 +1070,8 @@ func NewCmdCreate(name, fullName string) *cobra.Command {
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.devfilePath.value, "devfile", "", "Path to the user specified devfile")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.token, "token", "", "Token to be used when downloading devfile from the devfile path that is specified via --devfile")
 	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.starterToken, "starter-token", "", "Token to be used when downloading starter project")
+	componentCreateCmd.Flags().BoolVarP(&co.devfileMetadata.starterForce, "force", "f", false, "Overwrite the existing files of the context directory with the files of the starter project")
+	componentCreateCmd.Flags().StringVar(&co.devfileMetadata.stackVersion, "stack-version", "", "Version of the stack of the devfile registry to create the component from, the default version of the stack is used if not specified")
 	componentCreateCmd.Flags().BoolVar(&co.forceS2i, "s2i", false, "Enforce S2I type components")
 
 	componentCreateCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
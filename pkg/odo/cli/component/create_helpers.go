@@ -47,7 +47,7 @@ func (co *CreateOptions) SetComponentSettings(args []string) error {
 
 // decideAndDownloadStarterProject decides the starter project from the value passed by the user and
 // downloads it
-func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed string, token string, interactive bool, contextDir string) error {
+func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed string, token string, force bool, interactive bool, contextDir string, starterArchives map[string]string) error {
 	if projectPassed == "" && !interactive {
 		return nil
 	}
@@ -72,7 +72,14 @@ func decideAndDownloadStarterProject(devObj parser.DevfileObj, projectPassed str
 		return nil
 	}
 
-	return component.DownloadStarterProject(starterProject, token, contextDir)
+	if archive, ok := starterArchives[starterProject.Name]; ok {
+		// the archive of the starter project already contains the sub directory of the project only
+		starterProject.Git = nil
+		starterProject.SubDir = ""
+		starterProject.Zip = &devfilev1.ZipProjectSource{Location: archive}
+	}
+
+	return component.DownloadStarterProject(starterProject, token, contextDir, force)
 }
 
 // DevfileJSON creates the full json description of a devfile component is prints it
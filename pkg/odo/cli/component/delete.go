@@ -93,7 +93,7 @@ func (do *DeleteOptions) Complete(name string, cmd *cobra.Command, args []string
 
 	// if experimental mode is enabled and devfile is present
 	if !do.componentDeleteS2iFlag && util.CheckPathExists(do.devfilePath) {
-		do.EnvSpecificInfo, err = envinfo.NewEnvSpecificInfo(do.componentContext)
+		do.EnvSpecificInfo, err = envinfo.NewEnvSpecificInfoForEnvironment(do.componentContext, genericclioptions.FlagValueIfSet(cmd, genericclioptions.EnvFlagName))
 		if err != nil {
 			return err
 		}
@@ -310,6 +310,8 @@ func NewCmdDelete(name, fullName string) *cobra.Command {
 	completion.RegisterCommandHandler(componentDeleteCmd, completion.ComponentNameCompletionHandler)
 	//Adding `--context` flag
 	genericclioptions.AddContextFlag(componentDeleteCmd, &do.componentContext)
+	//Adding `--env` flag
+	genericclioptions.AddEnvFlag(componentDeleteCmd, nil)
 
 	//Adding `--project` flag
 	projectCmd.AddProjectFlag(componentDeleteCmd)
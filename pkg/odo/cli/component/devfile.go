@@ -65,7 +65,7 @@ func (po *PushOptions) DevfilePush() error {
 }
 
 func (po *PushOptions) devfilePushInner() (err error) {
-	devObj, err := devfile.ParseFromFile(po.DevfilePath)
+	devObj, err := devfile.ParseFromFileWithVariables(po.DevfilePath, po.EnvSpecificInfo.GetVariables())
 	if err != nil {
 		return err
 	}
@@ -40,11 +40,14 @@ var (
 # Link current component to port 8080 of the 'backend' component (backend must have port 8080 exposed) 
 %[1]s backend --port 8080
 
+# Replace the link of the current component to the 'backend' component created with the Service Binding Operator by a link applied by odo
+%[1]s backend --migrate
+
 # Link the current component to the 'EtcdCluster' named 'myetcd'
 # and make the secrets accessible as files in the '/bindings/etcd/' directory
 %[1]s EtcdCluster/myetcd  --bind-as-files --name etcd`)
 
-	linkLongDesc = `Link component to a service (backed by an Operator or Service Catalog) or component (works only with s2i components)
+	linkLongDesc = `Link component to a service (backed by an Operator or Service Catalog) or component
 
 If the source component is not provided, the current active component is assumed.
 In both use cases, link adds the appropriate secret to the environment of the source component. 
@@ -66,13 +69,23 @@ Here myetcdcluster is the name of the EtcdCluster service which can be found usi
 We've also created a backend application called 'backend' with port 8080 exposed:
 odo create nodejs backend --port 8080
 
-We can now link the two applications (works only with s2i components):
+We can now link the two applications:
 odo link backend --component frontend
 
 Now the frontend has 2 ENV variables it can use:
 COMPONENT_BACKEND_HOST=backend-app
 COMPONENT_BACKEND_PORT=8080
 
+For devfile components, the link is stored in the devfile and applied on 'odo push', without requiring the Service Binding Operator.
+The frontend also gets the URL of the backend, and the port and URL of each endpoint of the backend:
+COMPONENT_BACKEND_URL=http://backend-app:8080
+COMPONENT_BACKEND_HTTP_8080_PORT=8080
+COMPONENT_BACKEND_HTTP_8080_URL=http://backend-app:8080
+
+The values are updated when the ports of the backend change, and removed with 'odo unlink'.
+The links between devfile components created with the Service Binding Operator are kept as they are,
+they can be replaced by links applied by odo with the '--migrate' flag.
+
 If you wish to use a database, we can use the Service Catalog and link it to our backend:
 odo service create dh-postgresql-apb --plan dev -p postgresql_user=luke -p postgresql_password=secret
 odo link dh-postgresql-apb
@@ -108,7 +121,7 @@ func (o *LinkOptions) Complete(name string, cmd *cobra.Command, args []string) (
 		return err
 	}
 
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		o.operation = o.KClient.LinkSecret
 	} else {
 		o.operation = o.Client.LinkSecret
@@ -124,7 +137,7 @@ func (o *LinkOptions) Validate() (err error) {
 		return err
 	}
 
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		return
 	}
 
@@ -169,6 +182,7 @@ func NewCmdLink(name, fullName string) *cobra.Command {
 	linkCmd.PersistentFlags().BoolVarP(&o.wait, "wait", "w", false, "If enabled the link will return only when the component is fully running after the link is created")
 	linkCmd.PersistentFlags().BoolVar(&o.waitForTarget, "wait-for-target", false, "If enabled, the link command will wait for the service to be provisioned (has no effect when linking to a component)")
 	linkCmd.PersistentFlags().StringVar(&o.name, "name", "", "Name of the created ServiceBinding resource")
+	linkCmd.PersistentFlags().BoolVar(&o.migrate, "migrate", false, "If enabled, an existing link to the component created with the Service Binding Operator is replaced by a link applied by odo")
 	linkCmd.PersistentFlags().BoolVar(&o.bindAsFiles, "bind-as-files", false, "If enabled, configuration values will be mounted as files, instead of declared as environment variables")
 	linkCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 
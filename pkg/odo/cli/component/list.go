@@ -158,7 +158,7 @@ func (lo *ListOptions) Run(cmd *cobra.Command) (err error) {
 			if len(devfileComps) != 0 {
 				lo.hasDevfileComponents = true
 				fmt.Fprintln(w, "Devfile Components: ")
-				fmt.Fprintln(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "STATE", "\t", "CONTEXT")
+				genericclioptions.PrintTableHeader(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "STATE", "\t", "CONTEXT")
 				for _, comp := range devfileComps {
 					fmt.Fprintln(w, comp.Spec.App, "\t", comp.Name, "\t", comp.Namespace, "\t", comp.Status.State, "\t", comp.Status.Context)
 				}
@@ -170,7 +170,7 @@ func (lo *ListOptions) Run(cmd *cobra.Command) (err error) {
 			if len(s2iComps) != 0 {
 				lo.hasS2IComponents = true
 				fmt.Fprintln(w, "S2I Components: ")
-				fmt.Fprintln(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "SOURCETYPE", "\t", "STATE", "\t", "CONTEXT")
+				genericclioptions.PrintTableHeader(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "SOURCETYPE", "\t", "STATE", "\t", "CONTEXT")
 				for _, comp := range s2iComps {
 					fmt.Fprintln(w, comp.Spec.App, "\t", comp.Name, "\t", comp.Namespace, "\t", comp.Spec.Type, "\t", comp.Spec.SourceType, "\t", comp.Status.State, "\t", comp.Status.Context)
 
@@ -300,7 +300,7 @@ func (lo *ListOptions) Run(cmd *cobra.Command) (err error) {
 		if len(devfileComponents) != 0 {
 			lo.hasDevfileComponents = true
 			fmt.Fprintln(w, "Devfile Components: ")
-			fmt.Fprintln(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "STATE")
+			genericclioptions.PrintTableHeader(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "STATE")
 			for _, comp := range devfileComponents {
 				fmt.Fprintln(w, comp.Spec.App, "\t", comp.Name, "\t", comp.Namespace, "\t", comp.Spec.Type, "\t", comp.Status.State)
 			}
@@ -315,7 +315,7 @@ func (lo *ListOptions) Run(cmd *cobra.Command) (err error) {
 			lo.hasS2IComponents = true
 			w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 			fmt.Fprintln(w, "S2I Components: ")
-			fmt.Fprintln(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "SOURCETYPE", "\t", "STATE")
+			genericclioptions.PrintTableHeader(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE", "\t", "SOURCETYPE", "\t", "STATE")
 			for _, comp := range s2iComponents {
 				fmt.Fprintln(w, comp.Spec.App, "\t", comp.Name, "\t", comp.Namespace, "\t", comp.Spec.Type, "\t", comp.Spec.SourceType, "\t", comp.Status.State)
 			}
@@ -324,7 +324,7 @@ func (lo *ListOptions) Run(cmd *cobra.Command) (err error) {
 		if len(otherComps) != 0 {
 			w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 			fmt.Fprintln(w, "Other Components running on the cluster(read-only): ")
-			fmt.Fprintln(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE")
+			genericclioptions.PrintTableHeader(w, "APP", "\t", "NAME", "\t", "PROJECT", "\t", "TYPE")
 			for _, comp := range otherComps {
 				fmt.Fprintln(w, comp.Spec.App, "\t", comp.Name, "\t", comp.Namespace, "\t", comp.Spec.Type)
 			}
@@ -37,6 +37,9 @@ var pushCmdExample = (`  # Push source code to the current component
 
 # Push source code with custom devfile commands using --build-command and --run-command for experimental mode
 %[1]s --build-command="mybuild" --run-command="myrun"
+
+# Push source code and forward all the public and internal endpoints of the component to localhost
+%[1]s --port-forward
   `)
 
 var pushCmdExampleExperimentalOnly = (`
@@ -61,6 +64,9 @@ type PushOptions struct {
 	devfileRunCommand   string
 	devfileDebugCommand string
 	debugRun            bool
+
+	// portForward forwards the component endpoints to localhost after the push
+	portForward bool
 }
 
 // NewPushOptions returns new instance of PushOptions
@@ -101,7 +107,7 @@ func (po *PushOptions) Complete(name string, cmd *cobra.Command, args []string)
 		}
 
 		// We retrieve the configuration information. If this does not exist, then BLANK is returned (important!).
-		envFileInfo, err := envinfo.NewEnvSpecificInfo(po.componentContext)
+		envFileInfo, err := envinfo.NewEnvSpecificInfoForEnvironment(po.componentContext, genericclioptions.FlagValueIfSet(cmd, genericclioptions.EnvFlagName))
 		if err != nil {
 			return errors.Wrap(err, "unable to retrieve configuration information")
 		}
@@ -230,6 +236,10 @@ func (po *PushOptions) Validate() (err error) {
 		return nil
 	}
 
+	if po.portForward {
+		return fmt.Errorf("the --port-forward flag is only supported for devfile components")
+	}
+
 	// Validation for S2i components
 	log.Info("Validation")
 
@@ -267,7 +277,16 @@ func (po *PushOptions) Run(cmd *cobra.Command) (err error) {
 			scontext.SetComponentType(cmd.Context(), GetComponentTypeFromDevfile(po.Devfile.Data.GetMetadata()))
 		}
 		// Return Devfile push
-		return po.DevfilePush()
+		err = po.DevfilePush()
+		if err != nil || !po.portForward {
+			return err
+		}
+		// the endpoints are forwarded with the variables of the environment substituted, as they are pushed
+		devObj, err := devfile.ParseFromFileWithVariables(po.DevfilePath, po.EnvSpecificInfo.GetVariables())
+		if err != nil {
+			return err
+		}
+		return forwardEndpointsUntilInterrupted(po.Context, po.EnvSpecificInfo.GetName(), devObj)
 	}
 
 	// Legacy odo push
@@ -298,8 +317,11 @@ func NewCmdPush(name, fullName string) *cobra.Command {
 			genericclioptions.GenericRun(po, cmd, args)
 		},
 	}
+	// the events of the push are streamed in json
+	genericclioptions.StreamOnlyJSONOutput(pushCmd)
 
 	genericclioptions.AddContextFlag(pushCmd, &po.componentContext)
+	genericclioptions.AddEnvFlag(pushCmd, nil)
 	pushCmd.Flags().BoolVar(&po.show, "show-log", false, "If enabled, logs will be shown when built")
 	pushCmd.Flags().StringSliceVar(&po.ignores, "ignore", []string{}, "Files or folders to be ignored via glob expressions.")
 	pushCmd.Flags().BoolVar(&po.pushConfig, "config", false, "Use config flag to only apply config on to cluster")
@@ -311,6 +333,7 @@ func NewCmdPush(name, fullName string) *cobra.Command {
 	pushCmd.Flags().StringVar(&po.devfileRunCommand, "run-command", "", "Devfile Run Command to execute")
 	pushCmd.Flags().BoolVar(&po.debugRun, "debug", false, "Runs the component in debug mode")
 	pushCmd.Flags().StringVar(&po.devfileDebugCommand, "debug-command", "", "Devfile Debug Command to execute")
+	pushCmd.Flags().BoolVar(&po.portForward, "port-forward", false, "Forward all the public and internal endpoints of the component to localhost after the push, instead of using URLs")
 
 	//Adding `--project` flag
 	projectCmd.AddProjectFlag(pushCmd)
@@ -166,6 +166,8 @@ func NewCmdStatus(name, fullName string) *cobra.Command {
 			genericclioptions.GenericRun(o, cmd, args)
 		},
 	}
+	// the events of the status are streamed in json
+	genericclioptions.StreamOnlyJSONOutput(statusCmd)
 
 	statusCmd.SetUsageTemplate(odoutil.CmdUsageTemplate)
 
@@ -3,11 +3,13 @@ package ui
 import (
 	"fmt"
 	"sort"
+	"strings"
 
 	"gopkg.in/AlecAivazis/survey.v1"
 	"k8s.io/klog"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/openshift/odo/pkg/analyze"
 	"github.com/openshift/odo/pkg/catalog"
 	"github.com/openshift/odo/pkg/component"
 	"github.com/openshift/odo/pkg/config"
@@ -65,6 +67,49 @@ func SelectDevfileComponentType(options []catalog.DevfileComponentType) string {
 	return componentType
 }
 
+// SelectDevfileComponentTypeWithSuggestion proposes the devfile component type best matching the project, and lets
+// the user select another one in the prompt, the matching component types being listed first
+func SelectDevfileComponentTypeWithSuggestion(options []catalog.DevfileComponentType, suggestions []analyze.StackMatch) string {
+	if len(suggestions) == 0 {
+		return SelectDevfileComponentType(options)
+	}
+
+	suggestion := suggestions[0]
+	var useSuggestion bool
+	prompt := &survey.Confirm{
+		Message: fmt.Sprintf("The project matches the devfile component type %s of the registry %s (%s), do you want to use it", suggestion.Name, suggestion.Registry, strings.Join(suggestion.Reasons, ", ")),
+		Default: true,
+	}
+	err := survey.AskOne(prompt, &useSuggestion, nil)
+	ui.HandleError(err)
+	if useSuggestion {
+		return suggestion.Name
+	}
+
+	var candidates []string
+	suggested := map[string]bool{}
+	for _, s := range suggestions {
+		if !suggested[s.Name] {
+			suggested[s.Name] = true
+			candidates = append(candidates, s.Name)
+		}
+	}
+	for _, candidate := range getDevfileComponentTypeNameCandidates(options) {
+		if !suggested[candidate] {
+			candidates = append(candidates, candidate)
+		}
+	}
+
+	var componentType string
+	promptSelect := &survey.Select{
+		Message: "Which devfile component type do you wish to create",
+		Options: candidates,
+	}
+	err = survey.AskOne(promptSelect, &componentType, survey.Required)
+	ui.HandleError(err)
+	return componentType
+}
+
 // EnterDevfileComponentName lets the user to specify the component name in the prompt
 func EnterDevfileComponentName(defaultComponentName string) string {
 	var componentName string
@@ -65,7 +65,7 @@ func (o *UnlinkOptions) Complete(name string, cmd *cobra.Command, args []string)
 		return err
 	}
 
-	if o.csvSupport && o.Context.EnvSpecificInfo != nil {
+	if o.isDevfileLink() {
 		o.operation = o.KClient.UnlinkSecret
 	} else {
 		o.operation = o.Client.UnlinkSecret
@@ -37,6 +37,9 @@ var watchExampleWithDevfile = ktemplates.Examples(`  # Watch for changes in dire
 
 # Watch source code changes with custom devfile commands using --build-command, --run-command and --debug-command for devfile based components
 %[1]s --build-command="mybuild" --run-command="myrun" --debug-command="mydebug"
+
+# Watch for changes and forward all the public and internal endpoints of the component to localhost
+%[1]s --port-forward
   `)
 
 // WatchOptions contains attributes of the watch command
@@ -63,6 +66,9 @@ type WatchOptions struct {
 	devfileRunCommand   string
 	devfileDebugCommand string
 
+	// portForward forwards the component endpoints to localhost while watching
+	portForward bool
+
 	*genericclioptions.Context
 }
 
@@ -97,7 +103,7 @@ func (wo *WatchOptions) Complete(name string, cmd *cobra.Command, args []string)
 		wo.componentName = wo.EnvSpecificInfo.GetName()
 
 		// Parse devfile and validate
-		devObj, err := devfile.ParseFromFile(wo.devfilePath)
+		devObj, err := devfile.ParseFromFileWithVariables(wo.devfilePath, wo.EnvSpecificInfo.GetVariables())
 		if err != nil {
 			return err
 		}
@@ -155,6 +161,9 @@ func (wo *WatchOptions) Validate() (err error) {
 	}
 
 	// if experimental mode is enabled and devfile is present, return. The rest of the validation is for non-devfile components
+	if !util.CheckPathExists(wo.devfilePath) && wo.portForward {
+		return fmt.Errorf("the --port-forward flag is only supported for devfile components")
+	}
 	if util.CheckPathExists(wo.devfilePath) {
 		if wo.devfileDebugCommand != "" && wo.EnvSpecificInfo != nil && wo.EnvSpecificInfo.GetRunMode() != envinfo.Debug {
 			return fmt.Errorf("please start the component in debug mode using `odo push --debug` to use the --debug-command flag")
@@ -202,6 +211,22 @@ func (wo *WatchOptions) Run(cmd *cobra.Command) (err error) {
 	// if experimental mode is enabled and devfile is present
 	if util.CheckPathExists(wo.devfilePath) {
 
+		if wo.portForward {
+			devObj, err := devfile.ParseFromFileWithVariables(wo.devfilePath, wo.EnvSpecificInfo.GetVariables())
+			if err != nil {
+				return err
+			}
+			ef, err := newEndpointForwarder(wo.Context, wo.componentName, devObj)
+			if err != nil {
+				return errors.Wrap(err, "unable to forward the component endpoints")
+			}
+			ef.printEndpoints()
+
+			stopChan := make(chan struct{})
+			defer close(stopChan)
+			go ef.forward(stopChan)
+		}
+
 		err = watch.DevfileWatchAndPush(
 			os.Stdout,
 			watch.WatchParameters{
@@ -278,9 +303,11 @@ func NewCmdWatch(name, fullName string) *cobra.Command {
 	watchCmd.Flags().StringVar(&wo.devfileBuildCommand, "build-command", "", "Devfile Build Command to execute")
 	watchCmd.Flags().StringVar(&wo.devfileRunCommand, "run-command", "", "Devfile Run Command to execute")
 	watchCmd.Flags().StringVar(&wo.devfileDebugCommand, "debug-command", "", "Devfile Debug Command to execute")
+	watchCmd.Flags().BoolVar(&wo.portForward, "port-forward", false, "Forward all the public and internal endpoints of the component to localhost while watching, instead of using URLs")
 
 	// Adding context flag
 	genericclioptions.AddContextFlag(watchCmd, &wo.componentContext)
+	genericclioptions.AddEnvFlag(watchCmd, nil)
 
 	//Adding `--application` flag
 	appCmd.AddApplicationFlag(watchCmd)
@@ -314,7 +341,7 @@ func (wo *WatchOptions) regenerateAdapterAndPush(pushParams common.PushParameter
 func (wo *WatchOptions) regenerateComponentAdapterFromWatchParams(parameters watch.WatchParameters) (common.ComponentAdapter, error) {
 
 	// Parse devfile and validate
-	devObj, err := devfile.ParseFromFile(wo.devfilePath)
+	devObj, err := devfile.ParseFromFileWithVariables(wo.devfilePath, wo.EnvSpecificInfo.GetVariables())
 	if err != nil {
 		return nil, err
 	}
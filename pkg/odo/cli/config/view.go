@@ -108,7 +108,7 @@ func (o *ViewOptions) Run(cmd *cobra.Command) (err error) {
 	if len(envVarList) != 0 {
 		fmt.Fprintln(w, "ENVIRONMENT VARIABLES")
 		fmt.Fprintln(w, "------------------------------------------------")
-		fmt.Fprintln(w, "NAME", "\t", "VALUE")
+		genericclioptions.PrintTableHeader(w, "NAME", "\t", "VALUE")
 		for _, envVar := range envVarList {
 			fmt.Fprintln(w, envVar.Name, "\t", envVar.Value)
 		}
@@ -119,7 +119,7 @@ func (o *ViewOptions) Run(cmd *cobra.Command) (err error) {
 	fmt.Fprintln(w, "COMPONENT SETTINGS")
 	fmt.Fprintln(w, "------------------------------------------------")
 
-	fmt.Fprintln(w, "PARAMETER", "\t", "CURRENT_VALUE")
+	genericclioptions.PrintTableHeader(w, "PARAMETER", "\t", "CURRENT_VALUE")
 	fmt.Fprintln(w, "Type", "\t", showBlankIfNil(cs.Type))
 	fmt.Fprintln(w, "Application", "\t", showBlankIfNil(cs.Application))
 	fmt.Fprintln(w, "Project", "\t", showBlankIfNil(cs.Project))
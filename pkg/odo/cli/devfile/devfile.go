@@ -0,0 +1,34 @@
+package devfile
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/odo/util"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended devfile command name
+const RecommendedCommandName = "devfile"
+
+var devfileLongDesc = ktemplates.LongDesc(`Manage the devfile of a component`)
+
+// NewCmdDevfile implements the devfile command
+func NewCmdDevfile(name, fullName string) *cobra.Command {
+	devfileUpgradeCmd := NewCmdUpgrade(upgradeCommandName, util.GetFullName(fullName, upgradeCommandName))
+	devfileLintCmd := NewCmdLint(lintCommandName, util.GetFullName(fullName, lintCommandName))
+	devfileFlattenCmd := NewCmdFlatten(flattenCommandName, util.GetFullName(fullName, flattenCommandName))
+	devfileCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Manage the devfile of a component",
+		Long:    devfileLongDesc,
+		Example: fmt.Sprintf("%s\n%s\n%s", devfileUpgradeCmd.Example, devfileLintCmd.Example, devfileFlattenCmd.Example),
+	}
+
+	devfileCmd.AddCommand(devfileUpgradeCmd, devfileLintCmd, devfileFlattenCmd)
+	devfileCmd.SetUsageTemplate(util.CmdUsageTemplate)
+	devfileCmd.Annotations = map[string]string{"command": "main"}
+
+	return devfileCmd
+}
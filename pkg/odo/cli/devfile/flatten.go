@@ -0,0 +1,127 @@
+package devfile
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/openshift/odo/pkg/devfile/effective"
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
+
+	"github.com/devfile/library/pkg/devfile/parser/data"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const flattenCommandName = "flatten"
+
+var (
+	flattenLongDesc = ktemplates.LongDesc(`Print the effective devfile of the component
+
+The effective devfile is the devfile odo uses when pushing the component: the devfile of the component merged with
+its parent and plugins and their overrides, with the variables replaced and the changes made by odo, like the default
+values of the URLs of the env file, the variables overridden in the env file, the debug port and the env variables
+of the run and debug commands.
+The fields are annotated with comments indicating where they come from, the fields without comment come from the devfile of the component.`)
+
+	flattenExample = ktemplates.Examples(`
+	# Print the effective devfile of the component
+	%[1]s
+
+	# Print the effective devfile using a specific run command, without the annotations
+	%[1]s --run-command myrun --no-annotations
+	`)
+)
+
+// EffectiveDevfile represents the JSON output of odo devfile flatten -o json
+type EffectiveDevfile struct {
+	Devfile data.DevfileData  `json:"devfile"`
+	Origins map[string]string `json:"origins"`
+}
+
+// FlattenOptions encapsulates the options for the odo devfile flatten command
+type FlattenOptions struct {
+	contextFlag       string
+	runCommandFlag    string
+	debugCommandFlag  string
+	noAnnotationsFlag bool
+	envFlag           string
+
+	envInfo *envinfo.EnvSpecificInfo
+}
+
+// NewFlattenOptions creates a new FlattenOptions instance
+func NewFlattenOptions() *FlattenOptions {
+	return &FlattenOptions{}
+}
+
+// Complete completes FlattenOptions after they've been created
+func (o *FlattenOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.envInfo, err = envinfo.NewEnvSpecificInfoForEnvironment(o.contextFlag, o.envFlag)
+	return err
+}
+
+// Validate validates the FlattenOptions based on completed values
+func (o *FlattenOptions) Validate() (err error) {
+	if !util.CheckPathExists(filepath.Join(o.contextFlag, devfileName)) {
+		return errors.New("the context directory doesn't contain a devfile, please refer `odo create --help` on how to create a component")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile flatten command
+func (o *FlattenOptions) Run(cmd *cobra.Command) (err error) {
+	options := effective.Options{
+		RunCommand:   o.runCommandFlag,
+		DebugCommand: o.debugCommandFlag,
+		DebugPort:    o.envInfo.GetDebugPort(),
+		Variables:    o.envInfo.GetVariables(),
+	}
+	if urls := o.envInfo.GetComponentSettings().URL; urls != nil {
+		options.URLs = *urls
+	}
+
+	devfile, err := effective.Get(filepath.Join(o.contextFlag, devfileName), options)
+	if err != nil {
+		return err
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(EffectiveDevfile{Devfile: devfile.Obj.Data, Origins: devfile.Origins})
+		return nil
+	}
+
+	out, err := devfile.YAML(!o.noAnnotationsFlag)
+	if err != nil {
+		return err
+	}
+	fmt.Print(string(out))
+	return nil
+}
+
+// NewCmdFlatten implements the odo devfile flatten command
+func NewCmdFlatten(name, fullName string) *cobra.Command {
+	o := NewFlattenOptions()
+	flattenCmd := &cobra.Command{
+		Use:         name,
+		Short:       "Print the effective devfile of the component",
+		Long:        flattenLongDesc,
+		Example:     fmt.Sprintf(flattenExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	flattenCmd.Flags().StringVar(&o.runCommandFlag, "run-command", "", "Devfile run command to use, the default run command is used if not specified")
+	flattenCmd.Flags().StringVar(&o.debugCommandFlag, "debug-command", "", "Devfile debug command to use, the default debug command is used if not specified")
+	flattenCmd.Flags().BoolVar(&o.noAnnotationsFlag, "no-annotations", false, "Don't annotate the fields with where they come from")
+	genericclioptions.AddContextFlag(flattenCmd, &o.contextFlag)
+	genericclioptions.AddEnvFlag(flattenCmd, &o.envFlag)
+
+	return flattenCmd
+}
@@ -0,0 +1,242 @@
+package devfile
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+
+	"github.com/openshift/odo/pkg/devfile/validate"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	lintCommandName = "lint"
+
+	lintFormatText  = "text"
+	lintFormatSarif = "sarif"
+)
+
+var (
+	lintLongDesc = ktemplates.LongDesc(`Check the devfile of the component for problems
+
+Run the generic devfile validation and the odo specific validations on the devfile, and report all the problems found
+with their line and column in the devfile: schema errors, unsupported command types, composite commands of run kind,
+endpoint conflicts, containers without the sources, invalid volume sizes and deprecated fields.
+The command fails if errors are found, warnings don't make it fail.`)
+
+	lintExample = ktemplates.Examples(`
+	# Check the devfile of the component in the current directory
+	%[1]s
+
+	# Check a devfile and report the problems as SARIF, for code scanning tools
+	%[1]s ./devfile.yaml --format sarif > devfile.sarif
+
+	# Check the devfile of the component and report the problems as JSON
+	%[1]s -o json
+	`)
+)
+
+// DevfileLint represents the JSON output of odo devfile lint -o json
+type DevfileLint struct {
+	Devfile     string                `json:"devfile"`
+	Diagnostics []validate.Diagnostic `json:"diagnostics"`
+	Errors      int                   `json:"errors"`
+	Warnings    int                   `json:"warnings"`
+}
+
+// LintOptions encapsulates the options for the odo devfile lint command
+type LintOptions struct {
+	contextFlag string
+	formatFlag  string
+
+	devfilePath string
+}
+
+// NewLintOptions creates a new LintOptions instance
+func NewLintOptions() *LintOptions {
+	return &LintOptions{}
+}
+
+// Complete completes LintOptions after they've been created
+func (o *LintOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if len(args) > 0 {
+		o.devfilePath = args[0]
+	} else {
+		o.devfilePath = filepath.Join(o.contextFlag, devfileName)
+	}
+	return nil
+}
+
+// Validate validates the LintOptions based on completed values
+func (o *LintOptions) Validate() (err error) {
+	if o.formatFlag != lintFormatText && o.formatFlag != lintFormatSarif {
+		return errors.Errorf("unsupported format %q, supported formats are %s and %s", o.formatFlag, lintFormatText, lintFormatSarif)
+	}
+	if o.formatFlag == lintFormatSarif && log.IsJSON() {
+		return errors.New("--format sarif can't be used with -o json")
+	}
+	if !util.CheckPathExists(o.devfilePath) {
+		return errors.Errorf("the devfile %s doesn't exist, please refer `odo create --help` on how to create a component", o.devfilePath)
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile lint command
+func (o *LintOptions) Run(cmd *cobra.Command) (err error) {
+	diagnostics, err := validate.LintDevfile(o.devfilePath)
+	if err != nil {
+		return err
+	}
+	errorCount, warningCount := validate.CountBySeverity(diagnostics)
+
+	switch {
+	case log.IsJSON():
+		machineoutput.OutputSuccess(DevfileLint{
+			Devfile:     o.devfilePath,
+			Diagnostics: diagnostics,
+			Errors:      errorCount,
+			Warnings:    warningCount,
+		})
+	case o.formatFlag == lintFormatSarif:
+		out, err := json.MarshalIndent(newSarifLog(o.devfilePath, diagnostics), "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(out))
+	default:
+		for _, diagnostic := range diagnostics {
+			fmt.Printf("%s:%s\n", o.devfilePath, diagnostic)
+		}
+		if errorCount > 0 {
+			return fmt.Errorf("%d error(s) and %d warning(s) found in the devfile %s", errorCount, warningCount, o.devfilePath)
+		}
+		if warningCount > 0 {
+			log.Warningf("%d warning(s) found in the devfile %s", warningCount, o.devfilePath)
+			return nil
+		}
+		log.Successf("No problems found in the devfile %s", o.devfilePath)
+		return nil
+	}
+
+	if errorCount > 0 {
+		// os.Exit(1) since the errors are part of the output, and not reported by the generic machine-readable handler
+		os.Exit(1)
+	}
+	return nil
+}
+
+// sarifLog is the root of a SARIF 2.1.0 log, see https://docs.oasis-open.org/sarif/sarif/v2.1.0/sarif-v2.1.0.html
+type sarifLog struct {
+	Schema  string     `json:"$schema"`
+	Version string     `json:"version"`
+	Runs    []sarifRun `json:"runs"`
+}
+
+type sarifRun struct {
+	Tool    sarifTool     `json:"tool"`
+	Results []sarifResult `json:"results"`
+}
+
+type sarifTool struct {
+	Driver sarifDriver `json:"driver"`
+}
+
+type sarifDriver struct {
+	Name           string      `json:"name"`
+	InformationURI string      `json:"informationUri"`
+	Rules          []sarifRule `json:"rules"`
+}
+
+type sarifRule struct {
+	ID               string       `json:"id"`
+	ShortDescription sarifMessage `json:"shortDescription"`
+}
+
+type sarifMessage struct {
+	Text string `json:"text"`
+}
+
+type sarifResult struct {
+	RuleID    string          `json:"ruleId"`
+	Level     string          `json:"level"`
+	Message   sarifMessage    `json:"message"`
+	Locations []sarifLocation `json:"locations"`
+}
+
+type sarifLocation struct {
+	PhysicalLocation sarifPhysicalLocation `json:"physicalLocation"`
+}
+
+type sarifPhysicalLocation struct {
+	ArtifactLocation sarifArtifactLocation `json:"artifactLocation"`
+	Region           *sarifRegion          `json:"region,omitempty"`
+}
+
+type sarifArtifactLocation struct {
+	URI string `json:"uri"`
+}
+
+type sarifRegion struct {
+	StartLine   int `json:"startLine"`
+	StartColumn int `json:"startColumn,omitempty"`
+}
+
+// newSarifLog returns the SARIF log of the diagnostics of the devfile
+func newSarifLog(devfilePath string, diagnostics []validate.Diagnostic) sarifLog {
+	driver := sarifDriver{Name: "odo", InformationURI: "https://odo.dev", Rules: []sarifRule{}}
+	for id, description := range validate.Rules {
+		driver.Rules = append(driver.Rules, sarifRule{ID: id, ShortDescription: sarifMessage{Text: description}})
+	}
+	sort.Slice(driver.Rules, func(i, j int) bool {
+		return driver.Rules[i].ID < driver.Rules[j].ID
+	})
+
+	results := []sarifResult{}
+	for _, diagnostic := range diagnostics {
+		location := sarifPhysicalLocation{ArtifactLocation: sarifArtifactLocation{URI: filepath.ToSlash(devfilePath)}}
+		if diagnostic.Line > 0 {
+			location.Region = &sarifRegion{StartLine: diagnostic.Line, StartColumn: diagnostic.Column}
+		}
+		results = append(results, sarifResult{
+			RuleID:    diagnostic.Rule,
+			Level:     string(diagnostic.Severity),
+			Message:   sarifMessage{Text: diagnostic.Message},
+			Locations: []sarifLocation{{PhysicalLocation: location}},
+		})
+	}
+
+	return sarifLog{
+		Schema:  "https://json.schemastore.org/sarif-2.1.0.json",
+		Version: "2.1.0",
+		Runs:    []sarifRun{{Tool: sarifTool{Driver: driver}, Results: results}},
+	}
+}
+
+// NewCmdLint implements the odo devfile lint command
+func NewCmdLint(name, fullName string) *cobra.Command {
+	o := NewLintOptions()
+	lintCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s [devfile path]", name),
+		Short:       "Check the devfile of the component for problems",
+		Long:        lintLongDesc,
+		Example:     fmt.Sprintf(lintExample, fullName),
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	lintCmd.Flags().StringVar(&o.formatFlag, "format", lintFormatText, "Format of the report of the problems, text or sarif")
+	genericclioptions.AddContextFlag(lintCmd, &o.contextFlag)
+
+	return lintCmd
+}
@@ -0,0 +1,242 @@
+package devfile
+
+import (
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strings"
+
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const (
+	upgradeCommandName = "upgrade"
+	devfileName        = "devfile.yaml"
+)
+
+var (
+	upgradeLongDesc = ktemplates.LongDesc(`Check the devfile of the component against newer versions of its stack
+
+The version of the stack the component has been created from is recorded by 'odo create' in the .odo/devfile.lock file.
+The changes between this version and the newest version of the stack, or the version specified with --to, are merged
+with the changes made to the devfile of the component, and shown as a three-way diff. Use --apply to write the merged devfile,
+when the changes of the stack don't conflict with the changes made to the devfile.`)
+
+	upgradeExample = ktemplates.Examples(`
+	# Show the changes of the newest version of the stack of the component
+	%[1]s
+
+	# Upgrade the devfile of the component to the version 1.1.0 of its stack
+	%[1]s --to 1.1.0 --apply
+	`)
+)
+
+// DevfileUpgrade represents the JSON output of odo devfile upgrade -o json
+type DevfileUpgrade struct {
+	Stack          string           `json:"stack"`
+	Registry       string           `json:"registry"`
+	CurrentVersion string           `json:"currentVersion,omitempty"`
+	TargetVersion  string           `json:"targetVersion,omitempty"`
+	UpToDate       bool             `json:"upToDate"`
+	Applied        bool             `json:"applied"`
+	Changes        []util.MergeHunk `json:"changes,omitempty"`
+}
+
+// UpgradeOptions encapsulates the options for the odo devfile upgrade command
+type UpgradeOptions struct {
+	contextFlag string
+	toFlag      string
+	applyFlag   bool
+
+	lock     *catalog.StackLock
+	registry catalog.Registry
+}
+
+// NewUpgradeOptions creates a new UpgradeOptions instance
+func NewUpgradeOptions() *UpgradeOptions {
+	return &UpgradeOptions{}
+}
+
+// Complete completes UpgradeOptions after they've been created
+func (o *UpgradeOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.lock, err = catalog.GetStackLock(o.contextFlag)
+	if err != nil {
+		return err
+	}
+	if o.lock != nil {
+		o.registry = catalog.Registry{Name: o.lock.Registry, URL: o.lock.RegistryURL}
+	}
+	return nil
+}
+
+// Validate validates the UpgradeOptions based on completed values
+func (o *UpgradeOptions) Validate() (err error) {
+	if !util.CheckPathExists(filepath.Join(o.contextFlag, devfileName)) {
+		return errors.New("the context directory doesn't contain a devfile, please refer `odo create --help` on how to create a component")
+	}
+	if o.lock == nil {
+		return errors.New("the version of the stack of the component is unknown, only the components created from a devfile registry by 'odo create' can be upgraded")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo devfile upgrade command
+func (o *UpgradeOptions) Run(cmd *cobra.Command) (err error) {
+	var target catalog.StackVersion
+	if o.toFlag != "" {
+		target, err = catalog.GetStackVersion(o.registry, o.lock.Stack, o.toFlag)
+	} else {
+		target, err = catalog.GetLatestStackVersion(o.registry, o.lock.Stack)
+	}
+	if err != nil {
+		return err
+	}
+	remoteData, err := catalog.GetStackVersionDevfile(o.registry, o.lock.Stack, target)
+	if err != nil {
+		return err
+	}
+
+	out := DevfileUpgrade{
+		Stack:          o.lock.Stack,
+		Registry:       o.lock.Registry,
+		CurrentVersion: o.lock.Version,
+		TargetVersion:  target.Version,
+		UpToDate:       catalog.DevfileDigest(remoteData) == o.lock.Digest,
+	}
+	if out.UpToDate {
+		if log.IsJSON() {
+			machineoutput.OutputSuccess(out)
+			return nil
+		}
+		log.Successf("The devfile of the component is up to date with the %s of the stack %s", versionName(target.Version), o.lock.Stack)
+		return nil
+	}
+
+	baseData, err := catalog.GetStackLockDevfile(o.contextFlag, *o.lock)
+	if err != nil {
+		return errors.Wrap(err, "unable to get the devfile of the stack the component has been created from")
+	}
+	devfilePath := filepath.Join(o.contextFlag, devfileName)
+	localData, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+
+	merged, hunks := util.ThreeWayMerge(splitLines(baseData), splitLines(localData), splitLines(remoteData))
+	conflicts := 0
+	for _, hunk := range hunks {
+		// the changes only made to the devfile of the component are kept and not shown
+		if !hunk.RemoteChanged() {
+			continue
+		}
+		out.Changes = append(out.Changes, hunk)
+		if hunk.Conflict {
+			conflicts++
+		}
+	}
+
+	if o.applyFlag {
+		if conflicts > 0 {
+			if !log.IsJSON() {
+				o.printChanges(out.Changes, target)
+			}
+			return fmt.Errorf("%d change(s) of the %s of the stack %s conflict with the changes made to the devfile, please apply them manually", conflicts, versionName(target.Version), o.lock.Stack)
+		}
+		err = ioutil.WriteFile(devfilePath, []byte(strings.Join(merged, "\n")), 0644) // #nosec G306
+		if err != nil {
+			return errors.Wrapf(err, "unable to write the devfile %s", devfilePath)
+		}
+		err = catalog.WriteStackLock(o.contextFlag, catalog.NewStackLock(o.registry, o.lock.Stack, target, remoteData), remoteData)
+		if err != nil {
+			return err
+		}
+		out.Applied = true
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(out)
+		return nil
+	}
+
+	o.printChanges(out.Changes, target)
+	if out.Applied {
+		log.Successf("The devfile of the component has been upgraded to the %s of the stack %s", versionName(target.Version), o.lock.Stack)
+		return nil
+	}
+	if conflicts > 0 {
+		log.Warningf("%d change(s) of the stack conflict with the changes made to the devfile", conflicts)
+	}
+	log.Italic("\nRun `odo devfile upgrade --apply` to upgrade the devfile of the component")
+	return nil
+}
+
+// printChanges prints the changes of the stack as a three-way diff, with the lines of the devfile of the component,
+// of the version of the stack the component has been created from and of the target version of the stack
+func (o *UpgradeOptions) printChanges(hunks []util.MergeHunk, target catalog.StackVersion) {
+	log.Infof("Changes from the %s to the %s of the stack %s", versionName(o.lock.Version), versionName(target.Version), o.lock.Stack)
+	for _, hunk := range hunks {
+		if hunk.Conflict {
+			fmt.Printf("@@ line %d, conflict @@\n", hunk.BaseLine)
+			fmt.Printf("<<<<<<< %s\n", devfileName)
+			printLines("", hunk.Local)
+			fmt.Printf("||||||| %s %s\n", o.lock.Stack, o.lock.Version)
+			printLines("", hunk.Base)
+			fmt.Println("=======")
+			printLines("", hunk.Remote)
+			fmt.Printf(">>>>>>> %s %s\n", o.lock.Stack, target.Version)
+			continue
+		}
+		fmt.Printf("@@ line %d @@\n", hunk.BaseLine)
+		printLines("-", hunk.Base)
+		printLines("+", hunk.Remote)
+	}
+}
+
+// printLines prints the lines with the prefix
+func printLines(prefix string, lines []string) {
+	for _, line := range lines {
+		fmt.Println(prefix + line)
+	}
+}
+
+// splitLines splits the content of a file into lines
+func splitLines(data []byte) []string {
+	return strings.Split(string(data), "\n")
+}
+
+// versionName returns the name of a version of a stack, for the stacks without version
+func versionName(version string) string {
+	if version == "" {
+		return "current version"
+	}
+	return "version " + version
+}
+
+// NewCmdUpgrade implements the odo devfile upgrade command
+func NewCmdUpgrade(name, fullName string) *cobra.Command {
+	o := NewUpgradeOptions()
+	upgradeCmd := &cobra.Command{
+		Use:         name,
+		Short:       "Check the devfile of the component against newer versions of its stack",
+		Long:        upgradeLongDesc,
+		Example:     fmt.Sprintf(upgradeExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	upgradeCmd.Flags().StringVar(&o.toFlag, "to", "", "Version of the stack to upgrade to, the newest version of the stack is used if not specified")
+	upgradeCmd.Flags().BoolVar(&o.applyFlag, "apply", false, "Write the devfile merged with the changes of the stack")
+	genericclioptions.AddContextFlag(upgradeCmd, &o.contextFlag)
+
+	return upgradeCmd
+}
@@ -0,0 +1,110 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const createCommandName = "create"
+
+var (
+	createLongDesc = ktemplates.LongDesc(`
+	Create a named environment of the component in the odo environment file
+
+	The environment is a copy of the current environment, or of the environment given with --from, with its own
+	project, application, URLs, variables and storage sizes. Use --env on the push, watch, url and storage commands
+	or 'odo env use' to use it.
+	`)
+
+	createExample = ktemplates.Examples(`
+	# Create the perf environment deploying the component in the perf project
+	%[1]s perf --project perf
+
+	# Create the demo environment as a copy of the perf environment
+	%[1]s demo --from perf
+	`)
+)
+
+// CreateOptions encapsulates the options for the command
+type CreateOptions struct {
+	context     string
+	fromFlag    string
+	projectFlag string
+	appFlag     string
+	cfg         *envinfo.EnvSpecificInfo
+	envName     string
+}
+
+// NewCreateOptions creates a new CreateOptions instance
+func NewCreateOptions() *CreateOptions {
+	return &CreateOptions{}
+}
+
+// Complete completes CreateOptions after they've been created
+func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.cfg, err = envinfo.NewEnvSpecificInfoForEnvironment(o.context, o.fromFlag)
+	if err != nil {
+		return errors.Wrap(err, "failed to load environment file")
+	}
+	o.envName = args[0]
+	return nil
+}
+
+// Validate validates the CreateOptions based on completed values
+func (o *CreateOptions) Validate() (err error) {
+	if !o.cfg.Exists() {
+		return errors.Errorf("the context directory doesn't contain a component, please refer `odo create --help` to create a component")
+	}
+	return nil
+}
+
+// Run contains the logic for the command
+func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
+	settings := o.cfg.GetComponentSettings()
+	// the component has not been pushed in the new environment yet
+	settings.RunMode = nil
+	if o.projectFlag != "" {
+		settings.Project = o.projectFlag
+	}
+	if o.appFlag != "" {
+		settings.AppName = o.appFlag
+	}
+
+	err = o.cfg.CreateEnvironment(o.envName, settings)
+	if err != nil {
+		return err
+	}
+
+	log.Successf("Created the environment %q from the environment %q", o.envName, o.cfg.GetEnvironment())
+	log.Italicf("Use 'odo env use %s' or the --env flag of the commands to use it", o.envName)
+	return nil
+}
+
+// NewCmdCreate implements the env create odo command
+func NewCmdCreate(name, fullName string) *cobra.Command {
+	o := NewCreateOptions()
+	envCreateCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <environment name>", name),
+		Short:   "Create a named environment of the component",
+		Long:    createLongDesc,
+		Example: fmt.Sprintf(createExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	envCreateCmd.Flags().StringVar(&o.fromFlag, "from", "", "Environment to copy the settings of, the current environment is used if not specified")
+	envCreateCmd.Flags().StringVar(&o.projectFlag, "project", "", "Project of the component in the environment")
+	envCreateCmd.Flags().StringVar(&o.appFlag, "app", "", "Application of the component in the environment")
+	envCreateCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+
+	return envCreateCmd
+}
@@ -15,33 +15,47 @@ import (
 const RecommendedCommandName = "env"
 
 const (
-	nameParameter                 = "Name"
-	nameParameterDescription      = "Use this value to set component name"
-	projectParameter              = "Project"
-	projectParameterDescription   = "Use this value to set component project"
-	debugportParameter            = "DebugPort"
-	debugportParameterDescription = "Use this value to set component debug port"
+	nameParameter                   = "Name"
+	nameParameterDescription        = "Use this value to set component name"
+	projectParameter                = "Project"
+	projectParameterDescription     = "Use this value to set component project"
+	debugportParameter              = "DebugPort"
+	debugportParameterDescription   = "Use this value to set component debug port"
+	variableParameter               = "Variable"
+	variableParameterDescription    = "Use this value to override the value of a devfile variable in the environment, in the NAME=VALUE format"
+	storageSizeParameter            = "StorageSize"
+	storageSizeParameterDescription = "Use this value to override the size of a devfile volume in the environment, in the NAME=SIZE format"
 )
 
-var envLongDesc = ktemplates.LongDesc(`Modifies odo specific configuration settings within environment file`)
+var envLongDesc = ktemplates.LongDesc(`Modifies odo specific configuration settings within environment file
+
+The environment file can hold several named environments of the component, like dev or perf, each with its own
+project, application, URLs, variables and storage sizes. The env files without named environments are migrated to the
+default environment.`)
 
 // NewCmdEnv implements the environment configuration command
 func NewCmdEnv(name, fullName string) *cobra.Command {
 	envViewCmd := NewCmdView(viewCommandName, util.GetFullName(fullName, viewCommandName))
 	envSetCmd := NewCmdSet(setCommandName, util.GetFullName(fullName, setCommandName))
 	envUnsetCmd := NewCmdUnset(unsetCommandName, util.GetFullName(fullName, unsetCommandName))
+	envCreateCmd := NewCmdCreate(createCommandName, util.GetFullName(fullName, createCommandName))
+	envUseCmd := NewCmdUse(useCommandName, util.GetFullName(fullName, useCommandName))
+	envListCmd := NewCmdList(listCommandName, util.GetFullName(fullName, listCommandName))
 	envCmd := &cobra.Command{
 		Use:   name,
 		Short: "Change or view environment configuration",
 		Long:  envLongDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			envViewCmd.Example,
 			envSetCmd.Example,
 			envUnsetCmd.Example,
+			envCreateCmd.Example,
+			envUseCmd.Example,
+			envListCmd.Example,
 		),
 	}
 
-	envCmd.AddCommand(envViewCmd, envSetCmd, envUnsetCmd)
+	envCmd.AddCommand(envViewCmd, envSetCmd, envUnsetCmd, envCreateCmd, envUseCmd, envListCmd)
 	envCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	envCmd.Annotations = map[string]string{"command": "main"}
 
@@ -0,0 +1,108 @@
+package env
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const listCommandName = "list"
+
+var (
+	listLongDesc = ktemplates.LongDesc(`
+	List the environments of the component, the current environment being marked with a *
+	`)
+
+	listExample = ktemplates.Examples(`
+	# List the environments of the component
+	%[1]s
+	`)
+)
+
+// EnvironmentList represents the JSON output of odo env list -o json
+type EnvironmentList struct {
+	Current      string                `json:"current"`
+	Environments []envinfo.Environment `json:"environments"`
+}
+
+// ListOptions encapsulates the options for the command
+type ListOptions struct {
+	context string
+	cfg     *envinfo.EnvSpecificInfo
+}
+
+// NewListOptions creates a new ListOptions instance
+func NewListOptions() *ListOptions {
+	return &ListOptions{}
+}
+
+// Complete completes ListOptions after they've been created
+func (o *ListOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.cfg, err = envinfo.NewEnvSpecificInfo(o.context)
+	if err != nil {
+		return errors.Wrap(err, "failed to load environment file")
+	}
+	return nil
+}
+
+// Validate validates the ListOptions based on completed values
+func (o *ListOptions) Validate() (err error) {
+	if !o.cfg.Exists() {
+		return errors.Errorf("the context directory doesn't contain a component, please refer `odo create --help` on how to create a component")
+	}
+	return nil
+}
+
+// Run contains the logic for the command
+func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
+	environments, current, err := o.cfg.ListEnvironments()
+	if err != nil {
+		return err
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(EnvironmentList{Current: current, Environments: environments})
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "CURRENT", "\t", "NAME", "\t", "PROJECT", "\t", "APPLICATION")
+	for _, environment := range environments {
+		mark := ""
+		if environment.Name == current {
+			mark = "*"
+		}
+		fmt.Fprintln(w, mark, "\t", environment.Name, "\t", environment.ComponentSettings.Project, "\t", environment.ComponentSettings.AppName)
+	}
+	w.Flush()
+	return nil
+}
+
+// NewCmdList implements the env list odo command
+func NewCmdList(name, fullName string) *cobra.Command {
+	o := NewListOptions()
+	envListCmd := &cobra.Command{
+		Use:         name,
+		Short:       "List the environments of the component",
+		Long:        listLongDesc,
+		Example:     fmt.Sprintf(listExample, fullName),
+		Args:        cobra.NoArgs,
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	envListCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+
+	return envListCmd
+}
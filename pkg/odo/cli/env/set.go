@@ -27,20 +27,27 @@ var (
    	%[1]s %[2]s myNodejs
    	%[1]s %[3]s myProject
    	%[1]s %[4]s 8888
+
+	# Override the value of a devfile variable and the size of a devfile volume in the perf environment
+	%[1]s %[5]s MEMORY_LIMIT=2Gi --env perf
+	%[1]s %[6]s data=10Gi --env perf
 	`)
 )
 
 var (
 	supportedSetParameters = map[string]string{
-		nameParameter:      nameParameterDescription,
-		projectParameter:   projectParameterDescription,
-		debugportParameter: debugportParameterDescription,
+		nameParameter:        nameParameterDescription,
+		projectParameter:     projectParameterDescription,
+		debugportParameter:   debugportParameterDescription,
+		variableParameter:    variableParameterDescription,
+		storageSizeParameter: storageSizeParameterDescription,
 	}
 )
 
 // SetOptions encapsulates the options for the command
 type SetOptions struct {
 	context    string
+	envFlag    string
 	cfg        *envinfo.EnvSpecificInfo
 	paramName  string
 	paramValue string
@@ -54,7 +61,7 @@ func NewSetOptions() *SetOptions {
 
 // Complete completes SetOptions after they've been created
 func (o *SetOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
-	o.cfg, err = envinfo.NewEnvSpecificInfo(o.context)
+	o.cfg, err = envinfo.NewEnvSpecificInfoForEnvironment(o.context, o.envFlag)
 	if err != nil {
 		return errors.Wrap(err, "failed to load environment file")
 	}
@@ -81,7 +88,12 @@ func (o *SetOptions) Validate() (err error) {
 // Run contains the logic for the command
 func (o *SetOptions) Run(cmd *cobra.Command) (err error) {
 	if !o.forceFlag {
-		if isSet := o.cfg.IsSet(o.paramName); isSet {
+		isSet := o.cfg.IsSet(o.paramName)
+		if envinfo.IsOverrideParameter(o.paramName) {
+			// the other variables or volumes being overridden doesn't matter
+			isSet = o.cfg.IsOverrideSet(o.paramName, o.paramValue)
+		}
+		if isSet {
 			if !ui.Proceed(fmt.Sprintf("%v is already set. Do you want to override it in the environment", o.paramName)) {
 				log.Info("Aborted by the user")
 				return nil
@@ -110,7 +122,7 @@ func NewCmdSet(name, fullName string) *cobra.Command {
 		Short: "Set a value in odo environment file",
 		Long:  setLongDesc + printSupportedParameters(supportedSetParameters),
 		Example: fmt.Sprintf(fmt.Sprint(setExample), fullName,
-			envinfo.Name, envinfo.Project, envinfo.DebugPort),
+			envinfo.Name, envinfo.Project, envinfo.DebugPort, envinfo.Variable, envinfo.StorageSize),
 		Args: func(cmd *cobra.Command, args []string) error {
 			if len(args) < 2 {
 				return fmt.Errorf("please provide a parameter name and value")
@@ -127,6 +139,7 @@ func NewCmdSet(name, fullName string) *cobra.Command {
 
 	envSetCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Don't ask for confirmation, set the environment directly")
 	envSetCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+	genericclioptions.AddEnvFlag(envSetCmd, &o.envFlag)
 
 	return envSetCmd
 }
@@ -29,13 +29,16 @@ var (
 
 var (
 	supportedUnsetParameters = map[string]string{
-		debugportParameter: debugportParameterDescription,
+		debugportParameter:   debugportParameterDescription,
+		variableParameter:    "Use this value to remove the overrides of the devfile variables in the environment",
+		storageSizeParameter: "Use this value to remove the overrides of the sizes of the devfile volumes in the environment",
 	}
 )
 
 // UnsetOptions encapsulates the options for the command
 type UnsetOptions struct {
 	context   string
+	envFlag   string
 	cfg       *envinfo.EnvSpecificInfo
 	paramName string
 	forceFlag bool
@@ -48,7 +51,7 @@ func NewUnsetOptions() *UnsetOptions {
 
 // Complete completes UnsetOptions after they've been created
 func (o *UnsetOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
-	o.cfg, err = envinfo.NewEnvSpecificInfo(o.context)
+	o.cfg, err = envinfo.NewEnvSpecificInfoForEnvironment(o.context, o.envFlag)
 	if err != nil {
 		return errors.Wrap(err, "failed to load environment file")
 	}
@@ -118,6 +121,7 @@ func NewCmdUnset(name, fullName string) *cobra.Command {
 
 	envUnsetCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Don't ask for confirmation, unsetting the environment directly")
 	envUnsetCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+	genericclioptions.AddEnvFlag(envUnsetCmd, &o.envFlag)
 
 	return envUnsetCmd
 }
@@ -0,0 +1,85 @@
+package env
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const useCommandName = "use"
+
+var (
+	useLongDesc = ktemplates.LongDesc(`
+	Set the current environment of the component, used by the commands when --env is not specified
+	`)
+
+	useExample = ktemplates.Examples(`
+	# Use the perf environment
+	%[1]s perf
+	`)
+)
+
+// UseOptions encapsulates the options for the command
+type UseOptions struct {
+	context string
+	cfg     *envinfo.EnvSpecificInfo
+	envName string
+}
+
+// NewUseOptions creates a new UseOptions instance
+func NewUseOptions() *UseOptions {
+	return &UseOptions{}
+}
+
+// Complete completes UseOptions after they've been created
+func (o *UseOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.cfg, err = envinfo.NewEnvSpecificInfo(o.context)
+	if err != nil {
+		return errors.Wrap(err, "failed to load environment file")
+	}
+	o.envName = args[0]
+	return nil
+}
+
+// Validate validates the UseOptions based on completed values
+func (o *UseOptions) Validate() (err error) {
+	if !o.cfg.Exists() {
+		return errors.Errorf("the context directory doesn't contain a component, please refer `odo create --help` to create a component")
+	}
+	return nil
+}
+
+// Run contains the logic for the command
+func (o *UseOptions) Run(cmd *cobra.Command) (err error) {
+	err = o.cfg.UseEnvironment(o.envName)
+	if err != nil {
+		return err
+	}
+	log.Successf("Switched to the environment %q", o.envName)
+	return nil
+}
+
+// NewCmdUse implements the env use odo command
+func NewCmdUse(name, fullName string) *cobra.Command {
+	o := NewUseOptions()
+	envUseCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <environment name>", name),
+		Short:   "Set the current environment of the component",
+		Long:    useLongDesc,
+		Example: fmt.Sprintf(useExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	envUseCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+
+	return envUseCmd
+}
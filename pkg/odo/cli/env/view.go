@@ -10,6 +10,7 @@ import (
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/machineoutput"
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
@@ -25,12 +26,16 @@ var (
 	viewExample = ktemplates.Examples(`
 	# For viewing the current environment configuration settings
 	%[1]s
+
+	# For viewing the configuration settings of the perf environment
+	%[1]s --env perf
 	`)
 )
 
 // ViewOptions encapsulates the options for the command
 type ViewOptions struct {
 	context string
+	envFlag string
 	cfg     *envinfo.EnvSpecificInfo
 }
 
@@ -41,7 +46,7 @@ func NewViewOptions() *ViewOptions {
 
 // Complete completes ViewOptions after they've been created
 func (o *ViewOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
-	o.cfg, err = envinfo.NewEnvSpecificInfo(o.context)
+	o.cfg, err = envinfo.NewEnvSpecificInfoForEnvironment(o.context, o.envFlag)
 	if err != nil {
 		return errors.Wrap(err, "failed to load environment file")
 	}
@@ -62,15 +67,24 @@ func (o *ViewOptions) Validate() (err error) {
 func (o *ViewOptions) Run(cmd *cobra.Command) (err error) {
 	cs := o.cfg.GetComponentSettings()
 	if log.IsJSON() {
-		machineoutput.OutputSuccess(envinfo.WrapForJSONOutput(cs))
+		envInfoRepr := envinfo.WrapForJSONOutput(cs)
+		envInfoRepr.Name = o.cfg.GetEnvironment()
+		machineoutput.OutputSuccess(envInfoRepr)
 		return
 	}
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 2, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "PARAMETER NAME", "\t", "PARAMETER VALUE")
+	genericclioptions.PrintTableHeader(w, "PARAMETER NAME", "\t", "PARAMETER VALUE")
+	fmt.Fprintln(w, "Environment", "\t", o.cfg.GetEnvironment())
 	fmt.Fprintln(w, "Name", "\t", cs.Name)
 	fmt.Fprintln(w, "Project", "\t", cs.Project)
 	fmt.Fprintln(w, "Application", "\t", cs.AppName)
 	fmt.Fprintln(w, "DebugPort", "\t", showBlankIfNil(cs.DebugPort))
+	for _, name := range util.GetSortedKeys(cs.Variable) {
+		fmt.Fprintln(w, "Variable", "\t", name+"="+cs.Variable[name])
+	}
+	for _, name := range util.GetSortedKeys(cs.StorageSize) {
+		fmt.Fprintln(w, "StorageSize", "\t", name+"="+cs.StorageSize[name])
+	}
 
 	w.Flush()
 
@@ -110,6 +124,7 @@ func NewCmdView(name, fullName string) *cobra.Command {
 	}
 
 	envViewCmd.Flags().StringVar(&o.context, "context", "", "Use given context directory as a source for component settings")
+	genericclioptions.AddEnvFlag(envViewCmd, &o.envFlag)
 
 	return envViewCmd
 }
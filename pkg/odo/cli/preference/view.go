@@ -56,7 +56,7 @@ func (o *ViewOptions) Run(cmd *cobra.Command) (err error) {
 		return
 	}
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 2, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "PARAMETER", "\t", "CURRENT_VALUE")
+	genericclioptions.PrintTableHeader(w, "PARAMETER", "\t", "CURRENT_VALUE")
 	fmt.Fprintln(w, "UpdateNotification", "\t", showBlankIfNil(cfg.OdoSettings.UpdateNotification))
 	fmt.Fprintln(w, "NamePrefix", "\t", showBlankIfNil(cfg.OdoSettings.NamePrefix))
 	fmt.Fprintln(w, "Timeout", "\t", showBlankIfNil(cfg.OdoSettings.Timeout))
@@ -64,7 +64,9 @@ func (o *ViewOptions) Run(cmd *cobra.Command) (err error) {
 	fmt.Fprintln(w, "PushTimeout", "\t", showBlankIfNil(cfg.OdoSettings.PushTimeout))
 	fmt.Fprintln(w, "Experimental", "\t", showBlankIfNil(cfg.OdoSettings.Experimental))
 	fmt.Fprintln(w, "Ephemeral", "\t", showBlankIfNil(cfg.OdoSettings.Ephemeral))
+	fmt.Fprintln(w, "RegistryOffline", "\t", showBlankIfNil(cfg.OdoSettings.RegistryOffline))
 	fmt.Fprintln(w, "ConsentTelemetry", "\t", showBlankIfNil(cfg.OdoSettings.ConsentTelemetry))
+	fmt.Fprintln(w, "IngressDomain", "\t", showBlankIfNil(cfg.OdoSettings.IngressDomain))
 
 	w.Flush()
 	return
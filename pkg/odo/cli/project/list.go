@@ -60,7 +60,7 @@ func (plo *ProjectListOptions) Run(cmd *cobra.Command) (err error) {
 			return fmt.Errorf("You are not a member of any projects. You can request a project to be created using the `odo project create <project_name>` command")
 		}
 		w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-		fmt.Fprintln(w, "ACTIVE", "\t", "NAME")
+		genericclioptions.PrintTableHeader(w, "ACTIVE", "\t", "NAME")
 		for _, project := range projects.Items {
 			activeMark := " "
 			if project.Status.Active {
@@ -22,12 +22,18 @@ const addCommandName = "add"
 
 // "odo registry add" command description and examples
 var (
-	addLongDesc = ktemplates.LongDesc(`Add devfile registry`)
+	addLongDesc = ktemplates.LongDesc(`Add devfile registry
+
+The registry can be a directory on the local filesystem, given as a path or a file:// URL, laid out like the registry repository:
+an index.json file at its root, and a directory per stack under the stacks directory. Such a directory can be created with 'odo registry mirror'.`)
 
 	addExample = ktemplates.Examples(`# Add devfile registry
 	%[1]s CheRegistry https://che-devfile-registry.openshift.io
 
 	%[1]s RegistryFromGitHub https://github.com/elsony/devfile-registry
+
+	# Add a registry from a local directory
+	%[1]s LocalRegistry /opt/devfile-registry
 	`)
 )
 
@@ -57,6 +63,18 @@ func (o *AddOptions) Complete(name string, cmd *cobra.Command, args []string) (e
 
 // Validate validates the AddOptions based on completed values
 func (o *AddOptions) Validate() (err error) {
+	if util2.IsLocalRegistry(o.registryURL) {
+		if o.token != "" {
+			return fmt.Errorf("a token can't be used with a local registry")
+		}
+		// the local registries are recorded with the file:// URL of their directory
+		o.registryURL, err = util2.GetLocalRegistryURL(o.registryURL)
+		if err != nil {
+			return err
+		}
+		return util2.ValidateLocalRegistry(util2.GetLocalRegistryPath(o.registryURL))
+	}
+
 	err = util.ValidateURL(o.registryURL)
 	if err != nil {
 		return err
@@ -99,7 +117,7 @@ func NewCmdAdd(name, fullName string) *cobra.Command {
 	o := NewAddOptions()
 	registryAddCmd := &cobra.Command{
 		Use:     fmt.Sprintf("%s <registry name> <registry URL>", name),
-		Short:   addLongDesc,
+		Short:   "Add devfile registry",
 		Long:    addLongDesc,
 		Example: fmt.Sprintf(fmt.Sprint(addExample), fullName),
 		Args:    cobra.ExactArgs(2),
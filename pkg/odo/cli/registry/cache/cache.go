@@ -0,0 +1,36 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/odo/util"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended cache command name
+const RecommendedCommandName = "cache"
+
+var cacheDesc = ktemplates.LongDesc(`Inspect and clear the information cached from the devfile registries
+
+odo caches the indexes and the devfiles of the devfile registries for RegistryCacheTime minutes, and revalidates them
+afterwards. The cached information is used when a registry can't be reached, and without connecting to the registries
+when the RegistryOffline preference is set to true.`)
+
+// NewCmdCache implements the odo registry cache command
+func NewCmdCache(name, fullName string) *cobra.Command {
+	cacheListCmd := NewCmdList(listCommandName, util.GetFullName(fullName, listCommandName))
+	cacheClearCmd := NewCmdClear(clearCommandName, util.GetFullName(fullName, clearCommandName))
+
+	cacheCmd := &cobra.Command{
+		Use:     name,
+		Short:   "Inspect and clear the information cached from the devfile registries",
+		Long:    cacheDesc,
+		Example: fmt.Sprintf("%s\n\n%s", cacheListCmd.Example, cacheClearCmd.Example),
+	}
+
+	cacheCmd.AddCommand(cacheListCmd, cacheClearCmd)
+	cacheCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return cacheCmd
+}
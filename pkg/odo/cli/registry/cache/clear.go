@@ -0,0 +1,86 @@
+package cache
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const clearCommandName = "clear"
+
+var (
+	clearDesc = ktemplates.LongDesc(`Clear the information cached from the devfile registries`)
+
+	clearExample = ktemplates.Examples(`# Clear the information cached from all the devfile registries
+	%[1]s
+
+	# Clear the information cached from the devfile registry DefaultDevfileRegistry
+	%[1]s DefaultDevfileRegistry
+	`)
+)
+
+// ClearOptions encapsulates the options for the odo registry cache clear command
+type ClearOptions struct {
+	registryName string
+	forceFlag    bool
+}
+
+// NewClearOptions creates a new ClearOptions instance
+func NewClearOptions() *ClearOptions {
+	return &ClearOptions{}
+}
+
+// Complete completes ClearOptions after they've been created
+func (o *ClearOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if len(args) > 0 {
+		o.registryName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the ClearOptions based on completed values
+func (o *ClearOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo registry cache clear command
+func (o *ClearOptions) Run(cmd *cobra.Command) (err error) {
+	target := "all the devfile registries"
+	if o.registryName != "" {
+		target = fmt.Sprintf("the devfile registry %s", o.registryName)
+	}
+	if !o.forceFlag && !ui.Proceed(fmt.Sprintf("Are you sure you want to clear the information cached from %s", target)) {
+		log.Infof("Aborting clearing the cache of %s", target)
+		return nil
+	}
+
+	err = catalog.ClearRegistryCache(o.registryName)
+	if err != nil {
+		return err
+	}
+	log.Successf("Cleared the information cached from %s", target)
+	return nil
+}
+
+// NewCmdClear implements the odo registry cache clear command
+func NewCmdClear(name, fullName string) *cobra.Command {
+	o := NewClearOptions()
+	cacheClearCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s [registry name]", name),
+		Short:   clearDesc,
+		Long:    clearDesc,
+		Example: fmt.Sprintf(clearExample, fullName),
+		Args:    cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	cacheClearCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Don't ask for confirmation, clear the cache directly")
+	return cacheClearCmd
+}
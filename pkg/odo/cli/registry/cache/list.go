@@ -0,0 +1,134 @@
+package cache
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/preference"
+
+	"github.com/spf13/cobra"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const listCommandName = "list"
+
+var (
+	listDesc = ktemplates.LongDesc(`List the files cached from the devfile registries`)
+
+	listExample = ktemplates.Examples(`# List the files cached from all the devfile registries
+	%[1]s
+
+	# List the files cached from the devfile registry DefaultDevfileRegistry
+	%[1]s DefaultDevfileRegistry
+	`)
+)
+
+// RegistryCacheList is the JSON output of odo registry cache list -o json
+type RegistryCacheList struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Items             []catalog.RegistryCacheEntry `json:"items"`
+}
+
+// ListOptions encapsulates the options for the odo registry cache list command
+type ListOptions struct {
+	registryName string
+	cacheTime    time.Duration
+}
+
+// NewListOptions creates a new ListOptions instance
+func NewListOptions() *ListOptions {
+	return &ListOptions{}
+}
+
+// Complete completes ListOptions after they've been created
+func (o *ListOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	if len(args) > 0 {
+		o.registryName = args[0]
+	}
+	cfg, err := preference.New()
+	if err != nil {
+		return err
+	}
+	o.cacheTime = time.Duration(cfg.GetRegistryCacheTime()) * time.Minute
+	return nil
+}
+
+// Validate validates the ListOptions based on completed values
+func (o *ListOptions) Validate() (err error) {
+	return nil
+}
+
+// Run contains the logic for the odo registry cache list command
+func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
+	entries, err := catalog.ListRegistryCache(o.registryName)
+	if err != nil {
+		return err
+	}
+
+	if log.IsJSON() {
+		if entries == nil {
+			entries = []catalog.RegistryCacheEntry{}
+		}
+		machineoutput.OutputSuccess(RegistryCacheList{
+			TypeMeta: metav1.TypeMeta{
+				Kind:       "List",
+				APIVersion: machineoutput.APIVersion,
+			},
+			Items: entries,
+		})
+		return nil
+	}
+
+	if len(entries) == 0 {
+		log.Info("No information cached from the devfile registries")
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "REGISTRY", "\t", "URL", "\t", "SIZE", "\t", "FETCHED", "\t", "VALIDATED", "\t", "STATUS", "\t", "VALIDATOR")
+	for _, entry := range entries {
+		status := "Fresh"
+		if time.Since(entry.ValidatedAt) >= o.cacheTime {
+			status = "Expired"
+		}
+		validator := "-"
+		if entry.ETag != "" {
+			validator = "ETag " + entry.ETag
+		} else if entry.LastModified != "" {
+			validator = "Last-Modified " + entry.LastModified
+		}
+		fmt.Fprintln(w, entry.Registry, "\t", entry.URL, "\t", entry.Size, "\t", formatTime(entry.FetchedAt), "\t", formatTime(entry.ValidatedAt), "\t", status, "\t", validator)
+	}
+	w.Flush()
+	return nil
+}
+
+// formatTime formats the time an entry has been fetched or validated at, relatively to the current time
+func formatTime(t time.Time) string {
+	return time.Since(t).Round(time.Second).String() + " ago"
+}
+
+// NewCmdList implements the odo registry cache list command
+func NewCmdList(name, fullName string) *cobra.Command {
+	o := NewListOptions()
+	cacheListCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s [registry name]", name),
+		Short:       listDesc,
+		Long:        listDesc,
+		Example:     fmt.Sprintf(listExample, fullName),
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	return cacheListCmd
+}
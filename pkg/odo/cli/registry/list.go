@@ -71,7 +71,7 @@ func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
 	}
 
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME", "\t", "URL", "\t", "SECURE")
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "URL", "\t", "SECURE")
 	o.printRegistryList(w, registryList)
 	w.Flush()
 	if o.printGitRegistryDeprecationWarning {
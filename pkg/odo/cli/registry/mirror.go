@@ -0,0 +1,157 @@
+package registry
+
+import (
+	// Built-in packages
+	"fmt"
+	"os"
+
+	// Third-party packages
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"github.com/zalando/go-keyring"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/component"
+	"github.com/openshift/odo/pkg/devfile"
+	"github.com/openshift/odo/pkg/log"
+	registryUtil "github.com/openshift/odo/pkg/odo/cli/registry/util"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/util"
+)
+
+const mirrorCommandName = "mirror"
+
+// "odo registry mirror" command description and examples
+var (
+	mirrorLongDesc = ktemplates.LongDesc(`Mirror a devfile registry into a local directory
+
+The stacks of the registry, the archives of their starter projects and the index of the registry are copied into the directory,
+laid out like the registry repository. The directory can then be used as a registry without network access, with 'odo registry add'.`)
+
+	mirrorExample = ktemplates.Examples(`# Mirror the registry named DefaultDevfileRegistry into the /opt/devfile-registry directory
+	%[1]s DefaultDevfileRegistry /opt/devfile-registry
+	`)
+)
+
+// MirrorOptions encapsulates the options for the "odo registry mirror" command
+type MirrorOptions struct {
+	registryName string
+	directory    string
+	registry     catalog.Registry
+}
+
+// NewMirrorOptions creates a new MirrorOptions instance
+func NewMirrorOptions() *MirrorOptions {
+	return &MirrorOptions{}
+}
+
+// Complete completes MirrorOptions after they've been created
+func (o *MirrorOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.registryName = args[0]
+	o.directory = args[1]
+	return
+}
+
+// Validate validates the MirrorOptions based on completed values
+func (o *MirrorOptions) Validate() (err error) {
+	registries, err := catalog.GetDevfileRegistries(o.registryName)
+	if err != nil {
+		return err
+	}
+	if len(registries) == 0 {
+		return fmt.Errorf("registry %s doesn't exist, please specify a valid registry via `odo registry list`", o.registryName)
+	}
+	o.registry = registries[0]
+
+	// the stacks are copied into the directory, which must not be the one of the mirrored registry
+	if registryUtil.IsLocalRegistry(o.registry.URL) && util.PathEqual(registryUtil.GetLocalRegistryPath(o.registry.URL), o.directory) {
+		return fmt.Errorf("the registry %s can't be mirrored into its own directory", o.registryName)
+	}
+	return
+}
+
+// Run contains the logic for "odo registry mirror" command
+func (o *MirrorOptions) Run(cmd *cobra.Command) (err error) {
+	err = os.MkdirAll(o.directory, os.ModePerm)
+	if err != nil {
+		return errors.Wrapf(err, "unable to create the directory %s", o.directory)
+	}
+
+	s := log.Spinnerf("Mirroring the stacks of the registry %s", o.registryName)
+	devfileIndex, err := catalog.MirrorRegistryStacks(o.registry, o.directory)
+	if err != nil {
+		s.End(false)
+		return err
+	}
+	s.End(true)
+
+	var token string
+	if registryUtil.IsSecure(o.registryName) {
+		token, err = keyring.Get(fmt.Sprintf("%s%s", util.CredentialPrefix, o.registryName), registryUtil.RegistryUser)
+		if err != nil {
+			return errors.Wrap(err, "unable to get secure registry credential from keyring")
+		}
+	}
+
+	mirrorURL, err := registryUtil.GetLocalRegistryURL(o.directory)
+	if err != nil {
+		return err
+	}
+	mirror := catalog.Registry{Name: o.registryName, URL: mirrorURL}
+
+	failed := 0
+	for _, stack := range devfileIndex {
+		devObj, err := devfile.ParseFromFile(catalog.GetLocalStackDevfilePath(mirror, stack.Name))
+		if err != nil {
+			log.Warningf("Unable to mirror the starter projects of the stack %s: %v", stack.Name, err)
+			failed++
+			continue
+		}
+		starterProjects, err := devObj.Data.GetStarterProjects(parsercommon.DevfileOptions{})
+		if err != nil {
+			return err
+		}
+		for i := range starterProjects {
+			archive := catalog.GetLocalStarterProjectArchivePath(o.directory, stack.Name, starterProjects[i].Name)
+			err = component.ArchiveStarterProject(&starterProjects[i], token, archive)
+			if err != nil {
+				log.Warningf("Unable to mirror the starter project %s of the stack %s: %v", starterProjects[i].Name, stack.Name, err)
+				failed++
+			}
+		}
+	}
+
+	// the index is written last, the directory is not a valid registry until all the stacks are mirrored
+	err = catalog.WriteLocalRegistryIndex(o.directory, devfileIndex)
+	if err != nil {
+		return errors.Wrap(err, "unable to write the index of the registry")
+	}
+
+	if failed > 0 {
+		log.Warningf("The registry %s has been mirrored into %s, but %d starter project(s) couldn't be mirrored", o.registryName, o.directory, failed)
+	} else {
+		log.Successf("The registry %s has been mirrored into %s", o.registryName, o.directory)
+	}
+	log.Italicf("Use `odo registry add <registry name> %s` to use the mirrored registry", mirrorURL)
+	return nil
+}
+
+// NewCmdMirror implements the "odo registry mirror" command
+func NewCmdMirror(name, fullName string) *cobra.Command {
+	o := NewMirrorOptions()
+	registryMirrorCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <registry name> <directory>", name),
+		Short:   "Mirror a devfile registry into a local directory",
+		Long:    mirrorLongDesc,
+		Example: fmt.Sprintf(fmt.Sprint(mirrorExample), fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	return registryMirrorCmd
+}
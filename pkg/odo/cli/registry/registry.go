@@ -9,6 +9,7 @@ import (
 	ktemplates "k8s.io/kubectl/pkg/util/templates"
 
 	// odo packages
+	"github.com/openshift/odo/pkg/odo/cli/registry/cache"
 	"github.com/openshift/odo/pkg/odo/util"
 )
 
@@ -25,20 +26,26 @@ func NewCmdRegistry(name, fullName string) *cobra.Command {
 	registryListCmd := NewCmdList(listCommandName, util.GetFullName(fullName, listCommandName))
 	registryUpdateCmd := NewCmdUpdate(updateCommandName, util.GetFullName(fullName, updateCommandName))
 	registryDeleteCmd := NewCmdDelete(deleteCommandName, util.GetFullName(fullName, deleteCommandName))
+	registryMirrorCmd := NewCmdMirror(mirrorCommandName, util.GetFullName(fullName, mirrorCommandName))
+	registryServeCmd := NewCmdServe(serveCommandName, util.GetFullName(fullName, serveCommandName))
+	registryCacheCmd := cache.NewCmdCache(cache.RecommendedCommandName, util.GetFullName(fullName, cache.RecommendedCommandName))
 
 	registryCmd := &cobra.Command{
 		Use:   name,
 		Short: registryDesc,
 		Long:  registryDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			registryAddCmd.Example,
 			registryListCmd.Example,
 			registryUpdateCmd.Example,
 			registryDeleteCmd.Example,
+			registryMirrorCmd.Example,
+			registryServeCmd.Example,
+			registryCacheCmd.Example,
 		),
 	}
 
-	registryCmd.AddCommand(registryAddCmd, registryListCmd, registryUpdateCmd, registryDeleteCmd)
+	registryCmd.AddCommand(registryAddCmd, registryListCmd, registryUpdateCmd, registryDeleteCmd, registryMirrorCmd, registryServeCmd, registryCacheCmd)
 	registryCmd.SetUsageTemplate(util.CmdUsageTemplate)
 	registryCmd.Annotations = map[string]string{"command": "main"}
 
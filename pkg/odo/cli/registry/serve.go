@@ -0,0 +1,136 @@
+package registry
+
+import (
+	// Built-in packages
+	"fmt"
+	"net"
+	"net/http"
+	"os"
+	"strconv"
+
+	// Third-party packages
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+
+	// odo packages
+	"github.com/openshift/odo/pkg/catalog"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+)
+
+const serveCommandName = "serve"
+
+// "odo registry serve" command description and examples
+var (
+	serveLongDesc = ktemplates.LongDesc(`Serve a directory of stacks as a devfile registry
+
+Each sub-directory of the directory is a stack, containing a devfile.yaml and the other resources of the stack,
+and must be named after the stack. The index of the stacks is generated from their devfiles, and regenerated when the stacks change.
+
+The registry is served over HTTP with the endpoints of a devfile registry server, so that any odo can use it with 'odo registry add'.`)
+
+	serveExample = ktemplates.Examples(`# Serve the stacks of the ./stacks directory on http://localhost:8080
+	%[1]s ./stacks
+
+	# Serve the stacks of the ./stacks directory to the other hosts of the network, on port 8000
+	%[1]s ./stacks --address 0.0.0.0 --port 8000
+	`)
+)
+
+// ServeOptions encapsulates the options for the "odo registry serve" command
+type ServeOptions struct {
+	stacksDir string
+
+	// Flags
+	addressFlag string
+	portFlag    int
+}
+
+// NewServeOptions creates a new ServeOptions instance
+func NewServeOptions() *ServeOptions {
+	return &ServeOptions{}
+}
+
+// Complete completes ServeOptions after they've been created
+func (o *ServeOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.stacksDir = args[0]
+	return
+}
+
+// Validate validates the ServeOptions based on completed values
+func (o *ServeOptions) Validate() (err error) {
+	info, err := os.Stat(o.stacksDir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to access the stacks directory %s", o.stacksDir)
+	}
+	if !info.IsDir() {
+		return fmt.Errorf("%s is not a directory", o.stacksDir)
+	}
+	if o.portFlag <= 0 || o.portFlag > 65535 {
+		return fmt.Errorf("the port %d is not valid", o.portFlag)
+	}
+	return
+}
+
+// Run contains the logic for "odo registry serve" command
+func (o *ServeOptions) Run(cmd *cobra.Command) (err error) {
+	s := log.Spinnerf("Generating the index of the stacks of %s", o.stacksDir)
+	server, err := catalog.NewRegistryServer(o.stacksDir)
+	if err != nil {
+		s.End(false)
+		return err
+	}
+	s.End(true)
+
+	stop := make(chan struct{})
+	defer close(stop)
+	go func() {
+		err := server.Watch(stop, func(err error) {
+			if err != nil {
+				log.Warningf("The index of the stacks has not been regenerated, the previous index is still served: %v", err)
+				return
+			}
+			log.Infof("The index of the stacks has been regenerated with %d stack(s)", len(server.Index()))
+		})
+		if err != nil {
+			log.Warningf("The index of the stacks won't be regenerated on change: %v", err)
+		}
+	}()
+
+	address := net.JoinHostPort(o.addressFlag, strconv.Itoa(o.portFlag))
+	listener, err := net.Listen("tcp", address)
+	if err != nil {
+		return errors.Wrapf(err, "unable to listen on %s", address)
+	}
+
+	host := o.addressFlag
+	if host == "" || host == "0.0.0.0" {
+		host = "localhost"
+	}
+	registryURL := fmt.Sprintf("http://%s", net.JoinHostPort(host, strconv.Itoa(o.portFlag)))
+	log.Successf("Serving %d stack(s) of %s on %s", len(server.Index()), o.stacksDir, registryURL)
+	log.Italicf("Use `odo registry add <registry name> %s` to use the registry, press Ctrl+C to stop serving", registryURL)
+
+	return http.Serve(listener, server)
+}
+
+// NewCmdServe implements the "odo registry serve" command
+func NewCmdServe(name, fullName string) *cobra.Command {
+	o := NewServeOptions()
+	registryServeCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <stacks directory>", name),
+		Short:   "Serve a directory of stacks as a devfile registry",
+		Long:    serveLongDesc,
+		Example: fmt.Sprintf(fmt.Sprint(serveExample), fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	registryServeCmd.Flags().StringVar(&o.addressFlag, "address", "localhost", "Address on which the registry is served")
+	registryServeCmd.Flags().IntVar(&o.portFlag, "port", 8080, "Port on which the registry is served")
+
+	return registryServeCmd
+}
@@ -54,6 +54,18 @@ func (o *UpdateOptions) Complete(name string, cmd *cobra.Command, args []string)
 
 // Validate validates the UpdateOptions based on completed values
 func (o *UpdateOptions) Validate() (err error) {
+	if registryUtil.IsLocalRegistry(o.registryURL) {
+		if o.token != "" {
+			return fmt.Errorf("a token can't be used with a local registry")
+		}
+		// the local registries are recorded with the file:// URL of their directory
+		o.registryURL, err = registryUtil.GetLocalRegistryURL(o.registryURL)
+		if err != nil {
+			return err
+		}
+		return registryUtil.ValidateLocalRegistry(registryUtil.GetLocalRegistryPath(o.registryURL))
+	}
+
 	err = util.ValidateURL(o.registryURL)
 	if err != nil {
 		return err
@@ -4,6 +4,8 @@ import (
 	// odo packages
 
 	"os"
+	"path/filepath"
+	"runtime"
 	"strings"
 
 	"github.com/openshift/odo/pkg/log"
@@ -13,6 +15,8 @@ import (
 
 const (
 	RegistryUser = "default"
+	// LocalRegistryIndex is the index of the stacks of a local registry, at the root of its directory
+	LocalRegistryIndex = "index.json"
 )
 
 // IsSecure checks if the registry is secure
@@ -40,6 +44,58 @@ func IsGitBasedRegistry(url string) bool {
 	return strings.Contains(url, "github.com") || strings.Contains(url, "raw.githubusercontent.com")
 }
 
+// IsLocalRegistry checks if the registry is a directory on the local filesystem,
+// given as a file:// URL or as the path of an existing directory
+func IsLocalRegistry(url string) bool {
+	if strings.HasPrefix(url, "file://") {
+		return true
+	}
+	if url == "" || strings.Contains(url, "://") {
+		return false
+	}
+	info, err := os.Stat(url)
+	return err == nil && info.IsDir()
+}
+
+// GetLocalRegistryPath returns the path of the directory of a local registry
+func GetLocalRegistryPath(url string) string {
+	path := strings.TrimPrefix(url, "file://")
+	if runtime.GOOS == "windows" {
+		// file:///C:/registry
+		path = strings.TrimPrefix(path, "/")
+	}
+	return filepath.FromSlash(path)
+}
+
+// GetLocalRegistryURL returns the file:// URL of the directory of a local registry
+func GetLocalRegistryURL(path string) (string, error) {
+	absPath, err := filepath.Abs(GetLocalRegistryPath(path))
+	if err != nil {
+		return "", err
+	}
+	absPath = filepath.ToSlash(absPath)
+	if !strings.HasPrefix(absPath, "/") {
+		absPath = "/" + absPath
+	}
+	return "file://" + absPath, nil
+}
+
+// ValidateLocalRegistry checks that the directory is laid out like a registry, with an index of its stacks
+func ValidateLocalRegistry(dir string) error {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return errors.Wrapf(err, "unable to access the registry directory %s", dir)
+	}
+	if !info.IsDir() {
+		return errors.Errorf("%s exists but it's not a directory", dir)
+	}
+	_, err = os.Stat(filepath.Join(dir, LocalRegistryIndex))
+	if err != nil {
+		return errors.Errorf("the directory %s is not a devfile registry, %s is missing", dir, LocalRegistryIndex)
+	}
+	return nil
+}
+
 func PrintGitRegistryDeprecationWarning() {
 	log.Deprecate("Git based registries", "Please see https://github.com/openshift/odo/tree/main/docs/public/git-registry-deprecation.adoc")
 }
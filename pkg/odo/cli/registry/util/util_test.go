@@ -101,3 +101,51 @@ func TestIsGitBasedRegistry(t *testing.T) {
 		})
 	}
 }
+
+func TestIsLocalRegistry(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-registry")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+
+	tests := []struct {
+		name        string
+		registryURL string
+		want        bool
+	}{
+		{
+			name:        "Case 1: Returns true for a file:// URL",
+			registryURL: "file:///var/registry",
+			want:        true,
+		},
+		{
+			name:        "Case 2: Returns true for the path of an existing directory",
+			registryURL: dir,
+			want:        true,
+		},
+		{
+			name:        "Case 3: Returns false for an empty URL",
+			registryURL: "",
+			want:        false,
+		},
+		{
+			name:        "Case 4: Returns false for a URL without scheme",
+			registryURL: "registry.devfile.io",
+			want:        false,
+		},
+		{
+			name:        "Case 5: Returns false for a http URL",
+			registryURL: "https://registry.devfile.io",
+			want:        false,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if actual := IsLocalRegistry(tt.registryURL); actual != tt.want {
+				t.Errorf("failed checking if registry is local, got %t want %t", actual, tt.want)
+			}
+		})
+	}
+}
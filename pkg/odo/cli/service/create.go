@@ -18,7 +18,7 @@ import (
 
 const (
 	createRecommendedCommandName = "create"
-	equivalentTemplate           = "{{.CmdFullName}} {{.ServiceType}}" +
+	equivalentTemplate           = "{{.CmdFullName}} {{.FullServiceType}}" +
 		"{{if .ServiceName}} {{.ServiceName}}{{end}}" +
 		" --app {{.Application}}" +
 		" --project {{.Project}}" +
@@ -33,7 +33,13 @@ var (
 
 	createOperatorExample = ktemplates.Examples(`
 	# Create new EtcdCluster service from etcdoperator.v0.9.4 operator.
-	%[1]s etcdoperator.v0.9.4/EtcdCluster`)
+	%[1]s etcdoperator.v0.9.4/EtcdCluster
+
+	# Create new EtcdCluster service from etcdoperator.v0.9.4 operator, setting fields of its spec
+	%[1]s etcdoperator.v0.9.4/EtcdCluster myetcd --param size=3 --param version=3.2.13
+
+	# Create new operator backed service interactively
+	%[1]s`)
 
 	createShortDesc = `Create a new service from Operator Hub or Service Catalog and deploy it on OpenShift.`
 
@@ -44,7 +50,9 @@ Service creation can be performed from a valid component directory (one containi
 
 To create the service from outside a component directory, specify path to a valid component directory using "--context" flag.
 
-When creating a service using Operator Hub, provide a service name along with Operator name.
+When creating a service using Operator Hub, provide a service name along with Operator name. The fields of the spec of the service can be set with --param, using the dotted path of the field (e.g. --param pod.resources.limits.cpu=1); they are validated against the schema of the service.
+
+When no service type is given and Operators are supported by the cluster, the Operator, the kind of service and the values of its fields are asked interactively.
 
 When creating a service using Service Catalog, a --plan must be passed along with the service type. Parameters to configure the service are passed as key=value pairs.
 
@@ -55,6 +63,8 @@ For a full list of service types, use: 'odo catalog list services'`)
 type CreateOptions struct {
 	// parameters hold the user-provided values for service class parameters via flags (populated by cobra)
 	parameters []string
+	// operandParams hold the user-provided values for the fields of the spec of operator backed services via flags (populated by cobra)
+	operandParams []string
 	// Plan is the selected service plan
 	Plan string
 	// ServiceType corresponds to the service class name
@@ -109,6 +119,10 @@ func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string)
 		}
 		o.ParametersMap[kvSlice[0]] = kvSlice[1]
 	}
+	err = parseOperandParams(o.operandParams, o.ParametersMap)
+	if err != nil {
+		return err
+	}
 
 	err = validDevfileDirectory(o.componentContext)
 	if err != nil {
@@ -128,8 +142,12 @@ func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string)
 	// check if interactive mode is requested
 	if len(args) == 0 {
 		o.interactive = true
-		// only Service Catalog backend supports interactive mode for service creation
-		o.Backend = NewServiceCatalogBackend()
+		// prefer Operators over the Service Catalog when the cluster supports them
+		if csvSupported, err := o.KClient.IsCSVSupported(); err == nil && csvSupported {
+			o.Backend = NewOperatorBackend()
+		} else {
+			o.Backend = NewServiceCatalogBackend()
+		}
 	} else {
 		o.Backend = decideBackend(args[0])
 	}
@@ -139,11 +157,6 @@ func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string)
 
 // Validate validates the CreateOptions based on completed values
 func (o *CreateOptions) Validate() (err error) {
-	// if we are in interactive mode, all values are already valid
-	if o.interactive {
-		return nil
-	}
-
 	return o.Backend.ValidateServiceCreate(o)
 }
 
@@ -157,6 +170,11 @@ func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
 	// Information on what to do next; don't do this if "--dry-run" was requested as it gets appended to the file
 	if !o.DryRun {
 		log.Info("Successfully added service to the configuration; do 'odo push' to create service on the cluster")
+		if o.wait {
+			if _, ok := o.Backend.(*OperatorBackend); ok {
+				log.Info("'odo push' will wait for the service to be ready before starting the component")
+			}
+		}
 	}
 
 	equivalent := o.outputNonInteractiveEquivalent()
@@ -166,6 +184,14 @@ func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
 	return
 }
 
+// FullServiceType returns the service type as expected on the command line, including the Custom Resource for operator backed services
+func (o *CreateOptions) FullServiceType() string {
+	if b, ok := o.Backend.(*OperatorBackend); ok && b.CustomResource != "" {
+		return o.ServiceType + "/" + b.CustomResource
+	}
+	return o.ServiceType
+}
+
 // outputNonInteractiveEquivalent outputs the populated options as the equivalent command that would be used in non-interactive mode
 func (o *CreateOptions) outputNonInteractiveEquivalent() string {
 	if o.outputCLI {
@@ -203,7 +229,8 @@ func NewCmdServiceCreate(name, fullName string) *cobra.Command {
 
 	serviceCreateCmd.Flags().StringVar(&o.Plan, "plan", "", "The name of the plan of the service to be created")
 	serviceCreateCmd.Flags().StringArrayVarP(&o.parameters, "parameters", "p", []string{}, "Parameters of the plan where a parameter is expressed as <key>=<value")
-	serviceCreateCmd.Flags().BoolVarP(&o.wait, "wait", "w", false, "Wait until the service is ready")
+	serviceCreateCmd.Flags().StringArrayVar(&o.operandParams, "param", []string{}, "Field of the spec of an operator backed service, expressed as <key.path>=<value> where key.path is the dotted path of the field")
+	serviceCreateCmd.Flags().BoolVarP(&o.wait, "wait", "w", false, "Wait until the service is ready; for operator backed services, 'odo push' waits for the service to be ready before starting the component")
 	genericclioptions.AddContextFlag(serviceCreateCmd, &o.componentContext)
 	completion.RegisterCommandHandler(serviceCreateCmd, completion.ServiceClassCompletionHandler)
 	completion.RegisterCommandFlagHandler(serviceCreateCmd, "plan", completion.ServicePlanCompletionHandler)
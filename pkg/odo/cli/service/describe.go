@@ -0,0 +1,123 @@
+package service
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	applabels "github.com/openshift/odo/pkg/application/labels"
+	cmplabels "github.com/openshift/odo/pkg/component/labels"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/cli/component"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	odoutil "github.com/openshift/odo/pkg/odo/util"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	svc "github.com/openshift/odo/pkg/service"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const describeRecommendedCommandName = "describe"
+
+var (
+	describeExample = ktemplates.Examples(`
+    # Describe the operator backed service named 'EtcdCluster/myetcd'
+    %[1]s EtcdCluster/myetcd`)
+
+	describeLongDesc = ktemplates.LongDesc(`
+Describe an operator backed service deployed in the cluster, including the status conditions reported by the service`)
+)
+
+// DescribeOptions encapsulates the options for the odo service describe command
+type DescribeOptions struct {
+	serviceName string
+	*genericclioptions.Context
+	// Context to use when describing service. This will use app and project values from the context
+	componentContext string
+}
+
+// NewDescribeOptions creates a new DescribeOptions instance
+func NewDescribeOptions() *DescribeOptions {
+	return &DescribeOptions{}
+}
+
+// Complete completes DescribeOptions after they've been created
+func (o *DescribeOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.CreateParameters{
+		Cmd:              cmd,
+		DevfilePath:      component.DevfilePath,
+		ComponentContext: o.componentContext,
+	})
+	if err != nil {
+		return err
+	}
+	o.serviceName = args[0]
+	return
+}
+
+// Validate validates the DescribeOptions based on completed values
+func (o *DescribeOptions) Validate() (err error) {
+	if _, _, err = svc.SplitServiceKindName(o.serviceName); err != nil {
+		return fmt.Errorf("invalid service name %q, describe is supported for operator backed services only; use the format <service-kind>/<service-name>", o.serviceName)
+	}
+	return odoutil.CheckOutputFlag(o.OutputFlag)
+}
+
+// Run contains the logic for the odo service describe command
+func (o *DescribeOptions) Run(cmd *cobra.Command) (err error) {
+	description, err := svc.DescribeOperatorService(o.KClient, o.serviceName)
+	if err != nil {
+		return fmt.Errorf("unable to find service %q in the cluster: %v; do 'odo push' to create the services defined in the devfile", o.serviceName, err)
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(description)
+		return nil
+	}
+
+	managedBy := "No"
+	if description.Labels[applabels.ManagedBy] == "odo" {
+		managedBy = fmt.Sprintf("Yes (%s)", description.Labels[cmplabels.ComponentLabel])
+	}
+
+	log.Describef("Name: ", description.Name)
+	log.Describef("Kind: ", description.Kind)
+	log.Describef("Managed by odo: ", managedBy)
+	log.Describef("Age: ", time.Since(description.CreationTimestamp.Time).Truncate(time.Second).String())
+	log.Describef("Status: ", description.Status.State)
+	if description.Status.Phase != "" {
+		log.Describef("Phase: ", description.Status.Phase)
+	}
+
+	if len(description.Status.Conditions) > 0 {
+		log.Info("\nConditions:")
+		w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+		genericclioptions.PrintTableHeader(w, "TYPE", "\t", "STATUS", "\t", "REASON", "\t", "MESSAGE")
+		for _, condition := range description.Status.Conditions {
+			fmt.Fprintln(w, condition.Type, "\t", condition.Status, "\t", condition.Reason, "\t", condition.Message)
+		}
+		w.Flush()
+	}
+	return nil
+}
+
+// NewCmdServiceDescribe implements the odo service describe command.
+func NewCmdServiceDescribe(name, fullName string) *cobra.Command {
+	o := NewDescribeOptions()
+	serviceDescribeCmd := &cobra.Command{
+		Use:         name + " <service_name>",
+		Short:       "Describe an existing operator backed service",
+		Long:        describeLongDesc,
+		Example:     fmt.Sprintf(describeExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	genericclioptions.AddContextFlag(serviceDescribeCmd, &o.componentContext)
+	completion.RegisterCommandHandler(serviceDescribeCmd, completion.ServiceCompletionHandler)
+	return serviceDescribeCmd
+}
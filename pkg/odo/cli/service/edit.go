@@ -0,0 +1,230 @@
+package service
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"reflect"
+	"runtime"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/component"
+	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	svc "github.com/openshift/odo/pkg/service"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const editRecommendedCommandName = "edit"
+
+// editHeader is written at the top of the file opened in the editor
+const editHeader = `# Please edit the service below. Lines beginning with a '#' will be ignored,
+# and an empty file will abort the edit. The service is validated when the file is saved and closed.
+#
+`
+
+var (
+	editExample = ktemplates.Examples(`
+    # Edit the definition of the operator backed service named 'EtcdCluster/myetcd'
+    %[1]s EtcdCluster/myetcd`)
+
+	editLongDesc = ktemplates.LongDesc(`
+Edit the definition of an operator backed service defined in the devfile.
+
+The definition is opened in the editor defined by the VISUAL or EDITOR environment variables, or 'vi' on Linux and macOS and 'notepad' on Windows.
+Once saved, the definition is validated against the schema of the service and written to the devfile.
+
+The service is updated on the cluster on the next 'odo push'.`)
+)
+
+// EditOptions encapsulates the options for the odo service edit command
+type EditOptions struct {
+	serviceName string
+	*genericclioptions.Context
+	// Context to use when editing service. This will use app and project values from the context
+	componentContext string
+}
+
+// NewEditOptions creates a new EditOptions instance
+func NewEditOptions() *EditOptions {
+	return &EditOptions{}
+}
+
+// Complete completes EditOptions after they've been created
+func (o *EditOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.CreateParameters{
+		Cmd:              cmd,
+		DevfilePath:      component.DevfilePath,
+		ComponentContext: o.componentContext,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = validDevfileDirectory(o.componentContext)
+	if err != nil {
+		return err
+	}
+
+	o.serviceName = args[0]
+	return
+}
+
+// Validate validates the EditOptions based on completed values
+func (o *EditOptions) Validate() (err error) {
+	_, _, err = svc.SplitServiceKindName(o.serviceName)
+	if err != nil {
+		return fmt.Errorf("invalid service name %q, edit is supported for operator backed services only; use the format <service-kind>/<service-name>", o.serviceName)
+	}
+
+	defined, err := isOperatorServiceDefined(o.serviceName, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+	if !defined {
+		return fmt.Errorf("couldn't find service named %q. Refer %q to see list of defined services", o.serviceName, "odo service list")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo service edit command
+func (o *EditOptions) Run(cmd *cobra.Command) (err error) {
+	original, err := getOperatorServiceFromDevfile(o.serviceName, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+	originalYaml, err := yaml.Marshal(original)
+	if err != nil {
+		return err
+	}
+
+	file, err := ioutil.TempFile("", "odo-service-*.yaml")
+	if err != nil {
+		return errors.Wrap(err, "unable to create the file to edit")
+	}
+	defer os.Remove(file.Name()) // #nosec G307
+	err = file.Close()
+	if err != nil {
+		return err
+	}
+
+	content := editHeader + string(originalYaml)
+	for {
+		err = ioutil.WriteFile(file.Name(), []byte(content), 0600)
+		if err != nil {
+			return errors.Wrap(err, "unable to write the file to edit")
+		}
+
+		err = launchEditor(file.Name())
+		if err != nil {
+			return err
+		}
+
+		edited, err := ioutil.ReadFile(file.Name())
+		if err != nil {
+			return errors.Wrap(err, "unable to read the edited file")
+		}
+		editedYaml := stripComments(string(edited))
+		if strings.TrimSpace(editedYaml) == "" {
+			log.Info("Edit cancelled, the definition of the service is empty")
+			return nil
+		}
+
+		var cr map[string]interface{}
+		err = yaml.Unmarshal([]byte(editedYaml), &cr)
+		if err == nil {
+			if reflect.DeepEqual(cr, original) {
+				log.Info("Edit cancelled, no changes made")
+				return nil
+			}
+			err = svc.ValidateOperatorServiceDefinition(o.KClient, o.serviceName, cr)
+		}
+		if err != nil {
+			log.Errorf("The definition of the service is invalid: %v", err)
+			if !ui.Proceed("Do you want to edit the definition again") {
+				return fmt.Errorf("edit of service %q aborted, the devfile has not been changed", o.serviceName)
+			}
+			// keep the changes of the user, and give them the error as a reminder
+			content = editHeader + commentLines("The definition of the service is invalid: "+err.Error()) + "#\n" + editedYaml
+			continue
+		}
+
+		err = writeOperatorServiceToDevfile(o.serviceName, cr, o.EnvSpecificInfo.GetDevfileObj())
+		if err != nil {
+			return errors.Wrap(err, "failed to update the service in the devfile")
+		}
+		log.Infof("Successfully updated service %q in the configuration; do 'odo push' to update the service on the cluster", o.serviceName)
+		return nil
+	}
+}
+
+// launchEditor opens the file in the editor of the user and waits for the editor to be closed
+func launchEditor(path string) error {
+	editor := os.Getenv("VISUAL")
+	if editor == "" {
+		editor = os.Getenv("EDITOR")
+	}
+	if editor == "" {
+		editor = "vi"
+		if runtime.GOOS == "windows" {
+			editor = "notepad"
+		}
+	}
+
+	// the editor can be defined with arguments, e.g. "code --wait"
+	args := strings.Fields(editor)
+	cmd := exec.Command(args[0], append(args[1:], path)...) // #nosec G204
+	cmd.Stdin = os.Stdin
+	cmd.Stdout = os.Stdout
+	cmd.Stderr = os.Stderr
+	err := cmd.Run()
+	if err != nil {
+		return errors.Wrapf(err, "unable to launch the editor %q", editor)
+	}
+	return nil
+}
+
+// stripComments removes the lines beginning with a '#' from the content
+func stripComments(content string) string {
+	var lines []string
+	for _, line := range strings.Split(content, "\n") {
+		if strings.HasPrefix(strings.TrimSpace(line), "#") {
+			continue
+		}
+		lines = append(lines, line)
+	}
+	return strings.Join(lines, "\n")
+}
+
+// commentLines turns each line of the text into a comment
+func commentLines(text string) string {
+	var b strings.Builder
+	for _, line := range strings.Split(text, "\n") {
+		b.WriteString("# " + line + "\n")
+	}
+	return b.String()
+}
+
+// NewCmdServiceEdit implements the odo service edit command.
+func NewCmdServiceEdit(name, fullName string) *cobra.Command {
+	o := NewEditOptions()
+	serviceEditCmd := &cobra.Command{
+		Use:     name + " <service_kind>/<service_name>",
+		Short:   "Edit the definition of an operator backed service defined in the devfile",
+		Long:    editLongDesc,
+		Example: fmt.Sprintf(editExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	genericclioptions.AddContextFlag(serviceEditCmd, &o.componentContext)
+	completion.RegisterCommandHandler(serviceEditCmd, completion.ServiceCompletionHandler)
+	return serviceEditCmd
+}
@@ -12,13 +12,17 @@ import (
 	cmplabels "github.com/openshift/odo/pkg/component/labels"
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	svc "github.com/openshift/odo/pkg/service"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
 )
 
 type clusterInfo struct {
 	Labels            map[string]string
 	CreationTimestamp time.Time
+	Status            svc.OperatorServiceStatus
 }
 
 type serviceItem struct {
@@ -63,7 +67,21 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 		devfileComponent = o.EnvSpecificInfo.GetComponentSettings().Name
 	}
 
-	servicesItems := mixServices(clusterList, devfileList)
+	// the descriptions of the CRs are cached per kind, their status descriptors locating the status of the services
+	crDescriptions := map[string]*olm.CRDDescription{}
+	getCRDescription := func(kind string) *olm.CRDDescription {
+		if cr, found := crDescriptions[kind]; found {
+			return cr
+		}
+		cr, err := svc.GetCRDescription(o.KClient, kind)
+		if err != nil {
+			klog.V(4).Infof("unable to get the description of the services of kind %s: %v", kind, err)
+			cr = nil
+		}
+		crDescriptions[kind] = cr
+		return cr
+	}
+	servicesItems := mixServices(clusterList, devfileList, getCRDescription)
 
 	if len(servicesItems) == 0 {
 		if len(failedListingCR) > 0 {
@@ -76,10 +94,10 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 
 	// output result
 	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-	fmt.Fprintln(w, "NAME", "\t", "MANAGED BY ODO", "\t", "STATE", "\t", "AGE")
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "MANAGED BY ODO", "\t", "STATE", "\t", "STATUS", "\t", "AGE")
 	for _, name := range orderedNames {
 		managedByOdo, state, duration := getTabularInfo(servicesItems[name], devfileComponent)
-		fmt.Fprintln(w, name, "\t", managedByOdo, "\t", state, "\t", duration)
+		fmt.Fprintln(w, name, "\t", managedByOdo, "\t", state, "\t", getServiceStatus(servicesItems[name]), "\t", duration)
 	}
 	w.Flush()
 
@@ -90,17 +108,20 @@ func (o *ServiceListOptions) listOperatorServices() (err error) {
 	return nil
 }
 
-// mixServices returns a structure containing both the services in cluster and defined in devfile
-func mixServices(clusterList []unstructured.Unstructured, devfileList []string) (servicesItems map[string]*serviceItem) {
+// mixServices returns a structure containing both the services in cluster and defined in devfile,
+// the status of the services in cluster being computed with the description of the CR of their kind
+func mixServices(clusterList []unstructured.Unstructured, devfileList []string, getCRDescription func(kind string) *olm.CRDDescription) (servicesItems map[string]*serviceItem) {
 	servicesItems = map[string]*serviceItem{}
 	for _, item := range clusterList {
 		name := strings.Join([]string{item.GetKind(), item.GetName()}, "/")
 		if _, ok := servicesItems[name]; !ok {
 			servicesItems[name] = &serviceItem{}
 		}
+		clusterItem := item
 		servicesItems[name].ClusterInfo = &clusterInfo{
 			Labels:            item.GetLabels(),
 			CreationTimestamp: item.GetCreationTimestamp().Time,
+			Status:            svc.GetOperatorServiceStatus(&clusterItem, getCRDescription(item.GetKind())),
 		}
 	}
 
@@ -172,3 +193,11 @@ func getTabularInfo(serviceItem *serviceItem, devfileComponent string) (managedB
 	}
 	return
 }
+
+// getServiceStatus returns the status reported by a service deployed into cluster, or nothing if it is not deployed
+func getServiceStatus(serviceItem *serviceItem) string {
+	if serviceItem.ClusterInfo == nil {
+		return ""
+	}
+	return serviceItem.ClusterInfo.Status.State
+}
@@ -6,6 +6,9 @@ import (
 	"time"
 
 	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/machineoutput"
+	svc "github.com/openshift/odo/pkg/service"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 )
 
@@ -166,7 +169,11 @@ metadata:
   labels:
     app.kubernetes.io/managed-by: odo
     app.kubernetes.io/instance: component1
-  creationTimestamp: 2021-06-02T08:39:20Z00:00`,
+  creationTimestamp: 2021-06-02T08:39:20Z00:00
+status:
+  conditions:
+  - type: Ready
+    status: "True"`,
 				`
 kind: kind2
 metadata:
@@ -184,6 +191,12 @@ metadata:
 							"app.kubernetes.io/instance":   "component1",
 						},
 						CreationTimestamp: atime,
+						Status: svc.OperatorServiceStatus{
+							State: svc.StateReady,
+							Conditions: []machineoutput.ServiceStatusCondition{
+								{Type: "Ready", Status: "True"},
+							},
+						},
 					},
 					InDevfile: true,
 				},
@@ -194,6 +207,7 @@ metadata:
 							"app.kubernetes.io/instance":   "component2",
 						},
 						CreationTimestamp: atime,
+						Status:            svc.OperatorServiceStatus{State: svc.StateUnknown},
 					},
 					InDevfile: false,
 				},
@@ -213,7 +227,7 @@ metadata:
 					t.Errorf("Fail to unmarshal spec manifest")
 				}
 			}
-			result := mixServices(us, tt.devfileList)
+			result := mixServices(us, tt.devfileList, func(kind string) *olm.CRDDescription { return nil })
 			if !reflect.DeepEqual(result, tt.want) {
 				t.Errorf("Failed %s", t.Name())
 			}
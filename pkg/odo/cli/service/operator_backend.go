@@ -11,19 +11,26 @@ import (
 	"os"
 	"strings"
 
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/kclient"
 	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/service/ui"
+	commonui "github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/util/validation"
 	"github.com/openshift/odo/pkg/service"
 	svc "github.com/openshift/odo/pkg/service"
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/pkg/errors"
 	"github.com/spf13/cobra"
+	"k8s.io/klog"
 )
 
 // This CompleteServiceCreate contains logic to complete the "odo service create" call for the case of Operator backend
 func (b *OperatorBackend) CompleteServiceCreate(o *CreateOptions, cmd *cobra.Command, args []string) (err error) {
-	// since interactive mode is not supported for Operators yet, set it to false
-	o.interactive = false
+	if o.interactive {
+		return b.completeServiceCreateInteractively(o)
+	}
 
 	// if user has just used "odo service create", simply return
 	if o.fromFile == "" && len(args) == 0 {
@@ -52,6 +59,37 @@ func (b *OperatorBackend) CompleteServiceCreate(o *CreateOptions, cmd *cobra.Com
 	return nil
 }
 
+// completeServiceCreateInteractively lets the user select the Operator and the kind of service to create, then prompts
+// for the fields of the spec of the service, based on the schema of its CRD and the spec descriptors of the CSV
+func (b *OperatorBackend) completeServiceCreateInteractively(o *CreateOptions) error {
+	csvs, err := o.KClient.ListClusterServiceVersions()
+	if err != nil {
+		return errors.Wrap(err, "unable to list the Operators installed in the namespace")
+	}
+
+	hasCRs := false
+	for _, csv := range csvs.Items {
+		if len(csv.Spec.CustomResourceDefinitions.Owned) > 0 {
+			hasCRs = true
+			break
+		}
+	}
+	if !hasCRs {
+		return fmt.Errorf("no Operator providing services is installed in the namespace; refer %q for more information", "odo catalog list services")
+	}
+
+	csv, cr := ui.SelectOperatorBackedServiceInteractively(csvs.Items)
+	o.ServiceType = csv.Name
+	b.CustomResource = cr.Kind
+
+	b.loadSchema(o.KClient, &cr)
+	o.ParametersMap = ui.EnterOperandFieldsInteractively(b.schema, svc.GetOperandFields(b.schema, cr.SpecDescriptors))
+	o.ServiceName = ui.EnterServiceNameInteractively(strings.ToLower(cr.Kind), "How should we name your service ", validation.NameValidator)
+	o.outputCLI = commonui.Proceed("Output the non-interactive version of the selected options")
+	o.wait = commonui.Proceed("Wait for the service to be ready")
+	return nil
+}
+
 func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) (err error) {
 	d := svc.NewDynamicCRD()
 	// if the user wants to create service from a file, we check for
@@ -79,6 +117,9 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) (err error) {
 		if err != nil {
 			return err
 		}
+		if hasCR, cr := o.KClient.CheckCustomResourceInCSV(b.CustomResource, &csv); hasCR {
+			b.loadSchema(o.KClient, cr)
+		}
 
 		// all is well, let's populate the fields required for creating operator backed service
 		b.group, b.version, b.resource, err = svc.GetGVRFromOperator(csv, b.CustomResource)
@@ -86,6 +127,11 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) (err error) {
 			return err
 		}
 
+		err = svc.ValidateOperandSpec(b.schema, d.OriginalCRD)
+		if err != nil {
+			return err
+		}
+
 		err = d.ValidateMetadataInCRD()
 		if err != nil {
 			return err
@@ -132,12 +178,17 @@ func (b *OperatorBackend) ValidateServiceCreate(o *CreateOptions) (err error) {
 			o.ServiceName = strings.ToLower(b.CustomResource)
 		}
 
-		if len(o.parameters) != 0 {
+		if o.interactive || len(o.ParametersMap) != 0 {
 			builtCRD, err := b.buildCRDfromParams(o, csv)
 			if err != nil {
 				return err
 			}
 
+			err = svc.ValidateOperandSpec(b.schema, builtCRD)
+			if err != nil {
+				return err
+			}
+
 			d.OriginalCRD = builtCRD
 		} else {
 			almExample, err := svc.GetAlmExample(csv, b.CustomResource, o.ServiceType)
@@ -215,7 +266,13 @@ func (b *OperatorBackend) RunServiceCreate(o *CreateOptions) (err error) {
 			return err
 		}
 
-		err = svc.AddKubernetesComponentToDevfile(string(crdYaml), o.ServiceName, o.EnvSpecificInfo.GetDevfileObj())
+		var componentAttributes attributes.Attributes
+		if o.wait {
+			// the service is created on the cluster by "odo push", which is then expected to wait for it
+			componentAttributes = attributes.Attributes{}.PutBoolean(svc.WaitForReadyAttribute, true)
+		}
+
+		err = svc.AddKubernetesComponentWithAttributesToDevfile(string(crdYaml), o.ServiceName, componentAttributes, o.EnvSpecificInfo.GetDevfileObj())
 		if err != nil {
 			return err
 		}
@@ -260,5 +317,19 @@ func (b *OperatorBackend) buildCRDfromParams(o *CreateOptions, csv olm.ClusterSe
 		return nil, fmt.Errorf("the %q resource doesn't exist in specified %q operator", b.CustomResource, o.ServiceType)
 	}
 
-	return service.BuildCRDFromParams(cr, o.ParametersMap)
+	if b.schema == nil {
+		b.loadSchema(o.KClient, cr)
+	}
+	return service.BuildCRDFromParams(cr, b.schema, o.ParametersMap)
+}
+
+// loadSchema loads the OpenAPI schema of the spec of the CR from its CRD. When the CRD can't be read (e.g. the user isn't
+// allowed to read CRDs at the cluster level), the service is only validated against the spec descriptors of the CSV
+func (b *OperatorBackend) loadSchema(client *kclient.Client, cr *olm.CRDDescription) {
+	schema, err := svc.GetOperandSchema(client, cr)
+	if err != nil {
+		klog.V(2).Infof("Unable to get the schema of %q, it will be validated against the spec descriptors of the Operator only: %v", cr.Kind, err)
+		return
+	}
+	b.schema = schema
 }
@@ -21,30 +21,42 @@ func NewCmdService(name, fullName string) *cobra.Command {
 	serviceCreateCmd := NewCmdServiceCreate(createRecommendedCommandName, util.GetFullName(fullName, createRecommendedCommandName))
 	serviceListCmd := NewCmdServiceList(listRecommendedCommandName, util.GetFullName(fullName, listRecommendedCommandName))
 	serviceDeleteCmd := NewCmdServiceDelete(deleteRecommendedCommandName, util.GetFullName(fullName, deleteRecommendedCommandName))
+	serviceDescribeCmd := NewCmdServiceDescribe(describeRecommendedCommandName, util.GetFullName(fullName, describeRecommendedCommandName))
+	serviceUpdateCmd := NewCmdServiceUpdate(updateRecommendedCommandName, util.GetFullName(fullName, updateRecommendedCommandName))
+	serviceEditCmd := NewCmdServiceEdit(editRecommendedCommandName, util.GetFullName(fullName, editRecommendedCommandName))
 	serviceCmd := &cobra.Command{
 		Use:   name,
 		Short: "Perform service catalog operations",
 		Long:  serviceLongDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			serviceCreateCmd.Example,
 			serviceDeleteCmd.Example,
-			serviceListCmd.Example),
+			serviceDescribeCmd.Example,
+			serviceEditCmd.Example,
+			serviceListCmd.Example,
+			serviceUpdateCmd.Example),
 		Args: cobra.RangeArgs(1, 3),
 	}
 	// Add a defined annotation in order to appear in the help menu
 	serviceCmd.Annotations = map[string]string{"command": "main"}
 	serviceCmd.SetUsageTemplate(util.CmdUsageTemplate)
-	serviceCmd.AddCommand(serviceCreateCmd, serviceDeleteCmd, serviceListCmd)
+	serviceCmd.AddCommand(serviceCreateCmd, serviceDeleteCmd, serviceDescribeCmd, serviceEditCmd, serviceListCmd, serviceUpdateCmd)
 
 	//Adding `--project` flag
 	projectCmd.AddProjectFlag(serviceCreateCmd)
 	projectCmd.AddProjectFlag(serviceDeleteCmd)
+	projectCmd.AddProjectFlag(serviceDescribeCmd)
 	projectCmd.AddProjectFlag(serviceListCmd)
+	projectCmd.AddProjectFlag(serviceEditCmd)
+	projectCmd.AddProjectFlag(serviceUpdateCmd)
 
 	//Adding `--application` flag
 	appCmd.AddApplicationFlag(serviceCreateCmd)
 	appCmd.AddApplicationFlag(serviceDeleteCmd)
+	appCmd.AddApplicationFlag(serviceDescribeCmd)
 	appCmd.AddApplicationFlag(serviceListCmd)
+	appCmd.AddApplicationFlag(serviceEditCmd)
+	appCmd.AddApplicationFlag(serviceUpdateCmd)
 
 	return serviceCmd
 }
@@ -24,9 +24,6 @@ func (b *ServiceCatalogBackend) CompleteServiceCreate(o *CreateOptions, cmd *cob
 		classesByCategory, err := o.Client.GetKubeClient().ListServiceClassesByCategory()
 		if err != nil {
 			// this error indicates that Service Catalog is not properly setup
-			// we inform the user that if they're trying interactive mode for Operators, it's not yet supported.
-			// TODO: remove the warning when interactive mode for Operators is supported
-			log.Warning("odo doesn't support interactive mode for creating Operator backed service yet; refer \"odo service create -h\"")
 			return fmt.Errorf("unable to retrieve service classes: %v", err)
 		}
 
@@ -75,6 +72,15 @@ func (b *ServiceCatalogBackend) CompleteServiceCreate(o *CreateOptions, cmd *cob
 }
 
 func (b *ServiceCatalogBackend) ValidateServiceCreate(o *CreateOptions) (err error) {
+	// if we are in interactive mode, all values are already valid
+	if o.interactive {
+		return nil
+	}
+
+	if len(o.operandParams) > 0 {
+		return fmt.Errorf("--param is supported for operator backed services only; use --parameters to provide the parameters of the plan")
+	}
+
 	// make sure the service type exists
 	classPtr, err := o.Client.GetKubeClient().GetClusterServiceClass(o.ServiceType)
 	if err != nil {
@@ -1,5 +1,7 @@
 package service
 
+import apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+
 //OperatorBackend implements the interface ServiceProviderBackend and contains methods that help create a service from Operators
 type OperatorBackend struct {
 	// Custom Resrouce to create service from
@@ -12,6 +14,8 @@ type OperatorBackend struct {
 	version string
 	// Resource of the GVR
 	resource string
+	// OpenAPI schema of the spec of the Custom Resource, from its CRD; nil when the CRD can't be read
+	schema *apiextensionsv1.JSONSchemaProps
 }
 
 func NewOperatorBackend() *OperatorBackend {
@@ -2,6 +2,7 @@ package ui
 
 import (
 	"encoding/json"
+	"errors"
 	"fmt"
 	"sort"
 	"strings"
@@ -18,6 +19,8 @@ import (
 	"gopkg.in/AlecAivazis/survey.v1/terminal"
 
 	scv1beta1 "github.com/kubernetes-sigs/service-catalog/pkg/apis/servicecatalog/v1beta1"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 // Retrieve the list of existing service class categories
@@ -310,3 +313,153 @@ func propDesc(prop service.ServicePlanParameter) string {
 
 	return prop.Name + msg
 }
+
+// noValueOption is the option to select in order not to set a value for a non-required field with a list of allowed values
+const noValueOption = "<no value>"
+
+// SelectOperatorBackedServiceInteractively lets the user select the Operator, then the kind of service (CR) provided by
+// this Operator. Operators not providing any CR are not proposed
+func SelectOperatorBackedServiceInteractively(csvs []olm.ClusterServiceVersion) (csv olm.ClusterServiceVersion, cr olm.CRDDescription) {
+	csvsByName := make(map[string]olm.ClusterServiceVersion, len(csvs))
+	var csvNames []string
+	for _, item := range csvs {
+		if len(item.Spec.CustomResourceDefinitions.Owned) == 0 {
+			continue
+		}
+		csvsByName[item.Name] = item
+		csvNames = append(csvNames, item.Name)
+	}
+	sort.Strings(csvNames)
+
+	var csvName string
+	prompt := &survey.Select{
+		Message: "Which Operator should provide the service",
+		Options: csvNames,
+	}
+	err := survey.AskOne(prompt, &csvName, survey.Required)
+	ui.HandleError(err)
+	csv = csvsByName[csvName]
+
+	crsByKind := make(map[string]olm.CRDDescription)
+	var kinds []string
+	for _, item := range csv.Spec.CustomResourceDefinitions.Owned {
+		crsByKind[item.Kind] = item
+		kinds = append(kinds, item.Kind)
+	}
+	sort.Strings(kinds)
+
+	// if the Operator provides only one kind of service, we select it
+	if len(kinds) == 1 {
+		klog.V(4).Infof("Service %s was automatically selected since it's the only one provided by the Operator %s", kinds[0], csvName)
+		return csv, crsByKind[kinds[0]]
+	}
+
+	var kind string
+	prompt = &survey.Select{
+		Message: "Which kind of service do you wish to create",
+		Options: kinds,
+	}
+	err = survey.AskOne(prompt, &kind, survey.Required)
+	ui.HandleError(err)
+	return csv, crsByKind[kind]
+}
+
+// EnterOperandFieldsInteractively lets the user enter the values of the fields of the spec of an operator backed service,
+// starting with the required ones. The values are validated against the spec schema, when not nil
+func EnterOperandFieldsInteractively(schema *apiextensionsv1.JSONSchemaProps, fields []service.OperandField) (values map[string]string) {
+	return enterOperandFieldsInteractively(schema, fields)
+}
+
+// enterOperandFieldsInteractively lets user enter the fields interactively using the specified Stdio instance (useful
+// for testing purposes)
+func enterOperandFieldsInteractively(schema *apiextensionsv1.JSONSchemaProps, fields []service.OperandField, stdio ...terminal.Stdio) (values map[string]string) {
+	values = make(map[string]string, len(fields))
+
+	// first deal with required fields
+	var optional []service.OperandField
+	for _, field := range fields {
+		if field.Required {
+			addOperandValueFor(schema, field, values, stdio...)
+		} else {
+			optional = append(optional, field)
+		}
+	}
+
+	if len(optional) > 0 && ui.Proceed("Provide values for non-required fields", stdio...) {
+		for _, field := range optional {
+			addOperandValueFor(schema, field, values, stdio...)
+		}
+	}
+
+	return values
+}
+
+func addOperandValueFor(schema *apiextensionsv1.JSONSchemaProps, field service.OperandField, values map[string]string, stdio ...terminal.Stdio) {
+	message := fmt.Sprintf("Enter a value for field %s:", operandFieldDesc(field))
+	if field.Type != "" {
+		message = fmt.Sprintf("Enter a value for %s field %s:", field.Type, operandFieldDesc(field))
+	}
+
+	var prompt survey.Prompt
+	if len(field.Enum) > 0 {
+		options := field.Enum
+		if !field.Required {
+			options = append([]string{noValueOption}, options...)
+		}
+		selectPrompt := &survey.Select{
+			Message: message,
+			Options: options,
+		}
+		if field.Default != "" {
+			selectPrompt.Default = field.Default
+		}
+		if len(stdio) == 1 {
+			selectPrompt.WithStdio(stdio[0])
+		}
+		prompt = selectPrompt
+	} else {
+		inputPrompt := &survey.Input{
+			Message: message,
+			Default: field.Default,
+		}
+		if len(stdio) == 1 {
+			inputPrompt.WithStdio(stdio[0])
+		}
+		prompt = inputPrompt
+	}
+
+	var result string
+	err := survey.AskOne(prompt, &result, func(ans interface{}) error {
+		value, _ := ans.(string)
+		if value == "" || value == noValueOption {
+			if field.Required {
+				return errors.New("a value is required for this field")
+			}
+			return nil
+		}
+		_, err := service.ConvertOperandValue(schema, field.Path, value)
+		return err
+	})
+	ui.HandleError(err)
+
+	if result != "" && result != noValueOption {
+		values[field.Path] = result
+	}
+}
+
+// operandFieldDesc computes a human-readable description of the specified field
+func operandFieldDesc(field service.OperandField) string {
+	msg := field.DisplayName
+	if len(field.Description) > 0 {
+		if len(msg) > 0 {
+			msg += ": "
+		}
+		msg += field.Description
+	}
+
+	if len(msg) > 0 {
+		msg = " (" + strings.TrimSpace(msg) + ")"
+	}
+
+	return field.Path + msg
+}
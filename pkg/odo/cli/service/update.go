@@ -0,0 +1,145 @@
+package service
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/component"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	svc "github.com/openshift/odo/pkg/service"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	"k8s.io/klog"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const updateRecommendedCommandName = "update"
+
+var (
+	updateExample = ktemplates.Examples(`
+    # Update the size of the operator backed service named 'EtcdCluster/myetcd'
+    %[1]s EtcdCluster/myetcd --param size=5`)
+
+	updateLongDesc = ktemplates.LongDesc(`
+Update the fields of the spec of an operator backed service defined in the devfile.
+
+The fields are set with --param, using the dotted path of the field (e.g. --param pod.resources.limits.cpu=1); they are validated against the schema of the service.
+
+The service is updated on the cluster on the next 'odo push'.`)
+)
+
+// UpdateOptions encapsulates the options for the odo service update command
+type UpdateOptions struct {
+	serviceName string
+	// parameters hold the user-provided values for the fields of the spec of the service via flags (populated by cobra)
+	parameters []string
+	// ParametersMap is populated from the flag-provided values (parameters)
+	ParametersMap map[string]string
+	*genericclioptions.Context
+	// Context to use when updating service. This will use app and project values from the context
+	componentContext string
+}
+
+// NewUpdateOptions creates a new UpdateOptions instance
+func NewUpdateOptions() *UpdateOptions {
+	return &UpdateOptions{}
+}
+
+// Complete completes UpdateOptions after they've been created
+func (o *UpdateOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.CreateParameters{
+		Cmd:              cmd,
+		DevfilePath:      component.DevfilePath,
+		ComponentContext: o.componentContext,
+	})
+	if err != nil {
+		return err
+	}
+
+	err = validDevfileDirectory(o.componentContext)
+	if err != nil {
+		return err
+	}
+
+	o.serviceName = args[0]
+	o.ParametersMap = make(map[string]string)
+	return parseOperandParams(o.parameters, o.ParametersMap)
+}
+
+// Validate validates the UpdateOptions based on completed values
+func (o *UpdateOptions) Validate() (err error) {
+	_, _, err = svc.SplitServiceKindName(o.serviceName)
+	if err != nil {
+		return fmt.Errorf("invalid service name %q, update is supported for operator backed services only; use the format <service-kind>/<service-name>", o.serviceName)
+	}
+
+	if len(o.ParametersMap) == 0 {
+		return fmt.Errorf("no field to update was provided; use --param to set the fields of the service, or %q to edit its definition", "odo service edit")
+	}
+
+	defined, err := isOperatorServiceDefined(o.serviceName, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+	if !defined {
+		return fmt.Errorf("couldn't find service named %q. Refer %q to see list of defined services", o.serviceName, "odo service list")
+	}
+	return nil
+}
+
+// Run contains the logic for the odo service update command
+func (o *UpdateOptions) Run(cmd *cobra.Command) (err error) {
+	cr, err := getOperatorServiceFromDevfile(o.serviceName, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return err
+	}
+
+	kind, _, _ := svc.SplitServiceKindName(o.serviceName)
+	crDescription, err := o.KClient.GetCustomResource(kind)
+	if err != nil {
+		return errors.Wrapf(err, "unable to find the Operator providing the service %q", kind)
+	}
+
+	schema, err := svc.GetOperandSchema(o.KClient, crDescription)
+	if err != nil {
+		klog.V(2).Infof("Unable to get the schema of %q, it will be validated against the spec descriptors of the Operator only: %v", kind, err)
+	}
+
+	updated, err := svc.UpdateCRDFromParams(crDescription, schema, cr, o.ParametersMap)
+	if err != nil {
+		return err
+	}
+
+	err = svc.ValidateOperandSpec(schema, updated)
+	if err != nil {
+		return err
+	}
+
+	err = writeOperatorServiceToDevfile(o.serviceName, updated, o.EnvSpecificInfo.GetDevfileObj())
+	if err != nil {
+		return errors.Wrap(err, "failed to update the service in the devfile")
+	}
+
+	log.Infof("Successfully updated service %q in the configuration; do 'odo push' to update the service on the cluster", o.serviceName)
+	return nil
+}
+
+// NewCmdServiceUpdate implements the odo service update command.
+func NewCmdServiceUpdate(name, fullName string) *cobra.Command {
+	o := NewUpdateOptions()
+	serviceUpdateCmd := &cobra.Command{
+		Use:     name + " <service_kind>/<service_name> --param <key.path>=<value>",
+		Short:   "Update an operator backed service defined in the devfile",
+		Long:    updateLongDesc,
+		Example: fmt.Sprintf(updateExample, fullName),
+		Args:    cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+	serviceUpdateCmd.Flags().StringArrayVar(&o.parameters, "param", []string{}, "Field of the spec of the service, expressed as <key.path>=<value> where key.path is the dotted path of the field")
+	genericclioptions.AddContextFlag(serviceUpdateCmd, &o.componentContext)
+	completion.RegisterCommandHandler(serviceUpdateCmd, completion.ServiceCompletionHandler)
+	return serviceUpdateCmd
+}
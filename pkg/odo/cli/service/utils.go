@@ -3,8 +3,12 @@ package service
 import (
 	"fmt"
 	"path/filepath"
+	"strings"
 
+	"github.com/devfile/library/pkg/devfile/parser"
+	"github.com/ghodss/yaml"
 	svc "github.com/openshift/odo/pkg/service"
+	"github.com/pkg/errors"
 
 	"github.com/openshift/odo/pkg/odo/cli/component"
 	"github.com/openshift/odo/pkg/util"
@@ -34,3 +38,67 @@ func decideBackend(arg string) ServiceProviderBackend {
 		return NewOperatorBackend()
 	}
 }
+
+// isOperatorServiceDefined checks if the operator backed service of the given name, of the form <service-kind>/<service-name>,
+// is defined in the devfile, with the same kind
+func isOperatorServiceDefined(serviceName string, devfileObj parser.DevfileObj) (bool, error) {
+	services, err := svc.ListDevfileServices(devfileObj)
+	if err != nil {
+		return false, err
+	}
+	for _, service := range services {
+		if service == serviceName {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// parseOperandParams converts the --param values, in the key.path=value format, to a map of the values by the paths of the fields
+func parseOperandParams(params []string, parametersMap map[string]string) error {
+	for _, kv := range params {
+		// values can contain "=", only the first one separates the path of the field from its value
+		kvSlice := strings.SplitN(kv, "=", 2)
+		if len(kvSlice) != 2 || kvSlice[0] == "" {
+			return fmt.Errorf("param %q not provided in key.path=value format", kv)
+		}
+		parametersMap[kvSlice[0]] = kvSlice[1]
+	}
+	return nil
+}
+
+// getOperatorServiceFromDevfile returns the definition of the operator backed service of the given name, of the form
+// <service-kind>/<service-name>, from the devfile
+func getOperatorServiceFromDevfile(serviceName string, devfileObj parser.DevfileObj) (map[string]interface{}, error) {
+	_, instanceName, err := svc.SplitServiceKindName(serviceName)
+	if err != nil {
+		return nil, err
+	}
+
+	c, err := svc.GetKubernetesComponentFromDevfile(instanceName, devfileObj)
+	if err != nil {
+		return nil, err
+	}
+
+	var cr map[string]interface{}
+	err = yaml.Unmarshal([]byte(c.Kubernetes.Inlined), &cr)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to read the definition of the service %q from the devfile", serviceName)
+	}
+	return cr, nil
+}
+
+// writeOperatorServiceToDevfile replaces the definition of the operator backed service of the given name, of the form
+// <service-kind>/<service-name>, in the devfile
+func writeOperatorServiceToDevfile(serviceName string, cr map[string]interface{}, devfileObj parser.DevfileObj) error {
+	_, instanceName, err := svc.SplitServiceKindName(serviceName)
+	if err != nil {
+		return err
+	}
+
+	crYaml, err := yaml.Marshal(cr)
+	if err != nil {
+		return err
+	}
+	return svc.UpdateKubernetesComponentInDevfile(string(crYaml), instanceName, devfileObj)
+}
@@ -0,0 +1,190 @@
+package storage
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/openshift/odo/pkg/devfile/adapters"
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/devfile/adapters/kubernetes"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/component"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const cpRecommendedCommandName = "cp"
+
+var (
+	storageCpShortDesc = `Copy files and directories to and from the storage of a component`
+	storageCpLongDesc  = ktemplates.LongDesc(`Copy files and directories to and from the storage of a component
+
+	The path in the storage is given as <storage name>:<path>, the path being relative to the root of the storage.
+	The files are copied with a tar archive streamed to the container of the component mounting the storage,
+	or to a temporary helper pod mounting the storage when the component is not running.
+	The tar command must be available in the container of the component.`)
+	storageCpExample = ktemplates.Examples(`
+	# Copy the local file dump.sql to the directory seed of the storage mydb
+  %[1]s ./dump.sql mydb:seed/
+
+	# Copy the local directory fixtures to the root of the storage mydb
+  %[1]s ./fixtures mydb:
+
+	# Copy the file backup/dump.sql of the storage mydb to the local file dump.sql
+  %[1]s mydb:backup/dump.sql ./dump.sql
+
+	# Copy the whole storage mydb to the local directory mydb-data
+  %[1]s mydb: ./mydb-data
+	`)
+)
+
+// CpOptions encapsulates the options for the odo storage cp command
+type CpOptions struct {
+	componentContext string
+
+	storageName string
+	storagePath string
+	localPath   string
+	toStorage   bool
+
+	*genericclioptions.Context
+}
+
+// NewStorageCpOptions creates a new CpOptions instance
+func NewStorageCpOptions() *CpOptions {
+	return &CpOptions{}
+}
+
+// Complete completes CpOptions after they've been created
+func (o *CpOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = genericclioptions.New(genericclioptions.CreateParameters{
+		Cmd:              cmd,
+		DevfilePath:      component.DevfilePath,
+		ComponentContext: o.componentContext,
+	})
+	if err != nil {
+		return err
+	}
+
+	srcStorage, srcPath, srcIsStorage := parseStoragePath(args[0])
+	destStorage, destPath, destIsStorage := parseStoragePath(args[1])
+	switch {
+	case srcIsStorage && destIsStorage:
+		return errors.New("files can't be copied between two storage, please copy them to a local directory first")
+	case !srcIsStorage && !destIsStorage:
+		return errors.New("one of the paths must be a path of a storage, given as <storage name>:<path>")
+	case destIsStorage:
+		o.toStorage = true
+		o.storageName, o.storagePath, o.localPath = destStorage, destPath, args[0]
+	default:
+		o.storageName, o.storagePath, o.localPath = srcStorage, srcPath, args[1]
+	}
+	return nil
+}
+
+// Validate validates the CpOptions based on completed values
+func (o *CpOptions) Validate() (err error) {
+	if o.Context.EnvSpecificInfo == nil || o.Context.LocalConfigInfo.Exists() {
+		return errors.New("copying files to and from the storage is only supported for devfile components")
+	}
+
+	gotStorage, err := o.LocalConfigProvider.GetStorage(o.storageName)
+	if err != nil {
+		return err
+	}
+	if gotStorage == nil {
+		return fmt.Errorf("the storage %s doesn't exist in the component %s", o.storageName, o.LocalConfigProvider.GetName())
+	}
+
+	if o.toStorage {
+		if _, err = os.Stat(o.localPath); err != nil {
+			return errors.Wrapf(err, "unable to copy %s", o.localPath)
+		}
+	}
+	return nil
+}
+
+// Run contains the logic for the odo storage cp command
+func (o *CpOptions) Run(cmd *cobra.Command) (err error) {
+	kc := kubernetes.KubernetesContext{
+		Namespace: o.KClient.Namespace,
+	}
+	devfileHandler, err := adapters.NewComponentAdapter(o.LocalConfigProvider.GetName(), o.componentContext, o.Application, o.EnvSpecificInfo.GetDevfileObj(), kc)
+	if err != nil {
+		return err
+	}
+
+	mount, err := devfileHandler.MountStorage(o.storageName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if unmountErr := devfileHandler.UnmountStorage(mount); unmountErr != nil {
+			log.Warningf("%v", unmountErr)
+		}
+	}()
+	if mount.Helper {
+		log.Infof("The component %s is not running, a helper container is used to access the storage %s", o.LocalConfigProvider.GetName(), o.storageName)
+	}
+
+	storagePath := fmt.Sprintf("%s:%s", o.storageName, o.storagePath)
+	if o.toStorage {
+		spinner := log.Spinnerf("Copying %s to %s", o.localPath, storagePath)
+		defer spinner.End(false)
+		err = common.CopyToStorage(devfileHandler, mount, o.localPath, o.storagePath)
+		if err != nil {
+			return err
+		}
+		spinner.End(true)
+		return nil
+	}
+
+	spinner := log.Spinnerf("Copying %s to %s", storagePath, o.localPath)
+	defer spinner.End(false)
+	err = common.CopyFromStorage(devfileHandler, mount, o.storagePath, o.localPath)
+	if err != nil {
+		return err
+	}
+	spinner.End(true)
+	return nil
+}
+
+// parseStoragePath returns the storage name and the path of a <storage name>:<path> argument,
+// and false if the argument is a local path
+func parseStoragePath(arg string) (string, string, bool) {
+	i := strings.Index(arg, ":")
+	if i <= 0 {
+		return "", "", false
+	}
+	name := arg[:i]
+	// a Windows path starting with a drive letter or a path containing a separator before the colon is a local path
+	if len(name) == 1 || strings.ContainsAny(name, `/\`) {
+		return "", "", false
+	}
+	return name, arg[i+1:], true
+}
+
+// NewCmdStorageCp implements the odo storage cp command.
+func NewCmdStorageCp(name, fullName string) *cobra.Command {
+	o := NewStorageCpOptions()
+	storageCpCmd := &cobra.Command{
+		Use:     fmt.Sprintf("%s <source> <destination>", name),
+		Short:   storageCpShortDesc,
+		Long:    storageCpLongDesc,
+		Example: fmt.Sprintf(storageCpExample, fullName),
+		Args:    cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	genericclioptions.AddContextFlag(storageCpCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(storageCpCmd, nil)
+	completion.RegisterCommandFlagHandler(storageCpCmd, "context", completion.FileCompletionHandler)
+
+	return storageCpCmd
+}
@@ -0,0 +1,27 @@
+package storage
+
+import "testing"
+
+func Test_parseStoragePath(t *testing.T) {
+	tests := []struct {
+		arg           string
+		wantName      string
+		wantPath      string
+		wantIsStorage bool
+	}{
+		{arg: "mydb:seed/dump.sql", wantName: "mydb", wantPath: "seed/dump.sql", wantIsStorage: true},
+		{arg: "mydb:", wantName: "mydb", wantPath: "", wantIsStorage: true},
+		{arg: "./dump.sql"},
+		{arg: ":dump.sql"},
+		{arg: "./dir:with/colon"},
+		{arg: `C:\Users\dump.sql`},
+	}
+	for _, tt := range tests {
+		t.Run(tt.arg, func(t *testing.T) {
+			name, path, isStorage := parseStoragePath(tt.arg)
+			if name != tt.wantName || path != tt.wantPath || isStorage != tt.wantIsStorage {
+				t.Errorf("parseStoragePath() = %q, %q, %v, want %q, %q, %v", name, path, isStorage, tt.wantName, tt.wantPath, tt.wantIsStorage)
+			}
+		})
+	}
+}
@@ -19,10 +19,19 @@ const createRecommendedCommandName = "create"
 
 var (
 	storageCreateShortDesc = `Create storage and mount to a component`
-	storageCreateLongDesc  = ktemplates.LongDesc(`Create storage and mount to a component`)
-	storageCreateExample   = ktemplates.Examples(`
+	storageCreateLongDesc  = ktemplates.LongDesc(`Create storage and mount to a component
+
+	The storage class, access modes and volume mode of the PVC of the storage can be set for devfile components.
+	They can't be changed once the storage is pushed.`)
+	storageCreateExample = ktemplates.Examples(`
 	# Create storage of size 1Gb to a component
   %[1]s mystorage --path=/opt/app-root/src/storage/ --size=1Gi
+
+	# Create storage shared between pods using the storage class "nfs"
+  %[1]s mystorage --path=/data --size=1Gi --storage-class=nfs --access-mode=ReadWriteMany
+
+	# Create a raw block storage attached to the container as the device /dev/xvda
+  %[1]s mystorage --path=/dev/xvda --size=1Gi --volume-mode=Block
 	`)
 )
 
@@ -30,6 +39,9 @@ type CreateOptions struct {
 	storageName      string
 	storageSize      string
 	storagePath      string
+	storageClass     string
+	accessModes      []string
+	volumeMode       string
 	componentContext string
 
 	container string // container to which this storage belongs
@@ -61,10 +73,13 @@ func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string)
 	}
 
 	o.storage = localConfigProvider.LocalStorage{
-		Name:      o.storageName,
-		Size:      o.storageSize,
-		Path:      o.storagePath,
-		Container: o.container,
+		Name:         o.storageName,
+		Size:         o.storageSize,
+		Path:         o.storagePath,
+		Container:    o.container,
+		StorageClass: o.storageClass,
+		AccessModes:  o.accessModes,
+		VolumeMode:   o.volumeMode,
 	}
 
 	o.Context.LocalConfigProvider.CompleteStorage(&o.storage)
@@ -75,7 +90,17 @@ func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string)
 // Validate validates the CreateOptions based on completed values
 func (o *CreateOptions) Validate() (err error) {
 	// validate the storage
-	return o.LocalConfigProvider.ValidateStorage(o.storage)
+	err = o.LocalConfigProvider.ValidateStorage(o.storage)
+	if err != nil {
+		return err
+	}
+
+	// the storage class is validated against the storage classes of the cluster when it is reachable,
+	// otherwise it is validated by odo push
+	if o.storage.StorageClass != "" && o.Context.KClient != nil {
+		return o.Context.KClient.ValidateStorageClass(o.storage.StorageClass)
+	}
+	return nil
 }
 
 // Run contains the logic for the odo storage create command
@@ -87,6 +112,9 @@ func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
 
 	if log.IsJSON() {
 		storageResultMachineReadable := storage.GetMachineReadableFormat(o.storage.Name, o.storage.Size, o.storage.Path)
+		storageResultMachineReadable.Spec.StorageClass = o.storage.StorageClass
+		storageResultMachineReadable.Spec.AccessModes = o.storage.AccessModes
+		storageResultMachineReadable.Spec.VolumeMode = o.storage.VolumeMode
 		machineoutput.OutputSuccess(storageResultMachineReadable)
 	} else {
 		log.Successf("Added storage %v to %v", o.storageName, o.Context.LocalConfigProvider.GetName())
@@ -114,8 +142,12 @@ func NewCmdStorageCreate(name, fullName string) *cobra.Command {
 	storageCreateCmd.Flags().StringVar(&o.storageSize, "size", "", "Size of storage to add")
 	storageCreateCmd.Flags().StringVar(&o.storagePath, "path", "", "Path to mount the storage on")
 	storageCreateCmd.Flags().StringVar(&o.container, "container", "", "Name of container to attach the storage to in devfile")
+	storageCreateCmd.Flags().StringVar(&o.storageClass, "storage-class", "", "Storage class of the PVC of the storage, the default storage class of the cluster is used if not set")
+	storageCreateCmd.Flags().StringSliceVar(&o.accessModes, "access-mode", nil, "Access mode of the PVC of the storage (ReadWriteOnce, ReadOnlyMany or ReadWriteMany), can be repeated")
+	storageCreateCmd.Flags().StringVar(&o.volumeMode, "volume-mode", "", "Volume mode of the PVC of the storage (Filesystem or Block), Block storage being attached to the containers as a device at the given path")
 
 	genericclioptions.AddContextFlag(storageCreateCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(storageCreateCmd, nil)
 	completion.RegisterCommandFlagHandler(storageCreateCmd, "context", completion.FileCompletionHandler)
 
 	return storageCreateCmd
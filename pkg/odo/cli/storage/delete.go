@@ -115,6 +115,7 @@ func NewCmdStorageDelete(name, fullName string) *cobra.Command {
 	completion.RegisterCommandHandler(storageDeleteCmd, completion.StorageDeleteCompletionHandler)
 
 	genericclioptions.AddContextFlag(storageDeleteCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(storageDeleteCmd, nil)
 	completion.RegisterCommandFlagHandler(storageDeleteCmd, "context", completion.FileCompletionHandler)
 
 	return storageDeleteCmd
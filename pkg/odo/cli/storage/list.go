@@ -3,6 +3,7 @@ package storage
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	"github.com/openshift/odo/pkg/localConfigProvider"
@@ -99,14 +100,24 @@ func printStorage(storageList storage.StorageList, compName string) {
 
 		storageMap := make(map[string]bool)
 
+		pvcSettings := hasPVCSettings(storageList)
+
 		// create headers of mounted storage table
-		fmt.Fprintln(tabWriterMounted, "NAME", "\t", "SIZE", "\t", "PATH", "\t", "STATE")
+		headers := []interface{}{"NAME", "\t", "SIZE", "\t", "PATH", "\t", "STATE"}
+		if pvcSettings {
+			headers = append(headers, "\t", "STORAGE CLASS", "\t", "ACCESS MODES", "\t", "VOLUME MODE")
+		}
+		genericclioptions.PrintTableHeader(tabWriterMounted, headers...)
 		// iterating over all mounted storage and put in the mount storage table
 		for _, mStorage := range storageList.Items {
 			_, ok := storageMap[mStorage.Name]
 			if !ok {
 				storageMap[mStorage.Name] = true
-				fmt.Fprintln(tabWriterMounted, mStorage.Name, "\t", mStorage.Spec.Size, "\t", mStorage.Spec.Path, "\t", mStorage.Status)
+				row := []interface{}{mStorage.Name, "\t", mStorage.Spec.Size, "\t", mStorage.Spec.Path, "\t", mStorage.Status}
+				if pvcSettings {
+					row = append(row, getPVCSettingsColumns(mStorage)...)
+				}
+				fmt.Fprintln(tabWriterMounted, row...)
 			}
 		}
 
@@ -127,11 +138,21 @@ func printStorageWithContainer(storageList storage.StorageList, compName string)
 
 		tabWriterMounted := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
 
+		pvcSettings := hasPVCSettings(storageList)
+
 		// create headers of mounted storage table
-		fmt.Fprintln(tabWriterMounted, "NAME", "\t", "SIZE", "\t", "PATH", "\t", "CONTAINER", "\t", "STATE")
+		headers := []interface{}{"NAME", "\t", "SIZE", "\t", "PATH", "\t", "CONTAINER", "\t", "STATE"}
+		if pvcSettings {
+			headers = append(headers, "\t", "STORAGE CLASS", "\t", "ACCESS MODES", "\t", "VOLUME MODE")
+		}
+		genericclioptions.PrintTableHeader(tabWriterMounted, headers...)
 		// iterating over all mounted storage and put in the mount storage table
 		for _, mStorage := range storageList.Items {
-			fmt.Fprintln(tabWriterMounted, mStorage.Name, "\t", mStorage.Spec.Size, "\t", mStorage.Spec.Path, "\t", mStorage.Spec.ContainerName, "\t", mStorage.Status)
+			row := []interface{}{mStorage.Name, "\t", mStorage.Spec.Size, "\t", mStorage.Spec.Path, "\t", mStorage.Spec.ContainerName, "\t", mStorage.Status}
+			if pvcSettings {
+				row = append(row, getPVCSettingsColumns(mStorage)...)
+			}
+			fmt.Fprintln(tabWriterMounted, row...)
 		}
 
 		// print all mounted storage of the given component
@@ -144,6 +165,29 @@ func printStorageWithContainer(storageList storage.StorageList, compName string)
 	fmt.Println("")
 }
 
+// hasPVCSettings checks whether a storage of the list has a storage class, access modes or volume mode to display
+func hasPVCSettings(storageList storage.StorageList) bool {
+	for _, storageItem := range storageList.Items {
+		if storageItem.Spec.StorageClass != "" || len(storageItem.Spec.AccessModes) > 0 || storageItem.Spec.VolumeMode != "" {
+			return true
+		}
+	}
+	return false
+}
+
+// getPVCSettingsColumns returns the storage class, access modes and volume mode columns of the storage
+func getPVCSettingsColumns(storageItem storage.Storage) []interface{} {
+	return []interface{}{"\t", valueOrNone(storageItem.Spec.StorageClass), "\t", valueOrNone(strings.Join(storageItem.Spec.AccessModes, ",")), "\t", valueOrNone(storageItem.Spec.VolumeMode)}
+}
+
+// valueOrNone returns the value or "<none>" when it is empty
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
 // isContainerDisplay checks whether the container name should be included in the output
 func isContainerDisplay(storageList storage.StorageList, components []localConfigProvider.LocalContainer) bool {
 
@@ -211,6 +255,7 @@ func NewCmdStorageList(name, fullName string) *cobra.Command {
 	}
 
 	genericclioptions.AddContextFlag(storageListCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(storageListCmd, nil)
 	completion.RegisterCommandFlagHandler(storageListCmd, "context", completion.FileCompletionHandler)
 
 	return storageListCmd
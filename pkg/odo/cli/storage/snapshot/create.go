@@ -0,0 +1,133 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/openshift/odo/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const createCommandName = "create"
+
+var (
+	createDesc = ktemplates.LongDesc(`Take a snapshot of a storage of the component
+
+The storage must have been pushed to the cluster. The command waits for the snapshot to be ready to use.`)
+
+	createExample = ktemplates.Examples(`# Take a snapshot of the storage mydb, named after the storage and the current time
+	%[1]s mydb
+
+	# Take a snapshot named mydb-before-migration of the storage mydb with the VolumeSnapshotClass csi-snapclass
+	%[1]s mydb --name mydb-before-migration --snapshot-class csi-snapclass
+	`)
+)
+
+// CreateOptions encapsulates the options for the odo storage snapshot create command
+type CreateOptions struct {
+	storageName   string
+	snapshotName  string
+	snapshotClass string
+
+	componentContext string
+	dockerFlag       bool
+
+	*genericclioptions.Context
+}
+
+// NewCreateOptions creates a new CreateOptions instance
+func NewCreateOptions() *CreateOptions {
+	return &CreateOptions{}
+}
+
+// Complete completes CreateOptions after they've been created
+func (o *CreateOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = newSnapshotContext(cmd, o.componentContext, o.dockerFlag)
+	if err != nil {
+		return err
+	}
+
+	o.storageName = args[0]
+	if o.snapshotName == "" {
+		o.snapshotName = storage.GenerateSnapshotName(o.storageName)
+	}
+	return nil
+}
+
+// Validate validates the CreateOptions based on completed values
+func (o *CreateOptions) Validate() (err error) {
+	if err = validateSnapshotSupport(o.Context, o.dockerFlag); err != nil {
+		return err
+	}
+	if o.dockerFlag && o.snapshotClass != "" {
+		return errors.New("the --snapshot-class flag can't be used with the --docker flag")
+	}
+
+	gotStorage, err := o.LocalConfigProvider.GetStorage(o.storageName)
+	if err != nil {
+		return err
+	}
+	if gotStorage == nil {
+		return fmt.Errorf("the storage %s doesn't exist in the component %s", o.storageName, o.LocalConfigProvider.GetName())
+	}
+	return nil
+}
+
+// Run contains the logic for the odo storage snapshot create command
+func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
+	var snapshot storage.Snapshot
+	if o.dockerFlag {
+		mounter, err := newDockerStorageMounter(o.Context, o.componentContext)
+		if err != nil {
+			return err
+		}
+		snapshot, err = storage.CreateDockerSnapshot(mounter, o.snapshotName, o.storageName, o.LocalConfigProvider.GetName())
+		if err != nil {
+			return err
+		}
+	} else {
+		snapshot, err = storage.CreateSnapshot(o.KClient, o.snapshotName, o.snapshotClass, o.storageName, o.LocalConfigProvider.GetName(), o.LocalConfigProvider.GetApplication())
+		if err != nil {
+			return err
+		}
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(snapshot)
+		return nil
+	}
+	log.Successf("Created snapshot %s of the storage %s", snapshot.Name, o.storageName)
+	return nil
+}
+
+// NewCmdCreate implements the odo storage snapshot create command
+func NewCmdCreate(name, fullName string) *cobra.Command {
+	o := NewCreateOptions()
+	snapshotCreateCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s <storage name>", name),
+		Short:       "Take a snapshot of a storage of the component",
+		Long:        createDesc,
+		Example:     fmt.Sprintf(createExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	snapshotCreateCmd.Flags().StringVar(&o.snapshotName, "name", "", "Name of the snapshot, defaults to the name of the storage followed by the current time")
+	snapshotCreateCmd.Flags().StringVar(&o.snapshotClass, "snapshot-class", "", "VolumeSnapshotClass of the snapshot, defaults to the default VolumeSnapshotClass of the cluster")
+
+	addDockerFlag(snapshotCreateCmd, &o.dockerFlag)
+
+	genericclioptions.AddContextFlag(snapshotCreateCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(snapshotCreateCmd, nil)
+	completion.RegisterCommandFlagHandler(snapshotCreateCmd, "context", completion.FileCompletionHandler)
+
+	return snapshotCreateCmd
+}
@@ -0,0 +1,106 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/openshift/odo/pkg/storage"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const deleteCommandName = "delete"
+
+var (
+	deleteDesc = ktemplates.LongDesc(`Delete a snapshot of a storage of the component`)
+
+	deleteExample = ktemplates.Examples(`# Delete the snapshot mydb-before-migration
+	%[1]s mydb-before-migration
+	`)
+)
+
+// DeleteOptions encapsulates the options for the odo storage snapshot delete command
+type DeleteOptions struct {
+	snapshotName string
+	forceFlag    bool
+
+	componentContext string
+	dockerFlag       bool
+
+	*genericclioptions.Context
+}
+
+// NewDeleteOptions creates a new DeleteOptions instance
+func NewDeleteOptions() *DeleteOptions {
+	return &DeleteOptions{}
+}
+
+// Complete completes DeleteOptions after they've been created
+func (o *DeleteOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = newSnapshotContext(cmd, o.componentContext, o.dockerFlag)
+	if err != nil {
+		return err
+	}
+
+	o.snapshotName = args[0]
+	return nil
+}
+
+// Validate validates the DeleteOptions based on completed values
+func (o *DeleteOptions) Validate() (err error) {
+	return validateSnapshotSupport(o.Context, o.dockerFlag)
+}
+
+// Run contains the logic for the odo storage snapshot delete command
+func (o *DeleteOptions) Run(cmd *cobra.Command) (err error) {
+	deleteMsg := fmt.Sprintf("Are you sure you want to delete the snapshot %s of the component %s", o.snapshotName, o.LocalConfigProvider.GetName())
+	if !log.IsJSON() && !o.forceFlag && !ui.Proceed(deleteMsg) {
+		return fmt.Errorf("aborting deletion of snapshot: %s", o.snapshotName)
+	}
+
+	if o.dockerFlag {
+		err = storage.DeleteDockerSnapshot(o.snapshotName, o.LocalConfigProvider.GetName())
+	} else {
+		err = storage.DeleteSnapshot(o.KClient, o.snapshotName, o.LocalConfigProvider.GetName())
+	}
+	if err != nil {
+		return err
+	}
+
+	successMessage := fmt.Sprintf("Deleted snapshot %s", o.snapshotName)
+	if log.IsJSON() {
+		storage.SnapshotMachineReadableSuccessOutput(o.snapshotName, successMessage)
+		return nil
+	}
+	log.Successf(successMessage)
+	return nil
+}
+
+// NewCmdDelete implements the odo storage snapshot delete command
+func NewCmdDelete(name, fullName string) *cobra.Command {
+	o := NewDeleteOptions()
+	snapshotDeleteCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s <snapshot name>", name),
+		Short:       deleteDesc,
+		Long:        deleteDesc,
+		Example:     fmt.Sprintf(deleteExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	snapshotDeleteCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Delete the snapshot without prompting")
+
+	addDockerFlag(snapshotDeleteCmd, &o.dockerFlag)
+
+	genericclioptions.AddContextFlag(snapshotDeleteCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(snapshotDeleteCmd, nil)
+	completion.RegisterCommandFlagHandler(snapshotDeleteCmd, "context", completion.FileCompletionHandler)
+
+	return snapshotDeleteCmd
+}
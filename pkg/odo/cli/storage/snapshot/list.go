@@ -0,0 +1,139 @@
+package snapshot
+
+import (
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/openshift/odo/pkg/storage"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/duration"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const listCommandName = "list"
+
+var (
+	listDesc = ktemplates.LongDesc(`List the snapshots of the storage of the component`)
+
+	listExample = ktemplates.Examples(`# List the snapshots of all the storage of the component
+	%[1]s
+
+	# List the snapshots of the storage mydb
+	%[1]s mydb
+	`)
+)
+
+// ListOptions encapsulates the options for the odo storage snapshot list command
+type ListOptions struct {
+	storageName string
+
+	componentContext string
+	dockerFlag       bool
+
+	*genericclioptions.Context
+}
+
+// NewListOptions creates a new ListOptions instance
+func NewListOptions() *ListOptions {
+	return &ListOptions{}
+}
+
+// Complete completes ListOptions after they've been created
+func (o *ListOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = newSnapshotContext(cmd, o.componentContext, o.dockerFlag)
+	if err != nil {
+		return err
+	}
+
+	if len(args) > 0 {
+		o.storageName = args[0]
+	}
+	return nil
+}
+
+// Validate validates the ListOptions based on completed values
+func (o *ListOptions) Validate() (err error) {
+	return validateSnapshotSupport(o.Context, o.dockerFlag)
+}
+
+// Run contains the logic for the odo storage snapshot list command
+func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
+	var snapshots storage.SnapshotList
+	if o.dockerFlag {
+		snapshots, err = storage.ListDockerSnapshots(o.storageName, o.LocalConfigProvider.GetName())
+	} else {
+		snapshots, err = storage.ListSnapshots(o.KClient, o.storageName, o.LocalConfigProvider.GetName())
+	}
+	if err != nil {
+		return err
+	}
+
+	if log.IsJSON() {
+		machineoutput.OutputSuccess(snapshots)
+		return nil
+	}
+
+	if len(snapshots.Items) == 0 {
+		log.Infof("The component %s has no snapshots", o.LocalConfigProvider.GetName())
+		return nil
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
+	genericclioptions.PrintTableHeader(w, "NAME", "\t", "STORAGE", "\t", "READY", "\t", "RESTORE SIZE", "\t", "SNAPSHOT CLASS", "\t", "AGE")
+	for _, snapshot := range snapshots.Items {
+		ready := "Yes"
+		if !snapshot.Status.ReadyToUse {
+			ready = "No"
+			if snapshot.Status.Error != "" {
+				ready = "Error"
+			}
+		}
+		fmt.Fprintln(w, snapshot.Name, "\t", snapshot.Spec.Storage, "\t", ready, "\t", valueOrNone(snapshot.Status.RestoreSize), "\t", valueOrNone(snapshot.Spec.SnapshotClass), "\t", duration.HumanDuration(time.Since(snapshot.CreationTimestamp.Time)))
+	}
+	w.Flush()
+
+	for _, snapshot := range snapshots.Items {
+		if snapshot.Status.Error != "" {
+			log.Warningf("The snapshot %s has failed: %s", snapshot.Name, snapshot.Status.Error)
+		}
+	}
+	return nil
+}
+
+// valueOrNone returns the value, or <none> if it is empty
+func valueOrNone(value string) string {
+	if value == "" {
+		return "<none>"
+	}
+	return value
+}
+
+// NewCmdList implements the odo storage snapshot list command
+func NewCmdList(name, fullName string) *cobra.Command {
+	o := NewListOptions()
+	snapshotListCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s [storage name]", name),
+		Short:       listDesc,
+		Long:        listDesc,
+		Example:     fmt.Sprintf(listExample, fullName),
+		Args:        cobra.MaximumNArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	addDockerFlag(snapshotListCmd, &o.dockerFlag)
+
+	genericclioptions.AddContextFlag(snapshotListCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(snapshotListCmd, nil)
+	completion.RegisterCommandFlagHandler(snapshotListCmd, "context", completion.FileCompletionHandler)
+
+	return snapshotListCmd
+}
@@ -0,0 +1,122 @@
+package snapshot
+
+import (
+	"fmt"
+
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/openshift/odo/pkg/storage"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+const restoreCommandName = "restore"
+
+var (
+	restoreDesc = ktemplates.LongDesc(`Restore a storage of the component from one of its snapshots
+
+The PVC of the storage is deleted and created again from the snapshot, the current data of the storage being lost.
+The component is stopped while the PVC is replaced, and started again afterwards.
+
+With the --docker flag, the content of the Docker volume of the storage is replaced by the content of the tar backup.`)
+
+	restoreExample = ktemplates.Examples(`# Restore the storage of the snapshot mydb-before-migration
+	%[1]s mydb-before-migration
+	`)
+)
+
+// RestoreOptions encapsulates the options for the odo storage snapshot restore command
+type RestoreOptions struct {
+	snapshotName string
+	forceFlag    bool
+
+	componentContext string
+	dockerFlag       bool
+
+	*genericclioptions.Context
+}
+
+// NewRestoreOptions creates a new RestoreOptions instance
+func NewRestoreOptions() *RestoreOptions {
+	return &RestoreOptions{}
+}
+
+// Complete completes RestoreOptions after they've been created
+func (o *RestoreOptions) Complete(name string, cmd *cobra.Command, args []string) (err error) {
+	o.Context, err = newSnapshotContext(cmd, o.componentContext, o.dockerFlag)
+	if err != nil {
+		return err
+	}
+
+	o.snapshotName = args[0]
+	return nil
+}
+
+// Validate validates the RestoreOptions based on completed values
+func (o *RestoreOptions) Validate() (err error) {
+	return validateSnapshotSupport(o.Context, o.dockerFlag)
+}
+
+// Run contains the logic for the odo storage snapshot restore command
+func (o *RestoreOptions) Run(cmd *cobra.Command) (err error) {
+	restoreMsg := fmt.Sprintf("Are you sure you want to replace the data of the storage by the snapshot %s?", o.snapshotName)
+	if !o.dockerFlag {
+		restoreMsg += fmt.Sprintf(" The component %s will be restarted", o.LocalConfigProvider.GetName())
+	}
+	if !log.IsJSON() && !o.forceFlag && !ui.Proceed(restoreMsg) {
+		return fmt.Errorf("aborting restoration of snapshot: %s", o.snapshotName)
+	}
+
+	var snapshot storage.Snapshot
+	if o.dockerFlag {
+		mounter, err := newDockerStorageMounter(o.Context, o.componentContext)
+		if err != nil {
+			return err
+		}
+		snapshot, err = storage.RestoreDockerSnapshot(mounter, o.snapshotName, o.LocalConfigProvider.GetName())
+		if err != nil {
+			return err
+		}
+	} else {
+		snapshot, err = storage.RestoreSnapshot(o.KClient, o.snapshotName, o.LocalConfigProvider.GetName(), o.LocalConfigProvider.GetApplication())
+		if err != nil {
+			return err
+		}
+	}
+
+	successMessage := fmt.Sprintf("Restored the storage %s from the snapshot %s", snapshot.Spec.Storage, o.snapshotName)
+	if log.IsJSON() {
+		storage.SnapshotMachineReadableSuccessOutput(o.snapshotName, successMessage)
+		return nil
+	}
+	log.Successf(successMessage)
+	return nil
+}
+
+// NewCmdRestore implements the odo storage snapshot restore command
+func NewCmdRestore(name, fullName string) *cobra.Command {
+	o := NewRestoreOptions()
+	snapshotRestoreCmd := &cobra.Command{
+		Use:         fmt.Sprintf("%s <snapshot name>", name),
+		Short:       "Restore a storage of the component from one of its snapshots",
+		Long:        restoreDesc,
+		Example:     fmt.Sprintf(restoreExample, fullName),
+		Args:        cobra.ExactArgs(1),
+		Annotations: map[string]string{"machineoutput": "json"},
+		Run: func(cmd *cobra.Command, args []string) {
+			genericclioptions.GenericRun(o, cmd, args)
+		},
+	}
+
+	snapshotRestoreCmd.Flags().BoolVarP(&o.forceFlag, "force", "f", false, "Restore the snapshot without prompting")
+
+	addDockerFlag(snapshotRestoreCmd, &o.dockerFlag)
+
+	genericclioptions.AddContextFlag(snapshotRestoreCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(snapshotRestoreCmd, nil)
+	completion.RegisterCommandFlagHandler(snapshotRestoreCmd, "context", completion.FileCompletionHandler)
+
+	return snapshotRestoreCmd
+}
@@ -0,0 +1,118 @@
+package snapshot
+
+import (
+	"fmt"
+	"path/filepath"
+
+	"github.com/openshift/odo/pkg/devfile"
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/devfile/adapters/docker"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/lclient"
+	"github.com/openshift/odo/pkg/odo/cli/component"
+	"github.com/openshift/odo/pkg/odo/genericclioptions"
+	"github.com/openshift/odo/pkg/odo/util"
+	"github.com/openshift/odo/pkg/storage"
+	"github.com/pkg/errors"
+	"github.com/spf13/cobra"
+	ktemplates "k8s.io/kubectl/pkg/util/templates"
+)
+
+// RecommendedCommandName is the recommended snapshot command name
+const RecommendedCommandName = "snapshot"
+
+// dockerFlagName is the name of the flag using the local tar backups of the Docker volumes as snapshots
+const dockerFlagName = "docker"
+
+var snapshotDesc = ktemplates.LongDesc(`Create, list, restore and delete the snapshots of the storage of a component
+
+The snapshots are VolumeSnapshot resources of the PVCs of the storage, taken by the CSI snapshot controller.
+The CSI snapshot CRDs and the snapshot controller must be installed on the cluster, and the storage class of the
+storage must be provided by a CSI driver supporting snapshots.
+
+With the --docker flag, the snapshots are tar backups of the Docker volumes of the storage, kept in the
+directory .odo/snapshots of the home directory of the user.`)
+
+// NewCmdSnapshot implements the odo storage snapshot command
+func NewCmdSnapshot(name, fullName string) *cobra.Command {
+	snapshotCreateCmd := NewCmdCreate(createCommandName, util.GetFullName(fullName, createCommandName))
+	snapshotListCmd := NewCmdList(listCommandName, util.GetFullName(fullName, listCommandName))
+	snapshotRestoreCmd := NewCmdRestore(restoreCommandName, util.GetFullName(fullName, restoreCommandName))
+	snapshotDeleteCmd := NewCmdDelete(deleteCommandName, util.GetFullName(fullName, deleteCommandName))
+
+	snapshotCmd := &cobra.Command{
+		Use:   name,
+		Short: "Create, list, restore and delete the snapshots of the storage of a component",
+		Long:  snapshotDesc,
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s",
+			snapshotCreateCmd.Example,
+			snapshotListCmd.Example,
+			snapshotRestoreCmd.Example,
+			snapshotDeleteCmd.Example),
+	}
+
+	snapshotCmd.AddCommand(snapshotCreateCmd, snapshotListCmd, snapshotRestoreCmd, snapshotDeleteCmd)
+	snapshotCmd.SetUsageTemplate(util.CmdUsageTemplate)
+
+	return snapshotCmd
+}
+
+// newSnapshotContext returns the context of the snapshot commands,
+// without any cluster calls for the snapshots of the Docker volumes
+func newSnapshotContext(cmd *cobra.Command, componentContext string, docker bool) (*genericclioptions.Context, error) {
+	if !docker {
+		return genericclioptions.New(genericclioptions.CreateParameters{
+			Cmd:              cmd,
+			DevfilePath:      component.DevfilePath,
+			ComponentContext: componentContext,
+		})
+	}
+
+	context := genericclioptions.NewOfflineDevfileContext(cmd)
+	devfilePath := filepath.Join(componentContext, component.DevfilePath)
+	devObj, err := devfile.ParseFromFile(devfilePath)
+	if err != nil {
+		return nil, errors.Wrapf(err, "failed to parse the devfile %s", devfilePath)
+	}
+	context.EnvSpecificInfo.SetDevfileObj(devObj)
+	context.LocalConfigProvider = context.EnvSpecificInfo
+	return context, nil
+}
+
+// newDockerStorageMounter returns the Docker adapter of the component, mounting the Docker volumes of its storage
+func newDockerStorageMounter(context *genericclioptions.Context, componentContext string) (storage.StorageMounter, error) {
+	client, err := lclient.New()
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to connect to the Docker daemon")
+	}
+	return docker.New(common.AdapterContext{
+		ComponentName: context.LocalConfigProvider.GetName(),
+		Context:       componentContext,
+		AppName:       context.LocalConfigProvider.GetApplication(),
+		Devfile:       context.EnvSpecificInfo.GetDevfileObj(),
+	}, *client), nil
+}
+
+// addDockerFlag adds the --docker flag to the snapshot command
+func addDockerFlag(cmd *cobra.Command, setValueTo *bool) {
+	cmd.Flags().BoolVar(setValueTo, dockerFlagName, false, "Use the tar backups of the Docker volumes of the storage instead of VolumeSnapshots")
+}
+
+// validateSnapshotSupport returns an error if the component is not a devfile component
+// or if the CSI snapshot CRDs are not installed on the cluster
+func validateSnapshotSupport(context *genericclioptions.Context, docker bool) error {
+	if context.EnvSpecificInfo == nil || context.LocalConfigInfo.Exists() || (!docker && context.KClient == nil) {
+		return errors.New("the snapshots of the storage are only supported for devfile components")
+	}
+	if docker {
+		return nil
+	}
+	supported, err := context.KClient.IsVolumeSnapshotSupported()
+	if err != nil {
+		return err
+	}
+	if !supported {
+		return kclient.ErrVolumeSnapshotNotSupported
+	}
+	return nil
+}
@@ -4,6 +4,7 @@ import (
 	"fmt"
 
 	"github.com/openshift/odo/pkg/occlient"
+	"github.com/openshift/odo/pkg/odo/cli/storage/snapshot"
 	odoutil "github.com/openshift/odo/pkg/odo/util"
 	"github.com/openshift/odo/pkg/storage"
 	"github.com/pkg/errors"
@@ -24,20 +25,26 @@ func NewCmdStorage(name, fullName string) *cobra.Command {
 	storageCreateCmd := NewCmdStorageCreate(createRecommendedCommandName, odoutil.GetFullName(fullName, createRecommendedCommandName))
 	storageDeleteCmd := NewCmdStorageDelete(deleteRecommendedCommandName, odoutil.GetFullName(fullName, deleteRecommendedCommandName))
 	storageListCmd := NewCmdStorageList(listRecommendedCommandName, odoutil.GetFullName(fullName, listRecommendedCommandName))
+	storageCpCmd := NewCmdStorageCp(cpRecommendedCommandName, odoutil.GetFullName(fullName, cpRecommendedCommandName))
+	storageSnapshotCmd := snapshot.NewCmdSnapshot(snapshot.RecommendedCommandName, odoutil.GetFullName(fullName, snapshot.RecommendedCommandName))
 
 	var storageCmd = &cobra.Command{
 		Use:   name,
 		Short: storageShortDesc,
 		Long:  storageLongDesc,
-		Example: fmt.Sprintf("%s\n\n%s\n\n%s",
+		Example: fmt.Sprintf("%s\n\n%s\n\n%s\n\n%s\n\n%s",
 			storageCreateCmd.Example,
 			storageDeleteCmd.Example,
-			storageListCmd.Example),
+			storageListCmd.Example,
+			storageCpCmd.Example,
+			storageSnapshotCmd.Example),
 	}
 
 	storageCmd.AddCommand(storageCreateCmd)
 	storageCmd.AddCommand(storageDeleteCmd)
 	storageCmd.AddCommand(storageListCmd)
+	storageCmd.AddCommand(storageCpCmd)
+	storageCmd.AddCommand(storageSnapshotCmd)
 
 	// Add a defined annotation in order to appear in the help menu
 	storageCmd.Annotations = map[string]string{"command": "main"}
@@ -2,6 +2,7 @@ package url
 
 import (
 	"fmt"
+	"path/filepath"
 	"strings"
 
 	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
@@ -10,6 +11,8 @@ import (
 	clicomponent "github.com/openshift/odo/pkg/odo/cli/component"
 	"github.com/openshift/odo/pkg/odo/genericclioptions"
 	"github.com/openshift/odo/pkg/odo/util/completion"
+	"github.com/openshift/odo/pkg/preference"
+	"github.com/openshift/odo/pkg/url"
 	"github.com/pkg/errors"
 
 	"github.com/openshift/odo/pkg/util"
@@ -24,6 +27,9 @@ var (
 	urlCreateShortDesc = `Create a URL for a component`
 	urlCreateLongDesc  = ktemplates.LongDesc(`Create a URL for a component.
 	The created URL can be used to access the specified component from outside the cluster.
+
+	When no host is given for a URL of ingress kind, the host is the IngressDomain preference, the domain of the
+	OpenShift ingress configuration or, for local clusters, a nip.io host resolving to the address of the cluster.
 	`)
 	urlCreateExample = ktemplates.Examples(`  # Create a URL with a specific name by automatically detecting the port used by the component
 	%[1]s example
@@ -50,6 +56,18 @@ var (
 
 	# Create a URL under a specific container
 	%[1]s --port 8080 --container runtime
+
+	# Create a URL of ingress kind served by the nginx ingress class with an annotation, the host being detected
+	%[1]s --port 8080 --ingress --ingress-class nginx --annotation nginx.ingress.kubernetes.io/rewrite-target=/
+
+	# Create a secure URL with a certificate requested from the letsencrypt cert-manager ClusterIssuer
+	%[1]s --port 8080 --ingress --secure --cluster-issuer letsencrypt
+
+	# Create a secure URL with the certificate and key of local files
+	%[1]s --port 8080 --ingress --secure --tls-cert tls.crt --tls-key tls.key
+
+	# Create a secure URL reusing the wildcard TLS secret of the certs namespace
+	%[1]s --port 8080 --ingress --secure --tls-secret wildcard --tls-secret-namespace certs
 	  `)
 )
 
@@ -66,7 +84,19 @@ type CreateOptions struct {
 	protocol    string // protocol of the URL
 	container   string // container to which the URL belongs
 	wantIngress bool
-	url         localConfigProvider.LocalURL
+	// ingressClass is the class of the ingress of the URL
+	ingressClass string
+	// annotations are the key=value annotations of the ingress of the URL
+	annotations []string
+	// tlsSecretNamespace is the namespace the TLS secret is copied from
+	tlsSecretNamespace string
+	// tlsCertFile and tlsKeyFile are the files the TLS secret is created from
+	tlsCertFile string
+	tlsKeyFile  string
+	// issuer and clusterIssuer are the cert-manager issuers the certificate is requested from
+	issuer        string
+	clusterIssuer string
+	url           localConfigProvider.LocalURL
 }
 
 // NewURLCreateOptions creates a new CreateOptions instance
@@ -98,17 +128,60 @@ func (o *CreateOptions) Complete(_ string, cmd *cobra.Command, args []string) (e
 		o.urlName = args[0]
 	}
 
+	annotations, err := parseAnnotations(o.annotations)
+	if err != nil {
+		return err
+	}
+
+	issuer, issuerKind := o.issuer, ""
+	switch {
+	case o.issuer != "" && o.clusterIssuer != "":
+		return errors.New("only one of --issuer and --cluster-issuer can be used")
+	case o.issuer != "":
+		issuerKind = localConfigProvider.IssuerKind
+	case o.clusterIssuer != "":
+		issuer, issuerKind = o.clusterIssuer, localConfigProvider.ClusterIssuerKind
+	}
+
+	// the files are stored relative to the context of the component and resolved when the URL is pushed,
+	// for the configuration to remain valid when the component is moved or exported
+	contextDir, err := filepath.Abs(o.GetComponentContext())
+	if err != nil {
+		return err
+	}
+	for _, file := range []*string{&o.tlsCertFile, &o.tlsKeyFile} {
+		if *file == "" {
+			continue
+		}
+		path, err := filepath.Abs(*file)
+		if err != nil {
+			return err
+		}
+		if rel, err := filepath.Rel(contextDir, path); err == nil {
+			path = filepath.ToSlash(rel)
+		}
+		*file = path
+	}
+
 	// create the localURL
 	o.url = localConfigProvider.LocalURL{
-		Name:      o.urlName,
-		Port:      o.urlPort,
-		Secure:    o.secureURL,
-		Host:      o.host,
-		TLSSecret: o.tlsSecret,
-		Kind:      urlType,
-		Container: o.container,
-		Protocol:  o.protocol,
-		Path:      o.path,
+		Name:         o.urlName,
+		Port:         o.urlPort,
+		Secure:       o.secureURL,
+		Host:         o.host,
+		TLSSecret:    o.tlsSecret,
+		Kind:         urlType,
+		Container:    o.container,
+		Protocol:     o.protocol,
+		Path:         o.path,
+		IngressClass: o.ingressClass,
+		Annotations:  annotations,
+
+		TLSSecretNamespace: o.tlsSecretNamespace,
+		TLSCertFile:        o.tlsCertFile,
+		TLSKeyFile:         o.tlsKeyFile,
+		Issuer:             issuer,
+		IssuerKind:         issuerKind,
 	}
 
 	// complete the URL
@@ -117,6 +190,22 @@ func (o *CreateOptions) Complete(_ string, cmd *cobra.Command, args []string) (e
 		return err
 	}
 
+	// detect the host of the ingress URLs created without a host
+	if o.url.Kind == localConfigProvider.INGRESS && o.url.Host == "" {
+		cfg, err := preference.New()
+		if err != nil {
+			return err
+		}
+		host, source, err := url.GetDefaultIngressHost(o.Context.KClient, cfg.GetIngressDomain())
+		if err != nil {
+			return err
+		}
+		if host != "" {
+			o.url.Host = host
+			log.Infof("Using the host %s detected from %s", host, source)
+		}
+	}
+
 	if o.now {
 		prjName := o.Context.LocalConfigProvider.GetNamespace()
 		o.ResolveSrcAndConfigFlags()
@@ -194,6 +283,22 @@ func (o *CreateOptions) Run(cmd *cobra.Command) (err error) {
 	return
 }
 
+// parseAnnotations parses the key=value annotations of the ingress
+func parseAnnotations(values []string) (map[string]string, error) {
+	if len(values) == 0 {
+		return nil, nil
+	}
+	annotations := make(map[string]string, len(values))
+	for _, value := range values {
+		parts := strings.SplitN(value, "=", 2)
+		if len(parts) != 2 || parts[0] == "" {
+			return nil, errors.Errorf("invalid annotation %q, please use the key=value format", value)
+		}
+		annotations[parts[0]] = parts[1]
+	}
+	return annotations, nil
+}
+
 // NewCmdURLCreate implements the odo url create command.
 func NewCmdURLCreate(name, fullName string) *cobra.Command {
 	o := NewURLCreateOptions()
@@ -210,16 +315,24 @@ func NewCmdURLCreate(name, fullName string) *cobra.Command {
 	urlCreateCmd.Flags().IntVarP(&o.urlPort, "port", "", -1, "Port number for the url of the component, required in case of components which expose more than one service port")
 
 	urlCreateCmd.Flags().StringVar(&o.tlsSecret, "tls-secret", "", "TLS secret name for the url of the component if the user bring their own TLS secret")
+	urlCreateCmd.Flags().StringVar(&o.tlsSecretNamespace, "tls-secret-namespace", "", "Namespace of the TLS secret given with --tls-secret, the secret being copied in the namespace of the component")
+	urlCreateCmd.Flags().StringVar(&o.tlsCertFile, "tls-cert", "", "Certificate file the TLS secret of the url is created from, used with --tls-key")
+	urlCreateCmd.Flags().StringVar(&o.tlsKeyFile, "tls-key", "", "Private key file the TLS secret of the url is created from, used with --tls-cert")
+	urlCreateCmd.Flags().StringVar(&o.issuer, "issuer", "", "cert-manager Issuer the certificate of the secure url is requested from")
+	urlCreateCmd.Flags().StringVar(&o.clusterIssuer, "cluster-issuer", "", "cert-manager ClusterIssuer the certificate of the secure url is requested from")
 	urlCreateCmd.Flags().StringVarP(&o.host, "host", "", "", "Cluster IP for this URL")
 	urlCreateCmd.Flags().BoolVar(&o.wantIngress, "ingress", false, "Create an Ingress instead of Route on OpenShift clusters")
 	urlCreateCmd.Flags().BoolVarP(&o.secureURL, "secure", "", false, "Create a secure HTTPS URL")
 	urlCreateCmd.Flags().StringVarP(&o.path, "path", "", "", "path for this URL")
 	urlCreateCmd.Flags().StringVarP(&o.protocol, "protocol", "", string(devfilev1.HTTPEndpointProtocol), "protocol for this URL")
 	urlCreateCmd.Flags().StringVarP(&o.container, "container", "", "", "container of the endpoint in devfile")
+	urlCreateCmd.Flags().StringVar(&o.ingressClass, "ingress-class", "", "Ingress class of the URL of ingress kind")
+	urlCreateCmd.Flags().StringArrayVar(&o.annotations, "annotation", []string{}, "Annotation key=value of the ingress of the URL, can be specified multiple times")
 	urlCreateCmd.Example = fmt.Sprintf(urlCreateExampleExperimental, fullName)
 
 	genericclioptions.AddNowFlag(urlCreateCmd, &o.now)
 	o.AddContextFlag(urlCreateCmd)
+	genericclioptions.AddEnvFlag(urlCreateCmd, nil)
 	completion.RegisterCommandFlagHandler(urlCreateCmd, "context", completion.FileCompletionHandler)
 
 	return urlCreateCmd
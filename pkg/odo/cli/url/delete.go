@@ -132,6 +132,7 @@ func NewCmdURLDelete(name, fullName string) *cobra.Command {
 	urlDeleteCmd.Flags().BoolVarP(&o.urlForceDeleteFlag, "force", "f", false, "Delete url without prompting")
 
 	o.AddContextFlag(urlDeleteCmd)
+	genericclioptions.AddEnvFlag(urlDeleteCmd, nil)
 	genericclioptions.AddNowFlag(urlDeleteCmd, &o.now)
 	completion.RegisterCommandHandler(urlDeleteCmd, completion.URLCompletionHandler)
 	completion.RegisterCommandFlagHandler(urlDeleteCmd, "context", completion.FileCompletionHandler)
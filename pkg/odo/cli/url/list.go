@@ -3,6 +3,7 @@ package url
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	clicomponent "github.com/openshift/odo/pkg/odo/cli/component"
@@ -85,8 +86,21 @@ func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
 		}
 
 		log.Infof("Found the following URLs for component %v", componentName)
+		// the certificate column is only displayed when the certificate of a secure URL is known
+		showCertificate := false
+		for _, u := range urls.Items {
+			if u.Status.Certificate != nil {
+				showCertificate = true
+				break
+			}
+		}
+
 		tabWriterURL := tabwriter.NewWriter(os.Stdout, 5, 2, 3, ' ', tabwriter.TabIndent)
-		fmt.Fprintln(tabWriterURL, "NAME", "\t", "STATE", "\t", "URL", "\t", "PORT", "\t", "SECURE", "\t", "KIND")
+		if showCertificate {
+			genericclioptions.PrintTableHeader(tabWriterURL, "NAME", "\t", "STATE", "\t", "URL", "\t", "PORT", "\t", "SECURE", "\t", "KIND", "\t", "CERTIFICATE")
+		} else {
+			genericclioptions.PrintTableHeader(tabWriterURL, "NAME", "\t", "STATE", "\t", "URL", "\t", "PORT", "\t", "SECURE", "\t", "KIND")
+		}
 
 		// are there changes between local and cluster states?
 		outOfSync := false
@@ -98,10 +112,18 @@ func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
 				} else {
 					urlStr = url.GetURLString(u.Spec.Protocol, u.Spec.Host, "", o.Context.LocalConfigInfo.Exists())
 				}
-				fmt.Fprintln(tabWriterURL, u.Name, "\t", u.Status.State, "\t", urlStr, "\t", u.Spec.Port, "\t", u.Spec.Secure, "\t", u.Spec.Kind)
+				fmt.Fprint(tabWriterURL, u.Name, "\t", u.Status.State, "\t", urlStr, "\t", u.Spec.Port, "\t", u.Spec.Secure, "\t", u.Spec.Kind)
 			} else {
-				fmt.Fprintln(tabWriterURL, u.Name, "\t", u.Status.State, "\t", url.GetURLString(u.Spec.Protocol, "", u.Spec.Host, false), "\t", u.Spec.Port, "\t", u.Spec.Secure, "\t", u.Spec.Kind)
+				fmt.Fprint(tabWriterURL, u.Name, "\t", u.Status.State, "\t", url.GetURLString(u.Spec.Protocol, "", u.Spec.Host, false), "\t", u.Spec.Port, "\t", u.Spec.Secure, "\t", u.Spec.Kind)
 			}
+			if showCertificate {
+				certificate := ""
+				if u.Status.Certificate != nil {
+					certificate = u.Status.Certificate.String()
+				}
+				fmt.Fprint(tabWriterURL, "\t", certificate)
+			}
+			fmt.Fprintln(tabWriterURL)
 			if u.Status.State != url.StateTypePushed {
 				outOfSync = true
 			}
@@ -110,6 +132,15 @@ func (o *ListOptions) Run(cmd *cobra.Command) (err error) {
 		if outOfSync {
 			log.Info("There are local changes. Please run 'odo push'.")
 		}
+		for _, u := range urls.Items {
+			if u.Status.Certificate != nil && u.Status.Certificate.State != url.CertificateStateValid {
+				message := fmt.Sprintf("The certificate of the URL %s is %s", u.Name, strings.ToLower(string(u.Status.Certificate.State)))
+				if u.Status.Certificate.Message != "" {
+					message = fmt.Sprintf("%s: %s", message, u.Status.Certificate.Message)
+				}
+				log.Warning(message)
+			}
+		}
 	}
 
 	return
@@ -130,6 +161,7 @@ func NewCmdURLList(name, fullName string) *cobra.Command {
 		},
 	}
 	genericclioptions.AddContextFlag(urlListCmd, &o.componentContext)
+	genericclioptions.AddEnvFlag(urlListCmd, nil)
 	completion.RegisterCommandFlagHandler(urlListCmd, "context", completion.FileCompletionHandler)
 
 	return urlListCmd
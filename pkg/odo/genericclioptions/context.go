@@ -138,7 +138,11 @@ func (o *Context) InitConfigFromContext() error {
 
 //InitEnvInfoFromContext initializes envinfo from the context
 func (o *Context) InitEnvInfoFromContext() (err error) {
-	o.EnvSpecificInfo, err = envinfo.NewEnvSpecificInfo(o.ComponentContext)
+	var environment string
+	if o.command != nil {
+		environment = FlagValueIfSet(o.command, EnvFlagName)
+	}
+	o.EnvSpecificInfo, err = envinfo.NewEnvSpecificInfoForEnvironment(o.ComponentContext, environment)
 	if err != nil {
 		return err
 	}
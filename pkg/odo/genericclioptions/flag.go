@@ -17,6 +17,8 @@ const (
 	OutputFlagName = "output"
 	// ContextFlagName is the name of the flag allowing a user to specify the location of the component settings
 	ContextFlagName = "context"
+	// EnvFlagName is the name of the flag allowing a user to specify which environment of the component to use
+	EnvFlagName = "env"
 )
 
 // FlagValueIfSet retrieves the value of the specified flag if it is set for the given command
@@ -60,3 +62,13 @@ func AddNowFlag(cmd *cobra.Command, setValueTo *bool) {
 		cmd.Flags().Bool("now", false, helpMessage)
 	}
 }
+
+// AddEnvFlag adds `env` flag to given cobra command
+func AddEnvFlag(cmd *cobra.Command, setValueTo *string) {
+	helpMessage := "Environment of the component to use, the current environment is used if not specified"
+	if setValueTo != nil {
+		cmd.Flags().StringVar(setValueTo, EnvFlagName, "", helpMessage)
+	} else {
+		cmd.Flags().String(EnvFlagName, "", helpMessage)
+	}
+}
@@ -19,8 +19,8 @@ func getValidEnvInfo(command *cobra.Command) (*envinfo.EnvSpecificInfo, error) {
 
 	componentContext := GetContextFlagValue(command)
 
-	// Access the env file
-	envInfo, err := envinfo.NewEnvSpecificInfo(componentContext)
+	// Access the environment of the env file
+	envInfo, err := envinfo.NewEnvSpecificInfoForEnvironment(componentContext, FlagValueIfSet(command, EnvFlagName))
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,203 @@
+package genericclioptions
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"strings"
+	"text/template"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+	"k8s.io/client-go/util/jsonpath"
+)
+
+const (
+	// JSONOutputFormat is the -o value printing the output in json
+	JSONOutputFormat = "json"
+	// YAMLOutputFormat is the -o value printing the output in yaml
+	YAMLOutputFormat = "yaml"
+	// NameOutputFormat is the -o value printing only the names of the resources of the output
+	NameOutputFormat = "name"
+	// JSONPathOutputFormat is the prefix of the -o value printing the output with a jsonpath template
+	JSONPathOutputFormat = "jsonpath="
+	// GoTemplateOutputFormat is the prefix of the -o value printing the output with a go template
+	GoTemplateOutputFormat = "go-template="
+
+	// NoHeadersFlagName is the name of the flag hiding the headers of the tables of the default output
+	NoHeadersFlagName = "no-headers"
+
+	// machineOutputFormatsAnnotation restricts the -o values supported by a command with machine output,
+	// for the commands streaming their output as json events
+	machineOutputFormatsAnnotation = "machineoutputformats"
+)
+
+// SupportedOutputFormats is the list of the formats supported by the -o flag
+var SupportedOutputFormats = []string{JSONOutputFormat, YAMLOutputFormat, NameOutputFormat, JSONPathOutputFormat + "...", GoTemplateOutputFormat + "..."}
+
+// NewPrinter returns the printer of the machine readable output for the value of the -o flag
+func NewPrinter(output string) (machineoutput.Printer, error) {
+	switch {
+	case output == JSONOutputFormat:
+		return machineoutput.JSONPrinter{}, nil
+	case output == YAMLOutputFormat:
+		return yamlPrinter{}, nil
+	case output == NameOutputFormat:
+		return namePrinter{}, nil
+	case strings.HasPrefix(output, JSONPathOutputFormat):
+		return newJSONPathPrinter(strings.TrimPrefix(output, JSONPathOutputFormat))
+	case strings.HasPrefix(output, GoTemplateOutputFormat):
+		return newGoTemplatePrinter(strings.TrimPrefix(output, GoTemplateOutputFormat))
+	}
+	return nil, fmt.Errorf("%q is not a valid output format for -o, available formats: %s", output, strings.Join(SupportedOutputFormats, ", "))
+}
+
+// StreamOnlyJSONOutput marks the command as streaming its machine readable output as json events,
+// which can't be printed in the other output formats
+func StreamOnlyJSONOutput(cmd *cobra.Command) {
+	if cmd.Annotations == nil {
+		cmd.Annotations = map[string]string{}
+	}
+	cmd.Annotations[machineOutputFormatsAnnotation] = JSONOutputFormat
+}
+
+// NoHeaders returns true if the --no-headers flag is set
+func NoHeaders() bool {
+	flag := pflag.Lookup(NoHeadersFlagName)
+	return flag != nil && flag.Value.String() == "true"
+}
+
+// PrintTableHeader prints the header line of a table of the default output, unless the --no-headers flag is set
+func PrintTableHeader(w io.Writer, columns ...interface{}) {
+	if !NoHeaders() {
+		fmt.Fprintln(w, columns...)
+	}
+}
+
+// toGeneric converts the object to its generic json representation, made of maps, slices and values,
+// to print the fields by their json names
+func toGeneric(obj interface{}) (interface{}, error) {
+	data, err := json.Marshal(obj)
+	if err != nil {
+		return nil, fmt.Errorf("unable to marshal JSON: %w", err)
+	}
+	var generic interface{}
+	if err := json.Unmarshal(data, &generic); err != nil {
+		return nil, fmt.Errorf("unable to unmarshal JSON: %w", err)
+	}
+	return generic, nil
+}
+
+// yamlPrinter prints the machine readable output in yaml
+type yamlPrinter struct{}
+
+func (p yamlPrinter) PrintObj(obj interface{}, w io.Writer) error {
+	data, err := yaml.Marshal(obj)
+	if err != nil {
+		return fmt.Errorf("unable to marshal YAML: %w", err)
+	}
+	_, err = w.Write(data)
+	return err
+}
+
+// namePrinter prints kind/name for the resource of the machine readable output, or for each item of a list
+type namePrinter struct{}
+
+func (p namePrinter) PrintObj(obj interface{}, w io.Writer) error {
+	generic, err := toGeneric(obj)
+	if err != nil {
+		return err
+	}
+	resource, ok := generic.(map[string]interface{})
+	if !ok {
+		return fmt.Errorf("the output of the command has no name")
+	}
+
+	resources := []interface{}{resource}
+	if items, ok := resource["items"].([]interface{}); ok {
+		resources = items
+	}
+	for _, r := range resources {
+		name, err := resourceName(r)
+		if err != nil {
+			return err
+		}
+		if _, err := fmt.Fprintln(w, name); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// resourceName returns kind/name for a resource with a kind, its name otherwise
+func resourceName(resource interface{}) (string, error) {
+	r, _ := resource.(map[string]interface{})
+	metadata, _ := r["metadata"].(map[string]interface{})
+	name, _ := metadata["name"].(string)
+	if name == "" {
+		name, _ = r["name"].(string)
+	}
+	if name == "" {
+		return "", fmt.Errorf("the output of the command has no name")
+	}
+	if kind, _ := r["kind"].(string); kind != "" && kind != "List" {
+		return strings.ToLower(kind) + "/" + name, nil
+	}
+	return name, nil
+}
+
+// jsonPathPrinter prints the machine readable output with a jsonpath template
+type jsonPathPrinter struct {
+	parser *jsonpath.JSONPath
+}
+
+func newJSONPathPrinter(tmpl string) (*jsonPathPrinter, error) {
+	if tmpl == "" {
+		return nil, fmt.Errorf("the jsonpath template of -o %s... is empty", JSONPathOutputFormat)
+	}
+	parser := jsonpath.New("output").AllowMissingKeys(true)
+	if err := parser.Parse(tmpl); err != nil {
+		return nil, fmt.Errorf("error parsing the jsonpath template %s: %w", tmpl, err)
+	}
+	return &jsonPathPrinter{parser: parser}, nil
+}
+
+func (p *jsonPathPrinter) PrintObj(obj interface{}, w io.Writer) error {
+	generic, err := toGeneric(obj)
+	if err != nil {
+		return err
+	}
+	if err := p.parser.Execute(w, generic); err != nil {
+		return fmt.Errorf("error executing the jsonpath template: %w", err)
+	}
+	return nil
+}
+
+// goTemplatePrinter prints the machine readable output with a go template
+type goTemplatePrinter struct {
+	template *template.Template
+}
+
+func newGoTemplatePrinter(tmpl string) (*goTemplatePrinter, error) {
+	if tmpl == "" {
+		return nil, fmt.Errorf("the go template of -o %s... is empty", GoTemplateOutputFormat)
+	}
+	t, err := template.New("output").Parse(tmpl)
+	if err != nil {
+		return nil, fmt.Errorf("error parsing the go template %s: %w", tmpl, err)
+	}
+	return &goTemplatePrinter{template: t}, nil
+}
+
+func (p *goTemplatePrinter) PrintObj(obj interface{}, w io.Writer) error {
+	generic, err := toGeneric(obj)
+	if err != nil {
+		return err
+	}
+	if err := p.template.Execute(w, generic); err != nil {
+		return fmt.Errorf("error executing the go template: %w", err)
+	}
+	return nil
+}
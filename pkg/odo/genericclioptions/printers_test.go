@@ -0,0 +1,127 @@
+package genericclioptions
+
+import (
+	"bytes"
+	"testing"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+type testItem struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Port              int `json:"port"`
+}
+
+type testList struct {
+	metav1.TypeMeta `json:",inline"`
+	Items           []testItem `json:"items"`
+}
+
+func TestNewPrinter(t *testing.T) {
+	list := testList{
+		TypeMeta: metav1.TypeMeta{Kind: "List", APIVersion: "odo.dev/v1alpha1"},
+		Items: []testItem{
+			{TypeMeta: metav1.TypeMeta{Kind: "Url"}, ObjectMeta: metav1.ObjectMeta{Name: "http"}, Port: 8080},
+			{TypeMeta: metav1.TypeMeta{Kind: "Url"}, ObjectMeta: metav1.ObjectMeta{Name: "debug"}, Port: 5858},
+		},
+	}
+
+	tests := []struct {
+		name       string
+		output     string
+		obj        interface{}
+		want       string
+		wantErr    bool
+		wantObjErr bool
+	}{
+		{
+			name:   "json",
+			output: "json",
+			obj:    list.Items[0],
+			want:   "{\n\t\"kind\": \"Url\",\n\t\"metadata\": {\n\t\t\"name\": \"http\",\n\t\t\"creationTimestamp\": null\n\t},\n\t\"port\": 8080\n}\n",
+		},
+		{
+			name:   "yaml",
+			output: "yaml",
+			obj:    list.Items[0],
+			want:   "kind: Url\nmetadata:\n  creationTimestamp: null\n  name: http\nport: 8080\n",
+		},
+		{
+			name:   "name of a list",
+			output: "name",
+			obj:    list,
+			want:   "url/http\nurl/debug\n",
+		},
+		{
+			name:   "name of a resource",
+			output: "name",
+			obj:    list.Items[1],
+			want:   "url/debug\n",
+		},
+		{
+			name:       "name of an output without name",
+			output:     "name",
+			obj:        struct{}{},
+			wantObjErr: true,
+		},
+		{
+			name:   "jsonpath",
+			output: "jsonpath={range .items[*]}{.metadata.name}={.port}{\"\\n\"}{end}",
+			obj:    list,
+			want:   "http=8080\ndebug=5858\n",
+		},
+		{
+			name:   "jsonpath with a missing key",
+			output: "jsonpath={.items[0].spec}",
+			obj:    list,
+			want:   "",
+		},
+		{
+			name:    "invalid jsonpath",
+			output:  "jsonpath={.items[0}",
+			wantErr: true,
+		},
+		{
+			name:    "empty jsonpath",
+			output:  "jsonpath=",
+			wantErr: true,
+		},
+		{
+			name:   "go-template",
+			output: "go-template={{range .items}}{{.metadata.name}} {{.port}}\n{{end}}",
+			obj:    list,
+			want:   "http 8080\ndebug 5858\n",
+		},
+		{
+			name:    "invalid go-template",
+			output:  "go-template={{range .items}}",
+			wantErr: true,
+		},
+		{
+			name:    "unknown format",
+			output:  "wide",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			printer, err := NewPrinter(tt.output)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewPrinter() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+
+			var out bytes.Buffer
+			err = printer.PrintObj(tt.obj, &out)
+			if (err != nil) != tt.wantObjErr {
+				t.Fatalf("PrintObj() error = %v, wantErr %v", err, tt.wantObjErr)
+			}
+			if out.String() != tt.want {
+				t.Errorf("PrintObj() = %q, want %q", out.String(), tt.want)
+			}
+		})
+	}
+}
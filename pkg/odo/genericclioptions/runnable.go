@@ -21,6 +21,7 @@ import (
 	"k8s.io/klog"
 
 	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
 	"github.com/openshift/odo/pkg/odo/util"
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
@@ -184,21 +185,33 @@ func CheckMachineReadableOutputCommand(cmd *cobra.Command) {
 	hasFlagChanged := outputFlag != nil && outputFlag.Changed
 	machineOutput := cmd.Annotations["machineoutput"]
 
-	// Check the valid output
-	if hasFlagChanged && outputFlag.Value.String() != "json" {
-		log.Error("Please input a valid output format for -o, available format: json")
-		os.Exit(1)
-	}
+	if hasFlagChanged {
+		output := outputFlag.Value.String()
+
+		// Check the valid output
+		printer, err := NewPrinter(output)
+		if err != nil {
+			// By default we "disable" logging, so activate it so that the below error can be shown.
+			_ = flag.Set("o", "")
+			log.Error(err.Error())
+			os.Exit(1)
+		}
 
-	// Check that if -o json has been passed, that the command actually USES json.. if not, error out.
-	if hasFlagChanged && outputFlag.Value.String() == "json" && machineOutput == "" {
+		// Check that if -o has been passed, that the command actually has machine readable output.. if not, error out.
+		if machineOutput == "" {
+			_ = flag.Set("o", "")
+			log.Errorf("Machine readable output is not yet implemented for the command %q, -o %s can't be used", cmd.CommandPath(), output)
+			os.Exit(1)
+		}
 
-		// By default we "disable" logging, so activate it so that the below error can be shown.
-		_ = flag.Set("o", "")
+		// The commands streaming json events only support -o json
+		if formats, ok := cmd.Annotations[machineOutputFormatsAnnotation]; ok && output != formats {
+			_ = flag.Set("o", "")
+			log.Errorf("The command %q only supports the output format %s, -o %s can't be used", cmd.CommandPath(), formats, output)
+			os.Exit(1)
+		}
 
-		// Output the error
-		log.Error("Machine readable output is not yet implemented for this command")
-		os.Exit(1)
+		machineoutput.SetPrinter(printer)
 	}
 
 	// Before running anything, we will make sure that no verbose output is made
@@ -244,7 +244,7 @@ func ModifyAdditionalFlags(cmd *cobra.Command) string {
 
 	f.VisitAll(func(f *pflag.Flag) {
 		// Remove json flag if machineoutput has not been passed in
-		if f.Name == "o" && machineOutput == "json" {
+		if (f.Name == "o" || f.Name == "no-headers") && machineOutput == "json" {
 			f.Hidden = false
 		}
 	})
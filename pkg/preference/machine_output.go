@@ -79,6 +79,13 @@ func toPreferenceItems(prefInfo PreferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetExperimental()),
 			Description: ExperimentalDescription,
 		},
+		{
+			Name:        RegistryOfflineSetting,
+			Value:       odoSettings.RegistryOffline,
+			Default:     DefaultRegistryOffline,
+			Type:        getType(prefInfo.GetRegistryOffline()),
+			Description: RegistryOfflineDescription,
+		},
 		{
 			Name:        ConsentTelemetrySetting,
 			Value:       odoSettings.ConsentTelemetry,
@@ -86,6 +93,13 @@ func toPreferenceItems(prefInfo PreferenceInfo) []PreferenceItem {
 			Type:        getType(prefInfo.GetConsentTelemetry()),
 			Description: ConsentTelemetryDescription,
 		},
+		{
+			Name:        IngressDomainSetting,
+			Value:       odoSettings.IngressDomain,
+			Default:     "",
+			Type:        getType(prefInfo.GetIngressDomain()),
+			Description: IngressDomainDescription,
+		},
 	}
 }
 
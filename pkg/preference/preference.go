@@ -14,6 +14,7 @@ import (
 
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/odo/cli/ui"
+	"github.com/openshift/odo/pkg/odo/util/validation"
 	"github.com/openshift/odo/pkg/util"
 )
 
@@ -74,6 +75,12 @@ const (
 	// DefaultRegistryCacheTime is time (in minutes) for how long odo will cache information from Devfile registry
 	DefaultRegistryCacheTime = 15
 
+	// RegistryOfflineSetting specifies if odo only uses the cached information from the devfile registries
+	RegistryOfflineSetting = "RegistryOffline"
+
+	// DefaultRegistryOffline is a default value for RegistryOffline preference
+	DefaultRegistryOffline = false
+
 	// EphemeralSetting specifies if ephemeral volumes needs to be used as source volume.
 	EphemeralSetting = "Ephemeral"
 
@@ -85,6 +92,9 @@ const (
 
 	// DefaultConsentTelemetry is a default value for ConsentTelemetry preference
 	DefaultConsentTelemetrySetting = false
+
+	// IngressDomainSetting is the name of the setting controlling the default host of the URLs of Ingress kind
+	IngressDomainSetting = "IngressDomain"
 )
 
 // TimeoutSettingDescription is human-readable description for the timeout setting
@@ -99,12 +109,18 @@ var BuildTimeoutSettingDescription = fmt.Sprintf("BuildTimeout (in seconds) for
 // RegistryCacheTimeDescription adds a description for RegistryCacheTime
 var RegistryCacheTimeDescription = fmt.Sprintf("For how long (in minutes) odo will cache information from Devfile registry (Default: %d)", DefaultRegistryCacheTime)
 
+// RegistryOfflineDescription adds a description for RegistryOffline
+var RegistryOfflineDescription = fmt.Sprintf("If true odo will use the cached information from Devfile registries without connecting to them (Default: %t)", DefaultRegistryOffline)
+
 // EphemeralDescription adds a description for EphemeralSourceVolume
 var EphemeralDescription = fmt.Sprintf("If true odo will create a emptyDir volume to store source code (Default: %t)", DefaultEphemeralSettings)
 
 //TelemetryConsentDescription adds a description for TelemetryConsentSetting
 var ConsentTelemetryDescription = fmt.Sprintf("If true odo will collect telemetry for the user's odo usage (Default: %t)\n\t\t    For more information: https://developers.redhat.com/article/tool-data-collection", DefaultConsentTelemetrySetting)
 
+// IngressDomainDescription adds a description for IngressDomain
+var IngressDomainDescription = "Default host of the URLs of Ingress kind, used by 'odo url create' when --host is not provided (Default: detected from the cluster)"
+
 // This value can be provided to set a seperate directory for users 'homedir' resolution
 // note for mocking purpose ONLY
 var customHomeDir = os.Getenv("CUSTOM_HOMEDIR")
@@ -119,8 +135,10 @@ var (
 		PushTimeoutSetting:        PushTimeoutSettingDescription,
 		ExperimentalSetting:       ExperimentalDescription,
 		RegistryCacheTimeSetting:  RegistryCacheTimeDescription,
+		RegistryOfflineSetting:    RegistryOfflineDescription,
 		EphemeralSetting:          EphemeralDescription,
 		ConsentTelemetrySetting:   ConsentTelemetryDescription,
+		IngressDomainSetting:      IngressDomainDescription,
 	}
 
 	// set-like map to quickly check if a parameter is supported
@@ -160,11 +178,17 @@ type OdoSettings struct {
 	// RegistryCacheTime how long odo should cache information from registry
 	RegistryCacheTime *int `yaml:"RegistryCacheTime,omitempty"`
 
+	// RegistryOffline if true only uses the cached information from registry
+	RegistryOffline *bool `yaml:"RegistryOffline,omitempty"`
+
 	// Ephemeral if true creates odo emptyDir to store odo source code
 	Ephemeral *bool `yaml:"Ephemeral,omitempty"`
 
 	// ConsentTelemetry if true collects telemetry for odo
 	ConsentTelemetry *bool `yaml:"ConsentTelemetry,omitempty"`
+
+	// IngressDomain is the default host of the URLs of Ingress kind
+	IngressDomain *string `yaml:"IngressDomain,omitempty"`
 }
 
 // Registry includes the registry metadata
@@ -413,6 +437,13 @@ func (c *PreferenceInfo) SetConfiguration(parameter string, value string) error
 			}
 			c.OdoSettings.RegistryCacheTime = &typedval
 
+		case "registryoffline":
+			val, err := strconv.ParseBool(strings.ToLower(value))
+			if err != nil {
+				return errors.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
+			}
+			c.OdoSettings.RegistryOffline = &val
+
 		case "updatenotification":
 			val, err := strconv.ParseBool(strings.ToLower(value))
 			if err != nil {
@@ -444,6 +475,12 @@ func (c *PreferenceInfo) SetConfiguration(parameter string, value string) error
 				return errors.Errorf("unable to set %q to %q, value must be a boolean", parameter, value)
 			}
 			c.OdoSettings.ConsentTelemetry = &val
+
+		case "ingressdomain":
+			if err := validation.ValidateHost(value); err != nil {
+				return errors.Wrapf(err, "unable to set %q to %q", parameter, value)
+			}
+			c.OdoSettings.IngressDomain = &value
 		}
 	} else {
 		return errors.Errorf("unknown parameter : %q is not a parameter in odo preference, run help to see list of available parameters", parameter)
@@ -505,6 +542,12 @@ func (c *PreferenceInfo) GetRegistryCacheTime() int {
 	return util.GetIntOrDefault(c.OdoSettings.RegistryCacheTime, DefaultRegistryCacheTime)
 }
 
+// GetRegistryOffline returns the value of RegistryOffline from preferences
+// and if absent then returns default
+func (c *PreferenceInfo) GetRegistryOffline() bool {
+	return util.GetBoolOrDefault(c.OdoSettings.RegistryOffline, DefaultRegistryOffline)
+}
+
 // GetUpdateNotification returns the value of UpdateNotification from preferences
 // and if absent then returns default
 func (c *PreferenceInfo) GetUpdateNotification() bool {
@@ -537,6 +580,12 @@ func (c *PreferenceInfo) GetConsentTelemetry() bool {
 	return util.GetBoolOrDefault(c.OdoSettings.ConsentTelemetry, DefaultConsentTelemetrySetting)
 }
 
+// GetIngressDomain returns the value of IngressDomain from preferences
+// and if absent then returns empty, the host being detected from the cluster
+func (c *PreferenceInfo) GetIngressDomain() string {
+	return util.GetStringOrEmpty(c.OdoSettings.IngressDomain)
+}
+
 // FormatSupportedParameters outputs supported parameters and their description
 func FormatSupportedParameters() (result string) {
 	for _, v := range GetSupportedParameters() {
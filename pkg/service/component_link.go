@@ -0,0 +1,535 @@
+package service
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"path"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/library/pkg/devfile/parser"
+	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
+	"github.com/ghodss/yaml"
+	applabels "github.com/openshift/odo/pkg/application/labels"
+	componentlabels "github.com/openshift/odo/pkg/component/labels"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	servicebinding "github.com/redhat-developer/service-binding-operator/api/v1alpha1"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+const (
+	// ComponentLinkAnnotation is the annotation of a link to another component applied by odo, the links between components
+	// without it are created with the Service Binding Operator
+	ComponentLinkAnnotation = "odo.dev/component-link"
+	// ComponentLinkPortAnnotation is the annotation of a link to another component, containing the port of the target component to connect to
+	ComponentLinkPortAnnotation = "odo.dev/link-port"
+	// ComponentEndpointsAnnotation is the annotation of the Service of a component, listing the endpoints exposed by the component
+	ComponentEndpointsAnnotation = "odo.dev/endpoints"
+	// ComponentLinkLabel is the label of the secrets holding the information to connect to a linked component, containing the name of the link
+	ComponentLinkLabel = "odo.dev/link"
+	// ComponentLinkTargetLabel is the label of the secrets holding the information to connect to a linked component, containing the name of the Service of the target component
+	ComponentLinkTargetLabel = "odo.dev/link-target"
+	// componentLinksChecksumAnnotation is the annotation of the pod template of a component, containing a checksum of the information
+	// of its links to other components. The pods of the component are restarted when the information changes
+	componentLinksChecksumAnnotation = "odo.dev/links-checksum"
+	// bindingsPath is the directory in which the information of a link is mounted by default, when bound as files
+	bindingsPath = "/bindings"
+)
+
+var invalidLinkKeyChars = regexp.MustCompile("[^A-Z0-9_]")
+
+// Endpoint is an endpoint of a component, exposed by the Service of the component
+type Endpoint struct {
+	Name     string `json:"name"`
+	Port     int32  `json:"port"`
+	Protocol string `json:"protocol,omitempty"`
+}
+
+// ComponentLink is a link from a component to another component of the same application, defined in the devfile
+type ComponentLink struct {
+	// Name is the name of the link, also used as the name of the secret holding the information to connect to the target component
+	Name string
+	// Service is the name of the Service of the target component
+	Service string
+	// Port is the port of the target component to connect to, 0 when the first port of the target component is used
+	Port int32
+	// BindAsFiles is true when the information is mounted as files instead of being injected as environment variables
+	BindAsFiles bool
+	// MountPath is the directory in which the information is mounted when bound as files
+	MountPath string
+}
+
+// IsComponentLink returns true when the ServiceBinding links the component to another component and is applied by odo,
+// instead of the Service Binding Operator
+func IsComponentLink(sb servicebinding.ServiceBinding) bool {
+	return sb.Annotations[ComponentLinkAnnotation] == "true" &&
+		len(sb.Spec.Services) == 1 && sb.Spec.Services[0].Group == "" && sb.Spec.Services[0].Kind == "Service"
+}
+
+// IsDevfileComponentLink returns true when the Kubernetes inlined component of the given name is a link to another component applied by odo
+func IsDevfileComponentLink(devfileObj parser.DevfileObj, name string) (bool, error) {
+	components, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfile.KubernetesComponentType},
+	})
+	if err != nil {
+		return false, err
+	}
+	for _, c := range components {
+		if c.Name != name {
+			continue
+		}
+		link, err := getComponentLink(c)
+		if err != nil {
+			return false, err
+		}
+		return link != nil, nil
+	}
+	return false, nil
+}
+
+// getComponentLink returns the link to another component defined by the Kubernetes inlined component, or nil if the
+// component defines an operator backed service or a link to such a service
+func getComponentLink(c devfile.Component) (*ComponentLink, error) {
+	if c.Kubernetes == nil {
+		return nil, nil
+	}
+	var u unstructured.Unstructured
+	err := yaml.Unmarshal([]byte(c.Kubernetes.Inlined), &u)
+	if err != nil {
+		return nil, err
+	}
+	if !isLinkResource(u.GetKind()) {
+		return nil, nil
+	}
+
+	var sb servicebinding.ServiceBinding
+	err = yaml.Unmarshal([]byte(c.Kubernetes.Inlined), &sb)
+	if err != nil {
+		return nil, err
+	}
+	if !IsComponentLink(sb) {
+		return nil, nil
+	}
+
+	link := &ComponentLink{
+		Name:        sb.Name,
+		Service:     sb.Spec.Services[0].Name,
+		BindAsFiles: sb.Spec.BindAsFiles,
+		MountPath:   sb.Spec.MountPath,
+	}
+	if link.MountPath == "" {
+		link.MountPath = path.Join(bindingsPath, link.Name)
+	}
+	if port := sb.Annotations[ComponentLinkPortAnnotation]; port != "" {
+		p, err := strconv.ParseInt(port, 10, 32)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid port %q for the link %q", port, sb.Name)
+		}
+		link.Port = int32(p)
+	}
+	return link, nil
+}
+
+// GetComponentLinks returns the links to other components defined in the Kubernetes inlined components of a devfile
+func GetComponentLinks(k8sComponents []devfile.Component) ([]ComponentLink, error) {
+	var links []ComponentLink
+	for _, c := range k8sComponents {
+		link, err := getComponentLink(c)
+		if err != nil {
+			return nil, err
+		}
+		if link != nil {
+			links = append(links, *link)
+		}
+	}
+	return links, nil
+}
+
+// GetComponentEndpoints returns the endpoints of the container components of the devfile exposed by the Service of the component
+func GetComponentEndpoints(devfileObj parser.DevfileObj) ([]Endpoint, error) {
+	containers, err := devfileObj.Data.GetComponents(parsercommon.DevfileOptions{
+		ComponentOptions: parsercommon.ComponentOptions{ComponentType: devfile.ContainerComponentType},
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	var endpoints []Endpoint
+	ports := map[int]bool{}
+	for _, c := range containers {
+		for _, e := range c.Container.Endpoints {
+			// the endpoints not exposed are not part of the Service, and the first endpoint of a port gives its name to the port
+			if e.Exposure == devfile.NoneEndpointExposure || ports[e.TargetPort] {
+				continue
+			}
+			ports[e.TargetPort] = true
+			endpoints = append(endpoints, Endpoint{
+				Name:     e.Name,
+				Port:     int32(e.TargetPort),
+				Protocol: string(e.Protocol),
+			})
+		}
+	}
+	return endpoints, nil
+}
+
+// GetComponentEndpointsAnnotation returns the value of the ComponentEndpointsAnnotation for the endpoints
+func GetComponentEndpointsAnnotation(endpoints []Endpoint) (string, error) {
+	value, err := json.Marshal(endpoints)
+	if err != nil {
+		return "", err
+	}
+	return string(value), nil
+}
+
+// getServiceEndpoints returns the endpoints listed in the ComponentEndpointsAnnotation of the Service of a component
+func getServiceEndpoints(svc corev1.Service) ([]Endpoint, error) {
+	value, ok := svc.Annotations[ComponentEndpointsAnnotation]
+	if !ok {
+		return nil, nil
+	}
+	var endpoints []Endpoint
+	err := json.Unmarshal([]byte(value), &endpoints)
+	if err != nil {
+		return nil, errors.Wrapf(err, "invalid endpoints for the Service %q", svc.Name)
+	}
+	return endpoints, nil
+}
+
+// linkKeyName returns the name of the environment variable (or file) holding the information of a linked component
+func linkKeyName(componentName, baseKeyName string) string {
+	return invalidLinkKeyChars.ReplaceAllString(strings.ToUpper(fmt.Sprintf("COMPONENT_%s_%s", componentName, baseKeyName)), "_")
+}
+
+// endpointURL returns the URL to reach the port of the host with the protocol of an endpoint
+func endpointURL(host string, port int32, protocol string) string {
+	scheme := strings.ToLower(protocol)
+	if scheme == "" {
+		scheme = "http"
+	}
+	return fmt.Sprintf("%s://%s:%d", scheme, host, port)
+}
+
+// GetComponentLinkData returns the information to connect to the component exposed by the Service: the host, port and URL of
+// the port to connect to, and the port and URL of each endpoint of the component. The first port of the Service is used when
+// port is 0
+func GetComponentLinkData(svc corev1.Service, port int32) (map[string]string, error) {
+	componentName := svc.Labels[componentlabels.ComponentLabel]
+	if componentName == "" {
+		componentName = svc.Name
+	}
+	if len(svc.Spec.Ports) == 0 {
+		return nil, fmt.Errorf("the component %q doesn't expose any port", componentName)
+	}
+
+	endpoints, err := getServiceEndpoints(svc)
+	if err != nil {
+		return nil, err
+	}
+	protocols := map[int32]string{}
+	for _, e := range endpoints {
+		protocols[e.Port] = e.Protocol
+	}
+
+	exposed := map[int32]bool{}
+	var exposedPorts []string
+	for _, p := range svc.Spec.Ports {
+		exposed[p.Port] = true
+		exposedPorts = append(exposedPorts, strconv.Itoa(int(p.Port)))
+	}
+	if port == 0 {
+		port = svc.Spec.Ports[0].Port
+	} else if !exposed[port] {
+		return nil, fmt.Errorf("the component %q doesn't expose the port %d, the exposed ports are: %s", componentName, port, strings.Join(exposedPorts, ", "))
+	}
+
+	data := map[string]string{
+		linkKeyName(componentName, "host"): svc.Name,
+		linkKeyName(componentName, "port"): strconv.Itoa(int(port)),
+		linkKeyName(componentName, "url"):  endpointURL(svc.Name, port, protocols[port]),
+	}
+	for _, e := range endpoints {
+		if !exposed[e.Port] {
+			continue
+		}
+		data[linkKeyName(componentName, e.Name+"_port")] = strconv.Itoa(int(e.Port))
+		data[linkKeyName(componentName, e.Name+"_url")] = endpointURL(svc.Name, e.Port, e.Protocol)
+	}
+	return data, nil
+}
+
+// secretDataEquals returns true when the data of the secret is the given data
+func secretDataEquals(secret corev1.Secret, data map[string]string) bool {
+	if len(secret.Data) != len(data) {
+		return false
+	}
+	for key, value := range data {
+		if string(secret.Data[key]) != value {
+			return false
+		}
+	}
+	return true
+}
+
+// getComponentLinkSecretLabels returns the labels of the secret of the link of the component with the given labels
+func getComponentLinkSecretLabels(labels map[string]string, link ComponentLink) map[string]string {
+	secretLabels := map[string]string{}
+	for key, value := range labels {
+		secretLabels[key] = value
+	}
+	secretLabels[ComponentLinkLabel] = link.Name
+	secretLabels[ComponentLinkTargetLabel] = link.Service
+	return secretLabels
+}
+
+// getComponentLinkSecretsSelector returns the selector of the secrets of the links of the component with the given labels
+func getComponentLinkSecretsSelector(labels map[string]string) string {
+	return util.ConvertLabelsToSelector(map[string]string{
+		applabels.ApplicationLabel:     labels[applabels.ApplicationLabel],
+		componentlabels.ComponentLabel: labels[componentlabels.ComponentLabel],
+	}) + "," + ComponentLinkLabel
+}
+
+// PushComponentLinks creates or updates the secrets holding the information to connect to the components linked to the
+// component with the given labels in the devfile, and deletes the secrets of the links removed from the devfile.
+// It returns the links to inject into the component
+func PushComponentLinks(client *kclient.Client, k8sComponents []devfile.Component, labels map[string]string) ([]ComponentLink, error) {
+	links, err := GetComponentLinks(k8sComponents)
+	if err != nil {
+		return nil, err
+	}
+
+	secrets, err := client.ListSecrets(getComponentLinkSecretsSelector(labels))
+	if err != nil {
+		return nil, err
+	}
+	deployed := map[string]corev1.Secret{}
+	for _, secret := range secrets {
+		deployed[secret.Name] = secret
+	}
+
+	for _, link := range links {
+		svc, err := client.GetService(link.Service)
+		if err != nil {
+			if kerrors.IsNotFound(errors.Cause(err)) {
+				return nil, fmt.Errorf("unable to apply the link %q, the linked component has not been pushed or doesn't expose any port", link.Name)
+			}
+			return nil, err
+		}
+		data, err := GetComponentLinkData(*svc, link.Port)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to apply the link %q", link.Name)
+		}
+
+		objectMeta := metav1.ObjectMeta{
+			Name:   link.Name,
+			Labels: getComponentLinkSecretLabels(labels, link),
+		}
+		if link.Port != 0 {
+			objectMeta.Annotations = map[string]string{ComponentLinkPortAnnotation: strconv.Itoa(int(link.Port))}
+		}
+
+		secret, found := deployed[link.Name]
+		delete(deployed, link.Name)
+		if !found {
+			err = client.CreateSecret(objectMeta, data)
+			if err != nil {
+				return nil, err
+			}
+			log.Successf("Created link %q on the cluster; component will be restarted", link.Name)
+			continue
+		}
+
+		if secretDataEquals(secret, data) && secret.Annotations[ComponentLinkPortAnnotation] == objectMeta.Annotations[ComponentLinkPortAnnotation] {
+			continue
+		}
+		secret.Labels = objectMeta.Labels
+		secret.Annotations = objectMeta.Annotations
+		secret.Data = nil
+		secret.StringData = data
+		_, err = client.UpdateSecret(&secret)
+		if err != nil {
+			return nil, err
+		}
+		log.Successf("Updated link %q on the cluster; component will be restarted", link.Name)
+	}
+
+	for name := range deployed {
+		err = client.DeleteSecret(name)
+		if err != nil {
+			return nil, err
+		}
+		log.Successf("Deleted link %q on the cluster; component will be restarted", name)
+	}
+
+	return links, nil
+}
+
+// getComponentLinksChecksum returns a checksum of the information of the links held by the secrets
+func getComponentLinksChecksum(secrets []corev1.Secret) string {
+	var entries []string
+	for _, secret := range secrets {
+		for key, value := range secret.Data {
+			entries = append(entries, secret.Name+"/"+key+"="+string(value))
+		}
+	}
+	sort.Strings(entries)
+	return fmt.Sprintf("%x", sha256.Sum256([]byte(strings.Join(entries, "\n"))))
+}
+
+// InjectComponentLinks injects the information of the links into the containers of the pod template of a component,
+// as environment variables or as files. The pod template is annotated with a checksum of the information, so that the
+// pods are restarted when it changes
+func InjectComponentLinks(client *kclient.Client, podTemplate *corev1.PodTemplateSpec, links []ComponentLink, labels map[string]string) error {
+	if len(links) == 0 {
+		return nil
+	}
+
+	for _, link := range links {
+		if link.BindAsFiles {
+			volumeName := "link-" + link.Name
+			podTemplate.Spec.Volumes = append(podTemplate.Spec.Volumes, corev1.Volume{
+				Name: volumeName,
+				VolumeSource: corev1.VolumeSource{
+					Secret: &corev1.SecretVolumeSource{SecretName: link.Name},
+				},
+			})
+			for i := range podTemplate.Spec.Containers {
+				podTemplate.Spec.Containers[i].VolumeMounts = append(podTemplate.Spec.Containers[i].VolumeMounts, corev1.VolumeMount{
+					Name:      volumeName,
+					MountPath: link.MountPath,
+					ReadOnly:  true,
+				})
+			}
+			continue
+		}
+		for i := range podTemplate.Spec.Containers {
+			podTemplate.Spec.Containers[i].EnvFrom = append(podTemplate.Spec.Containers[i].EnvFrom, corev1.EnvFromSource{
+				SecretRef: &corev1.SecretEnvSource{
+					LocalObjectReference: corev1.LocalObjectReference{Name: link.Name},
+				},
+			})
+		}
+	}
+
+	secrets, err := client.ListSecrets(getComponentLinkSecretsSelector(labels))
+	if err != nil {
+		return err
+	}
+	// the pod template can share its annotations with the deployment, which must not get the checksum
+	annotations := map[string]string{}
+	for key, value := range podTemplate.Annotations {
+		annotations[key] = value
+	}
+	annotations[componentLinksChecksumAnnotation] = getComponentLinksChecksum(secrets)
+	podTemplate.Annotations = annotations
+	return nil
+}
+
+// UpdateComponentLinksOwnerReferences adds the owner reference to the secrets of the links of the component with the given labels,
+// if not already present in the list of owner references
+func UpdateComponentLinksOwnerReferences(client *kclient.Client, labels map[string]string, ownerReference metav1.OwnerReference) error {
+	secrets, err := client.ListSecrets(getComponentLinkSecretsSelector(labels))
+	if err != nil {
+		return err
+	}
+	for i := range secrets {
+		found := false
+		for _, ownerRef := range secrets[i].OwnerReferences {
+			if ownerRef.UID == ownerReference.UID {
+				found = true
+				break
+			}
+		}
+		if found {
+			continue
+		}
+		secrets[i].OwnerReferences = append(secrets[i].OwnerReferences, ownerReference)
+		_, err = client.UpdateSecret(&secrets[i])
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// RefreshComponentLinksToTarget updates the information of the links of other components to the component exposed by the Service,
+// after a change of the Service. The components using outdated information are restarted to get the new one
+func RefreshComponentLinksToTarget(client *kclient.Client, svc corev1.Service) error {
+	secrets, err := client.ListSecrets(util.ConvertLabelsToSelector(map[string]string{ComponentLinkTargetLabel: svc.Name}))
+	if err != nil {
+		return err
+	}
+
+	for i := range secrets {
+		secret := secrets[i]
+		var port int32
+		if value := secret.Annotations[ComponentLinkPortAnnotation]; value != "" {
+			p, err := strconv.ParseInt(value, 10, 32)
+			if err != nil {
+				return errors.Wrapf(err, "invalid port %q for the link %q", value, secret.Name)
+			}
+			port = int32(p)
+		}
+
+		componentName := secret.Labels[componentlabels.ComponentLabel]
+		data, err := GetComponentLinkData(svc, port)
+		if err != nil {
+			// the source component keeps the last known information, it will be reported when the source component is pushed
+			log.Warningf("Unable to update the link %q of the component %q: %v", secret.Name, componentName, err)
+			continue
+		}
+		if secretDataEquals(secret, data) {
+			continue
+		}
+
+		secret.Data = nil
+		secret.StringData = data
+		_, err = client.UpdateSecret(&secret)
+		if err != nil {
+			return err
+		}
+
+		err = restartLinkedComponent(client, secret.Labels)
+		if err != nil {
+			return err
+		}
+		log.Successf("Updated link %q of the component %q; component will be restarted", secret.Name, componentName)
+	}
+	return nil
+}
+
+// restartLinkedComponent updates the checksum of the information of the links of the component with the given labels,
+// which restarts its pods with the new information
+func restartLinkedComponent(client *kclient.Client, labels map[string]string) error {
+	deployment, err := client.GetOneDeployment(labels[componentlabels.ComponentLabel], labels[applabels.ApplicationLabel])
+	if err != nil {
+		if _, ok := err.(*kclient.DeploymentNotFoundError); ok {
+			klog.V(2).Infof("The component %q is not deployed, it will get the new information of its links on the next push", labels[componentlabels.ComponentLabel])
+			return nil
+		}
+		return err
+	}
+
+	secrets, err := client.ListSecrets(getComponentLinkSecretsSelector(labels))
+	if err != nil {
+		return err
+	}
+	if deployment.Spec.Template.Annotations == nil {
+		deployment.Spec.Template.Annotations = map[string]string{}
+	}
+	deployment.Spec.Template.Annotations[componentLinksChecksumAnnotation] = getComponentLinksChecksum(secrets)
+	_, err = client.UpdateDeployment(*deployment)
+	return err
+}
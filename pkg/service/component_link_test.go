@@ -0,0 +1,205 @@
+package service
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	componentlabels "github.com/openshift/odo/pkg/component/labels"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/stretchr/testify/require"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// mockKubernetesComponent returns a devfile Kubernetes inlined component
+func mockKubernetesComponent(name, inlined string) devfile.Component {
+	return devfile.Component{
+		Name: name,
+		ComponentUnion: devfile.ComponentUnion{
+			Kubernetes: &devfile.KubernetesComponent{
+				K8sLikeComponent: devfile.K8sLikeComponent{
+					K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{Inlined: inlined},
+				},
+			},
+		},
+	}
+}
+
+// mockBackendService returns the Service of a 'backend' component exposing two endpoints
+func mockBackendService() corev1.Service {
+	return corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "backend-app",
+			Labels: map[string]string{componentlabels.ComponentLabel: "backend"},
+			Annotations: map[string]string{
+				ComponentEndpointsAnnotation: `[{"name":"http-api","port":8080,"protocol":"http"},{"name":"grpc","port":9090,"protocol":"tcp"}]`,
+			},
+		},
+		Spec: corev1.ServiceSpec{
+			Ports: []corev1.ServicePort{{Name: "port-8080", Port: 8080}, {Name: "port-9090", Port: 9090}},
+		},
+	}
+}
+
+func TestGetComponentLinks(t *testing.T) {
+	components := []devfile.Component{
+		mockKubernetesComponent("frontend-backend-app", `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: frontend-backend-app
+  annotations:
+    odo.dev/component-link: "true"
+    odo.dev/link-port: "9090"
+spec:
+  bindAsFiles: true
+  services:
+  - kind: Service
+    name: backend-app
+    version: v1`),
+		// the links between components created with the Service Binding Operator are applied by the operator
+		mockKubernetesComponent("frontend-db-app", `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: frontend-db-app
+spec:
+  services:
+  - kind: Service
+    name: db-app
+    version: v1`),
+		mockKubernetesComponent("frontend-etcdcluster-myetcd", `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: frontend-etcdcluster-myetcd
+spec:
+  services:
+  - group: etcd.database.coreos.com
+    kind: EtcdCluster
+    name: myetcd
+    version: v1beta2`),
+		mockKubernetesComponent("myetcd", `
+apiVersion: etcd.database.coreos.com/v1beta2
+kind: EtcdCluster
+metadata:
+  name: myetcd
+spec:
+  size: 3`),
+	}
+
+	got, err := GetComponentLinks(components)
+	require.NoError(t, err)
+	want := []ComponentLink{
+		{Name: "frontend-backend-app", Service: "backend-app", Port: 9090, BindAsFiles: true, MountPath: "/bindings/frontend-backend-app"},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("GetComponentLinks() = %+v, want %+v", got, want)
+	}
+}
+
+func TestGetComponentLinkData(t *testing.T) {
+	tests := []struct {
+		name    string
+		svc     corev1.Service
+		port    int32
+		want    map[string]string
+		wantErr string
+	}{
+		{
+			name: "first port of the component by default",
+			svc:  mockBackendService(),
+			want: map[string]string{
+				"COMPONENT_BACKEND_HOST":          "backend-app",
+				"COMPONENT_BACKEND_PORT":          "8080",
+				"COMPONENT_BACKEND_URL":           "http://backend-app:8080",
+				"COMPONENT_BACKEND_HTTP_API_PORT": "8080",
+				"COMPONENT_BACKEND_HTTP_API_URL":  "http://backend-app:8080",
+				"COMPONENT_BACKEND_GRPC_PORT":     "9090",
+				"COMPONENT_BACKEND_GRPC_URL":      "tcp://backend-app:9090",
+			},
+		},
+		{
+			name: "selected port",
+			svc:  mockBackendService(),
+			port: 9090,
+			want: map[string]string{
+				"COMPONENT_BACKEND_HOST":          "backend-app",
+				"COMPONENT_BACKEND_PORT":          "9090",
+				"COMPONENT_BACKEND_URL":           "tcp://backend-app:9090",
+				"COMPONENT_BACKEND_HTTP_API_PORT": "8080",
+				"COMPONENT_BACKEND_HTTP_API_URL":  "http://backend-app:8080",
+				"COMPONENT_BACKEND_GRPC_PORT":     "9090",
+				"COMPONENT_BACKEND_GRPC_URL":      "tcp://backend-app:9090",
+			},
+		},
+		{
+			name:    "port not exposed",
+			svc:     mockBackendService(),
+			port:    3000,
+			wantErr: `the component "backend" doesn't expose the port 3000, the exposed ports are: 8080, 9090`,
+		},
+		{
+			name: "component without endpoints information",
+			svc: corev1.Service{
+				ObjectMeta: metav1.ObjectMeta{Name: "backend-app"},
+				Spec:       corev1.ServiceSpec{Ports: []corev1.ServicePort{{Port: 8080}}},
+			},
+			want: map[string]string{
+				"COMPONENT_BACKEND_APP_HOST": "backend-app",
+				"COMPONENT_BACKEND_APP_PORT": "8080",
+				"COMPONENT_BACKEND_APP_URL":  "http://backend-app:8080",
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := GetComponentLinkData(tt.svc, tt.port)
+			if tt.wantErr != "" {
+				require.EqualError(t, err, tt.wantErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestPushComponentLinks(t *testing.T) {
+	client, _ := kclient.FakeNew()
+	backend := mockBackendService()
+	_, err := client.CreateService(backend)
+	require.NoError(t, err)
+
+	labels := componentlabels.GetLabels("frontend", "app", true)
+	link := mockKubernetesComponent("frontend-backend-app", `
+apiVersion: binding.operators.coreos.com/v1alpha1
+kind: ServiceBinding
+metadata:
+  name: frontend-backend-app
+  annotations:
+    odo.dev/component-link: "true"
+spec:
+  services:
+  - kind: Service
+    name: backend-app
+    version: v1`)
+
+	links, err := PushComponentLinks(client, []devfile.Component{link}, labels)
+	require.NoError(t, err)
+	require.Len(t, links, 1)
+
+	secret, err := client.KubeClient.CoreV1().Secrets(client.Namespace).Get(context.TODO(), "frontend-backend-app", metav1.GetOptions{})
+	require.NoError(t, err)
+	require.Equal(t, "8080", secret.StringData["COMPONENT_BACKEND_PORT"])
+	require.Equal(t, "backend-app", secret.Labels[ComponentLinkTargetLabel])
+
+	// the secret of the link is deleted once the link is removed from the devfile
+	_, err = PushComponentLinks(client, nil, labels)
+	require.NoError(t, err)
+	secrets, err := client.ListSecrets(ComponentLinkLabel)
+	require.NoError(t, err)
+	require.Empty(t, secrets)
+}
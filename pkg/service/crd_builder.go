@@ -1,10 +1,12 @@
 package service
 
 import (
+	"sort"
 	"strings"
 
 	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
 	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 // CRDBuilder is responsible for build the full CR including the meta and spec.
@@ -22,6 +24,15 @@ func NewCRDBuilder(crd *olm.CRDDescription) *CRDBuilder {
 	}
 }
 
+// NewCRDBuilderWithSchema returns a CRDBuilder validating the params against the OpenAPI schema of the spec of the CR
+func NewCRDBuilderWithSchema(crd *olm.CRDDescription, schema *apiextensionsv1.JSONSchemaProps) *CRDBuilder {
+	return &CRDBuilder{
+		CRDSpecBuilder: NewCRDSpecBuilderWithSchema(crd.SpecDescriptors, schema),
+		crd:            crd,
+		cr:             make(map[string]interface{}),
+	}
+}
+
 func (crb *CRDBuilder) SetAndValidate(param string, value string) error {
 	return crb.CRDSpecBuilder.SetAndValidate(param, value)
 }
@@ -43,13 +54,20 @@ func (crb *CRDBuilder) Map() (map[string]interface{}, error) {
 }
 
 // BuildCRDFromParams iterates over the parameter maps provided by the user and builds the CRD
-func BuildCRDFromParams(cr *olm.CRDDescription, paramMap map[string]string) (map[string]interface{}, error) {
+// The params are validated against the spec schema of the CRD when it is not nil, and against the spec descriptors of the CR otherwise
+func BuildCRDFromParams(cr *olm.CRDDescription, schema *apiextensionsv1.JSONSchemaProps, paramMap map[string]string) (map[string]interface{}, error) {
 
-	crBuilder := NewCRDBuilder(cr)
+	crBuilder := NewCRDBuilderWithSchema(cr, schema)
 	var errorStrs []string
 
-	for key, value := range paramMap {
-		err := crBuilder.SetAndValidate(key, value)
+	keys := make([]string, 0, len(paramMap))
+	for key := range paramMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	for _, key := range keys {
+		err := crBuilder.SetAndValidate(key, paramMap[key])
 		if err != nil {
 			errorStrs = append(errorStrs, err.Error())
 		}
@@ -66,3 +84,42 @@ func BuildCRDFromParams(cr *olm.CRDDescription, paramMap map[string]string) (map
 
 	return builtCRD, nil
 }
+
+// UpdateCRDFromParams sets the params provided by the user in the spec of an existing CR. Like for BuildCRDFromParams,
+// the params are validated against the spec schema of the CRD when it is not nil, and against the spec descriptors of the CR otherwise
+func UpdateCRDFromParams(cr *olm.CRDDescription, schema *apiextensionsv1.JSONSchemaProps, existing map[string]interface{}, paramMap map[string]string) (map[string]interface{}, error) {
+	spec, _ := existing["spec"].(map[string]interface{})
+	specBuilder, err := NewCRDSpecBuilderFromSpec(cr.SpecDescriptors, schema, spec)
+	if err != nil {
+		return nil, err
+	}
+
+	keys := make([]string, 0, len(paramMap))
+	for key := range paramMap {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	var errorStrs []string
+	for _, key := range keys {
+		err = specBuilder.SetAndValidate(key, paramMap[key])
+		if err != nil {
+			errorStrs = append(errorStrs, err.Error())
+		}
+	}
+	if len(errorStrs) > 0 {
+		return nil, errors.New(strings.Join(errorStrs, "\n"))
+	}
+
+	specMap, err := specBuilder.Map()
+	if err != nil {
+		return nil, err
+	}
+
+	updated := make(map[string]interface{}, len(existing))
+	for key, value := range existing {
+		updated[key] = value
+	}
+	updated["spec"] = specMap
+	return updated, nil
+}
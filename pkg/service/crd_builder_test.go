@@ -95,3 +95,32 @@ func TestCRDBuilderMap(t *testing.T) {
 	}
 	require.Equal(t, outMap, expected, "The map output doesn't match the expected out")
 }
+
+func TestUpdateCRDFromParams(t *testing.T) {
+	existing := map[string]interface{}{
+		"apiVersion": "etcd.database.coreos.com/v1beta2",
+		"kind":       "EtcdCluster",
+		"metadata":   map[string]interface{}{"name": "example"},
+		"spec": map[string]interface{}{
+			"size":    float64(3),
+			"version": "3.2.13",
+		},
+	}
+
+	updated, err := UpdateCRDFromParams(MockCRDDescriptionOne(), nil, existing, map[string]string{"size": "5"})
+	require.Nil(t, err, "update shouldn't fail")
+	expected := map[string]interface{}{
+		"apiVersion": "etcd.database.coreos.com/v1beta2",
+		"kind":       "EtcdCluster",
+		"metadata":   map[string]interface{}{"name": "example"},
+		"spec": map[string]interface{}{
+			"size":    float64(5),
+			"version": "3.2.13",
+		},
+	}
+	require.Equal(t, expected, updated, "The map output doesn't match the expected out")
+	require.Equal(t, float64(3), existing["spec"].(map[string]interface{})["size"], "the existing CR shouldn't be modified")
+
+	_, err = UpdateCRDFromParams(MockCRDDescriptionOne(), nil, existing, map[string]string{"seze": "5"})
+	require.NotNil(t, err, "update should fail")
+}
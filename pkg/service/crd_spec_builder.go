@@ -9,12 +9,15 @@ import (
 
 	"github.com/pkg/errors"
 	"github.com/tidwall/sjson"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 )
 
 // CRDSpecBuilder provides all the functionalities to validate and build operands (operators) spec
 // based on schema available for them.
 type CRDSpecBuilder struct {
 	descriptors []olm.SpecDescriptor
+	// schema is the OpenAPI schema of the spec, from the CRD; it is nil when the CRD is not available
+	schema *apiextensionsv1.JSONSchemaProps
 
 	builtJsonStr string
 	params       map[string]interface{}
@@ -27,10 +30,36 @@ func NewCRDSpecBuilder(descriptors []olm.SpecDescriptor) *CRDSpecBuilder {
 	}
 }
 
+// NewCRDSpecBuilderFromSpec returns a CRDSpecBuilder setting the params on top of an existing spec
+func NewCRDSpecBuilderFromSpec(descriptors []olm.SpecDescriptor, schema *apiextensionsv1.JSONSchemaProps, spec map[string]interface{}) (*CRDSpecBuilder, error) {
+	builder := NewCRDSpecBuilderWithSchema(descriptors, schema)
+	if len(spec) == 0 {
+		return builder, nil
+	}
+	jsonSpec, err := json.Marshal(spec)
+	if err != nil {
+		return nil, errors.Wrap(err, "unable to read the spec of the operand")
+	}
+	builder.builtJsonStr = string(jsonSpec)
+	return builder, nil
+}
+
+// NewCRDSpecBuilderWithSchema returns a CRDSpecBuilder validating and converting the params against the OpenAPI schema
+// of the spec, in addition to the spec descriptors
+func NewCRDSpecBuilderWithSchema(descriptors []olm.SpecDescriptor, schema *apiextensionsv1.JSONSchemaProps) *CRDSpecBuilder {
+	builder := NewCRDSpecBuilder(descriptors)
+	builder.schema = schema
+	return builder
+}
+
 // set sets the param. The param is provided in json path format. e.g. "first.name".
 // It is also responsible for parsing the values from string to an appropriate type.
 func (pb *CRDSpecBuilder) set(param string, value string) error {
-	parsedValue := pb.convertType(value)
+	return pb.setValue(param, convertType(value))
+}
+
+// setValue sets the param to an already parsed value
+func (pb *CRDSpecBuilder) setValue(param string, parsedValue interface{}) error {
 	pb.params[param] = parsedValue
 	tJsonStr, err := sjson.Set(pb.builtJsonStr, param, parsedValue)
 	if err != nil {
@@ -40,7 +69,8 @@ func (pb *CRDSpecBuilder) set(param string, value string) error {
 	return nil
 }
 
-func (pb *CRDSpecBuilder) convertType(value string) interface{} {
+// convertType parses the value to an integer, a float or a boolean, if possible
+func convertType(value string) interface{} {
 	intv, err := strconv.ParseInt(value, 10, 64)
 	if err == nil {
 		return int64(intv)
@@ -61,6 +91,15 @@ func (pb *CRDSpecBuilder) convertType(value string) interface{} {
 
 // SetAndValidate validates if a param is part of the operand schema and then sets it.
 func (pb *CRDSpecBuilder) SetAndValidate(param string, value string) error {
+	if pb.schema != nil {
+		if _, found := getSchemaAtPath(pb.schema, param); found {
+			parsedValue, err := ConvertOperandValue(pb.schema, param, value)
+			if err != nil {
+				return err
+			}
+			return pb.setValue(param, parsedValue)
+		}
+	}
 	if pb.hasParam(param) {
 		return pb.set(param, value)
 	}
@@ -79,6 +118,9 @@ func (pb *CRDSpecBuilder) hasParam(param string) bool {
 // Map returns the final map
 func (pb *CRDSpecBuilder) Map() (map[string]interface{}, error) {
 	var out map[string]interface{}
+	if pb.builtJsonStr == "" {
+		return map[string]interface{}{}, nil
+	}
 
 	err := json.Unmarshal([]byte(pb.builtJsonStr), &out)
 	return out, err
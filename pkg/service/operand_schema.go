@@ -0,0 +1,414 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/kclient"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/pkg/errors"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// OperandField is a field of the spec of an operand (the CR of an operator backed service) which can be set by the user
+type OperandField struct {
+	// Path is the path of the field in the spec, in the dotted format (e.g. "pod.resources")
+	Path        string
+	DisplayName string
+	Description string
+	// Type is the OpenAPI type of the field; it is empty when the CRD schema isn't available
+	Type     string
+	Default  string
+	Enum     []string
+	Required bool
+}
+
+// GetOperandSchema returns the OpenAPI v3 schema of the spec of the CR, as defined by the CRD for the version of the CR
+func GetOperandSchema(client *kclient.Client, cr *olm.CRDDescription) (*apiextensionsv1.JSONSchemaProps, error) {
+	crd, err := client.GetCustomResourceDefinition(cr.Name)
+	if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the CustomResourceDefinition %q", cr.Name)
+	}
+
+	for _, version := range crd.Spec.Versions {
+		if version.Name != cr.Version {
+			continue
+		}
+		if version.Schema == nil || version.Schema.OpenAPIV3Schema == nil {
+			return nil, fmt.Errorf("the CustomResourceDefinition %q doesn't define any schema for version %q", cr.Name, cr.Version)
+		}
+		spec, ok := version.Schema.OpenAPIV3Schema.Properties["spec"]
+		if !ok {
+			return nil, fmt.Errorf("the CustomResourceDefinition %q doesn't define any schema for the spec of version %q", cr.Name, cr.Version)
+		}
+		return &spec, nil
+	}
+	return nil, fmt.Errorf("the CustomResourceDefinition %q doesn't serve version %q", cr.Name, cr.Version)
+}
+
+// GetOperandFields returns the fields of the spec which can be set by the user, sorted by path. The fields are read from
+// the spec schema of the CRD (when not nil) and completed with the display names and descriptions of the CSV specDescriptors
+func GetOperandFields(specSchema *apiextensionsv1.JSONSchemaProps, descriptors []olm.SpecDescriptor) []OperandField {
+	var fields []OperandField
+	if specSchema != nil {
+		fields = getSchemaFields(specSchema, "", true)
+	}
+
+	known := map[string]int{}
+	for i, field := range fields {
+		known[field.Path] = i
+	}
+	for _, descriptor := range descriptors {
+		if i, ok := known[descriptor.Path]; ok {
+			fields[i].DisplayName = descriptor.DisplayName
+			if fields[i].Description == "" {
+				fields[i].Description = descriptor.Description
+			}
+			continue
+		}
+
+		// the descriptor can point to an object (e.g. resource requirements) which is set as a whole
+		field := OperandField{
+			Path:        descriptor.Path,
+			DisplayName: descriptor.DisplayName,
+			Description: descriptor.Description,
+		}
+		if specSchema != nil {
+			fieldSchema, found := getSchemaAtPath(specSchema, descriptor.Path)
+			if !found {
+				continue
+			}
+			if fieldSchema != nil {
+				field.Type = fieldSchema.Type
+			}
+		}
+		known[field.Path] = len(fields)
+		fields = append(fields, field)
+	}
+
+	sort.SliceStable(fields, func(i, j int) bool {
+		return fields[i].Path < fields[j].Path
+	})
+	return fields
+}
+
+// getSchemaFields returns the leaf fields of the object schema, prefixed with the given path
+func getSchemaFields(s *apiextensionsv1.JSONSchemaProps, prefix string, required bool) []OperandField {
+	var fields []OperandField
+
+	names := make([]string, 0, len(s.Properties))
+	for name := range s.Properties {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		property := s.Properties[name]
+		path := name
+		if prefix != "" {
+			path = prefix + "." + name
+		}
+		// a field is required only if all its parents are required too
+		fieldRequired := required && contains(s.Required, name)
+
+		if property.Type == "object" && len(property.Properties) > 0 {
+			fields = append(fields, getSchemaFields(&property, path, fieldRequired)...)
+			continue
+		}
+
+		field := OperandField{
+			Path:        path,
+			Description: property.Description,
+			Type:        property.Type,
+			Required:    fieldRequired,
+		}
+		if property.XIntOrString {
+			field.Type = "integer-or-string"
+		}
+		if property.Default != nil {
+			field.Default = jsonToString(property.Default.Raw)
+		}
+		for _, enum := range property.Enum {
+			field.Enum = append(field.Enum, jsonToString(enum.Raw))
+		}
+		fields = append(fields, field)
+	}
+	return fields
+}
+
+// getSchemaAtPath returns the schema of the field at the given dotted path of the spec. A nil schema is returned for
+// fields accepted by the spec schema without constraint. found is false when the field doesn't exist in the spec schema
+func getSchemaAtPath(specSchema *apiextensionsv1.JSONSchemaProps, path string) (fieldSchema *apiextensionsv1.JSONSchemaProps, found bool) {
+	current := specSchema
+	for _, segment := range strings.Split(path, ".") {
+		if current == nil {
+			return nil, true
+		}
+
+		if current.Type == "array" {
+			if _, err := strconv.Atoi(segment); err != nil {
+				return nil, false
+			}
+			if current.Items == nil || current.Items.Schema == nil {
+				return nil, true
+			}
+			current = current.Items.Schema
+			continue
+		}
+
+		if property, ok := current.Properties[segment]; ok {
+			current = &property
+			continue
+		}
+		if current.AdditionalProperties != nil && current.AdditionalProperties.Schema != nil {
+			current = current.AdditionalProperties.Schema
+			continue
+		}
+		if acceptsUnknownFields(current) {
+			return nil, true
+		}
+		return nil, false
+	}
+	return current, true
+}
+
+// ConvertOperandValue converts the value of the field at the given dotted path of the spec from a string to the type
+// expected by the spec schema. When the spec schema is nil, the type is guessed from the value
+func ConvertOperandValue(specSchema *apiextensionsv1.JSONSchemaProps, path string, value string) (interface{}, error) {
+	if specSchema == nil {
+		return convertType(value), nil
+	}
+
+	fieldSchema, found := getSchemaAtPath(specSchema, path)
+	if !found {
+		return nil, fmt.Errorf("the parameter %s is not present in the Operand Schema", path)
+	}
+	if fieldSchema == nil {
+		return convertType(value), nil
+	}
+
+	if fieldSchema.XIntOrString {
+		if intv, err := strconv.ParseInt(value, 10, 64); err == nil {
+			return intv, nil
+		}
+		return value, nil
+	}
+
+	switch fieldSchema.Type {
+	case "string":
+		return value, nil
+	case "integer":
+		intv, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("the parameter %s expects an integer, got %q", path, value)
+		}
+		return intv, nil
+	case "number":
+		floatv, err := strconv.ParseFloat(value, 64)
+		if err != nil {
+			return nil, fmt.Errorf("the parameter %s expects a number, got %q", path, value)
+		}
+		return floatv, nil
+	case "boolean":
+		boolv, err := strconv.ParseBool(value)
+		if err != nil {
+			return nil, fmt.Errorf("the parameter %s expects a boolean, got %q", path, value)
+		}
+		return boolv, nil
+	case "array", "object":
+		var out interface{}
+		err := yaml.Unmarshal([]byte(value), &out)
+		if err != nil {
+			return nil, fmt.Errorf("the parameter %s expects an %s in JSON or YAML format: %v", path, fieldSchema.Type, err)
+		}
+		return out, nil
+	}
+	return convertType(value), nil
+}
+
+// ValidateOperandSpec validates the spec of the CR against the spec schema of its CRD and returns all the errors found.
+// Nothing is validated when the spec schema is nil
+func ValidateOperandSpec(specSchema *apiextensionsv1.JSONSchemaProps, cr map[string]interface{}) error {
+	if specSchema == nil {
+		return nil
+	}
+
+	spec, ok := cr["spec"]
+	if !ok || spec == nil {
+		spec = map[string]interface{}{}
+	}
+
+	errorStrs := validateOperandValue(specSchema, spec, "spec")
+	if len(errorStrs) > 0 {
+		return fmt.Errorf("the service doesn't match the schema of its CustomResourceDefinition:\n%s", strings.Join(errorStrs, "\n"))
+	}
+	return nil
+}
+
+// validateOperandValue validates the value at the given path against the schema and returns the errors found
+func validateOperandValue(s *apiextensionsv1.JSONSchemaProps, value interface{}, path string) []string {
+	if s == nil || value == nil {
+		return nil
+	}
+
+	var errorStrs []string
+	if len(s.Enum) > 0 {
+		var allowed []string
+		valid := false
+		for _, enum := range s.Enum {
+			allowedValue := jsonToString(enum.Raw)
+			allowed = append(allowed, allowedValue)
+			if allowedValue == fmt.Sprint(value) {
+				valid = true
+			}
+		}
+		if !valid {
+			errorStrs = append(errorStrs, fmt.Sprintf("%s: unsupported value %q, supported values are: %s", path, fmt.Sprint(value), strings.Join(allowed, ", ")))
+		}
+	}
+
+	if s.XIntOrString {
+		if _, isString := value.(string); !isString && !isInteger(value) {
+			errorStrs = append(errorStrs, fmt.Sprintf("%s: expected an integer or a string", path))
+		}
+		return errorStrs
+	}
+
+	switch s.Type {
+	case "object":
+		object, ok := value.(map[string]interface{})
+		if !ok {
+			return append(errorStrs, fmt.Sprintf("%s: expected an object", path))
+		}
+		for _, required := range s.Required {
+			if _, ok := object[required]; !ok {
+				errorStrs = append(errorStrs, fmt.Sprintf("%s.%s: required field is missing", path, required))
+			}
+		}
+
+		keys := make([]string, 0, len(object))
+		for key := range object {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+		for _, key := range keys {
+			if property, ok := s.Properties[key]; ok {
+				errorStrs = append(errorStrs, validateOperandValue(&property, object[key], path+"."+key)...)
+			} else if s.AdditionalProperties != nil && s.AdditionalProperties.Schema != nil {
+				errorStrs = append(errorStrs, validateOperandValue(s.AdditionalProperties.Schema, object[key], path+"."+key)...)
+			} else if !acceptsUnknownFields(s) {
+				errorStrs = append(errorStrs, fmt.Sprintf("%s.%s: unknown field", path, key))
+			}
+		}
+
+	case "array":
+		array, ok := value.([]interface{})
+		if !ok {
+			return append(errorStrs, fmt.Sprintf("%s: expected an array", path))
+		}
+		if s.Items != nil && s.Items.Schema != nil {
+			for i, item := range array {
+				errorStrs = append(errorStrs, validateOperandValue(s.Items.Schema, item, fmt.Sprintf("%s.%d", path, i))...)
+			}
+		}
+
+	case "string":
+		str, ok := value.(string)
+		if !ok {
+			return append(errorStrs, fmt.Sprintf("%s: expected a string, got %v", path, value))
+		}
+		if s.Pattern != "" {
+			if matched, err := regexp.MatchString(s.Pattern, str); err == nil && !matched {
+				errorStrs = append(errorStrs, fmt.Sprintf("%s: %q doesn't match the pattern %q", path, str, s.Pattern))
+			}
+		}
+
+	case "integer", "number":
+		if s.Type == "integer" && !isInteger(value) {
+			return append(errorStrs, fmt.Sprintf("%s: expected an integer, got %v", path, value))
+		}
+		number, ok := toFloat(value)
+		if !ok {
+			return append(errorStrs, fmt.Sprintf("%s: expected a number, got %v", path, value))
+		}
+		if s.Minimum != nil && (number < *s.Minimum || (s.ExclusiveMinimum && number == *s.Minimum)) {
+			errorStrs = append(errorStrs, fmt.Sprintf("%s: %v is lower than the minimum %v", path, value, *s.Minimum))
+		}
+		if s.Maximum != nil && (number > *s.Maximum || (s.ExclusiveMaximum && number == *s.Maximum)) {
+			errorStrs = append(errorStrs, fmt.Sprintf("%s: %v is greater than the maximum %v", path, value, *s.Maximum))
+		}
+
+	case "boolean":
+		if _, ok := value.(bool); !ok {
+			errorStrs = append(errorStrs, fmt.Sprintf("%s: expected a boolean, got %v", path, value))
+		}
+	}
+	return errorStrs
+}
+
+// acceptsUnknownFields returns true if the object schema accepts fields which are not declared in its properties
+func acceptsUnknownFields(s *apiextensionsv1.JSONSchemaProps) bool {
+	if s.XPreserveUnknownFields != nil && *s.XPreserveUnknownFields {
+		return true
+	}
+	if s.AdditionalProperties != nil {
+		return s.AdditionalProperties.Allows
+	}
+	return len(s.Properties) == 0
+}
+
+func isInteger(value interface{}) bool {
+	switch v := value.(type) {
+	case int, int32, int64:
+		return true
+	case float64:
+		return v == float64(int64(v))
+	case json.Number:
+		_, err := v.Int64()
+		return err == nil
+	}
+	return false
+}
+
+func toFloat(value interface{}) (float64, bool) {
+	switch v := value.(type) {
+	case int:
+		return float64(v), true
+	case int32:
+		return float64(v), true
+	case int64:
+		return float64(v), true
+	case float64:
+		return v, true
+	case json.Number:
+		f, err := v.Float64()
+		return f, err == nil
+	}
+	return 0, false
+}
+
+// jsonToString returns the string representation of a raw JSON value, without the quotes of JSON strings
+func jsonToString(raw []byte) string {
+	var value interface{}
+	if err := json.Unmarshal(raw, &value); err != nil {
+		return string(raw)
+	}
+	if str, ok := value.(string); ok {
+		return str
+	}
+	return string(raw)
+}
+
+func contains(list []string, value string) bool {
+	for _, item := range list {
+		if item == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,220 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/stretchr/testify/require"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+)
+
+// mockEtcdSpecSchema returns the spec schema of a mock EtcdCluster CRD
+func mockEtcdSpecSchema(t *testing.T) *apiextensionsv1.JSONSchemaProps {
+	specSchema := `
+type: object
+required: [size]
+properties:
+  size:
+    type: integer
+    minimum: 1
+    description: The desired number of member Pods
+  version:
+    type: string
+    default: 3.2.13
+  storageType:
+    type: string
+    enum: [ephemeral, persistent]
+  tls:
+    type: boolean
+  pod:
+    type: object
+    properties:
+      labels:
+        type: object
+        additionalProperties:
+          type: string
+      resources:
+        type: object
+        properties:
+          limits:
+            type: object
+            x-kubernetes-preserve-unknown-fields: true
+  members:
+    type: array
+    items:
+      type: object
+      properties:
+        name:
+          type: string
+`
+	var s apiextensionsv1.JSONSchemaProps
+	err := yaml.Unmarshal([]byte(specSchema), &s)
+	if err != nil {
+		t.Fatalf("unable to unmarshal the schema: %v", err)
+	}
+	return &s
+}
+
+func TestGetOperandFields(t *testing.T) {
+	schema := mockEtcdSpecSchema(t)
+
+	t.Run("fields from the schema, completed by the spec descriptors", func(t *testing.T) {
+		got := GetOperandFields(schema, MockCRDDescriptionOne().SpecDescriptors)
+		want := []OperandField{
+			{Path: "members", Type: "array"},
+			{Path: "pod.labels", Type: "object"},
+			{Path: "pod.resources", DisplayName: "Resource Requirements", Description: "Limits describes the minimum/maximum amount of compute resources required/allowed", Type: "object"},
+			{Path: "pod.resources.limits", Type: "object"},
+			{Path: "size", DisplayName: "Size", Description: "The desired number of member Pods", Type: "integer", Required: true},
+			{Path: "storageType", Type: "string", Enum: []string{"ephemeral", "persistent"}},
+			{Path: "tls", Type: "boolean"},
+			{Path: "version", Type: "string", Default: "3.2.13"},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetOperandFields() = %+v, want %+v", got, want)
+		}
+	})
+
+	t.Run("fields from the spec descriptors only, when the schema is not available", func(t *testing.T) {
+		got := GetOperandFields(nil, MockCRDDescriptionOne().SpecDescriptors)
+		want := []OperandField{
+			{Path: "pod.resources", DisplayName: "Resource Requirements", Description: "Limits describes the minimum/maximum amount of compute resources required/allowed"},
+			{Path: "size", DisplayName: "Size", Description: "The desired number of member Pods for the etcd cluster."},
+		}
+		if !reflect.DeepEqual(got, want) {
+			t.Errorf("GetOperandFields() = %+v, want %+v", got, want)
+		}
+	})
+}
+
+func TestConvertOperandValue(t *testing.T) {
+	schema := mockEtcdSpecSchema(t)
+
+	tests := []struct {
+		name    string
+		schema  *apiextensionsv1.JSONSchemaProps
+		path    string
+		value   string
+		want    interface{}
+		wantErr bool
+	}{
+		{name: "integer", schema: schema, path: "size", value: "3", want: int64(3)},
+		{name: "invalid integer", schema: schema, path: "size", value: "three", wantErr: true},
+		{name: "string looking like a number", schema: schema, path: "version", value: "3.4", want: "3.4"},
+		{name: "boolean", schema: schema, path: "tls", value: "true", want: true},
+		{name: "map value", schema: schema, path: "pod.labels.tier", value: "backend", want: "backend"},
+		{name: "unconstrained field", schema: schema, path: "pod.resources.limits.cpu", value: "2", want: int64(2)},
+		{name: "array item", schema: schema, path: "members.0.name", value: "first", want: "first"},
+		{name: "array in JSON", schema: schema, path: "members", value: `[{"name": "first"}]`, want: []interface{}{map[string]interface{}{"name": "first"}}},
+		{name: "unknown field", schema: schema, path: "sise", value: "3", wantErr: true},
+		{name: "type guessed without schema", schema: nil, path: "version", value: "3.4", want: 3.4},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := ConvertOperandValue(tt.schema, tt.path, tt.value)
+			if tt.wantErr != (err != nil) {
+				t.Fatalf("ConvertOperandValue() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("ConvertOperandValue() = %#v, want %#v", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateOperandSpec(t *testing.T) {
+	schema := mockEtcdSpecSchema(t)
+
+	tests := []struct {
+		name    string
+		spec    string
+		wantErr string
+	}{
+		{
+			name: "valid spec",
+			spec: `
+spec:
+  size: 3
+  storageType: persistent
+  pod:
+    labels:
+      tier: backend
+    resources:
+      limits:
+        cpu: 1
+  members:
+  - name: first`,
+		},
+		{
+			name:    "missing required field",
+			spec:    `spec: {}`,
+			wantErr: "the service doesn't match the schema of its CustomResourceDefinition:\nspec.size: required field is missing",
+		},
+		{
+			name: "all the errors are reported",
+			spec: `
+spec:
+  size: 0
+  storageType: memory
+  tls: "yes"
+  sise: 3
+  members:
+  - name: 1`,
+			wantErr: "the service doesn't match the schema of its CustomResourceDefinition:\n" +
+				"spec.members.0.name: expected a string, got 1\n" +
+				"spec.sise: unknown field\n" +
+				"spec.size: 0 is lower than the minimum 1\n" +
+				"spec.storageType: unsupported value \"memory\", supported values are: ephemeral, persistent\n" +
+				"spec.tls: expected a boolean, got yes",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var cr map[string]interface{}
+			err := yaml.Unmarshal([]byte(tt.spec), &cr)
+			if err != nil {
+				t.Fatalf("unable to unmarshal the CR: %v", err)
+			}
+
+			err = ValidateOperandSpec(schema, cr)
+			if tt.wantErr == "" {
+				require.NoError(t, err)
+			} else {
+				require.EqualError(t, err, tt.wantErr)
+			}
+		})
+	}
+
+	t.Run("nothing is validated without schema", func(t *testing.T) {
+		require.NoError(t, ValidateOperandSpec(nil, map[string]interface{}{"spec": "invalid"}))
+	})
+}
+
+func TestBuildCRDFromParamsWithSchema(t *testing.T) {
+	cr := MockCRDDescriptionOne()
+	cr.Name = "etcdclusters.etcd.database.coreos.com"
+
+	got, err := BuildCRDFromParams(cr, mockEtcdSpecSchema(t), map[string]string{
+		"size":                     "3",
+		"version":                  "3.4",
+		"pod.resources.limits.cpu": "500m",
+	})
+	require.NoError(t, err)
+	require.Equal(t, map[string]interface{}{
+		"size":    float64(3),
+		"version": "3.4",
+		"pod": map[string]interface{}{
+			"resources": map[string]interface{}{
+				"limits": map[string]interface{}{"cpu": "500m"},
+			},
+		},
+	}, got["spec"])
+
+	_, err = BuildCRDFromParams(cr, mockEtcdSpecSchema(t), map[string]string{"size": "three", "sise": "3"})
+	require.EqualError(t, err, "the parameter sise is not present in the Operand Schema\nthe parameter size expects an integer, got \"three\"")
+
+	// the spec descriptors are still accepted when they are not part of the schema
+	_, err = BuildCRDFromParams(cr, &apiextensionsv1.JSONSchemaProps{Type: "object", Properties: map[string]apiextensionsv1.JSONSchemaProps{}, AdditionalProperties: &apiextensionsv1.JSONSchemaPropsOrBool{Allows: false}}, map[string]string{"size": "3"})
+	require.NoError(t, err)
+}
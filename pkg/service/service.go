@@ -8,6 +8,7 @@ import (
 	"github.com/ghodss/yaml"
 
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	parsercommon "github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 	"github.com/openshift/odo/pkg/kclient"
@@ -814,8 +815,15 @@ func FindDevfileServiceBinding(devfileObj parser.DevfileObj, kind string, name s
 
 // AddKubernetesComponentToDevfile adds service definition to devfile as an inlined Kubernetes component
 func AddKubernetesComponentToDevfile(crd, name string, devfileObj parser.DevfileObj) error {
+	return AddKubernetesComponentWithAttributesToDevfile(crd, name, nil, devfileObj)
+}
+
+// AddKubernetesComponentWithAttributesToDevfile adds service definition to devfile as an inlined Kubernetes component
+// with the given attributes e.g. WaitForReadyAttribute
+func AddKubernetesComponentWithAttributesToDevfile(crd, name string, componentAttributes attributes.Attributes, devfileObj parser.DevfileObj) error {
 	err := devfileObj.Data.AddComponents([]devfile.Component{{
-		Name: name,
+		Name:       name,
+		Attributes: componentAttributes,
 		ComponentUnion: devfile.ComponentUnion{
 			Kubernetes: &devfile.KubernetesComponent{
 				K8sLikeComponent: devfile.K8sLikeComponent{
@@ -860,6 +868,38 @@ func DeleteKubernetesComponentFromDevfile(name string, devfileObj parser.Devfile
 	return devfileObj.WriteYamlDevfile()
 }
 
+// GetKubernetesComponentFromDevfile returns the Kubernetes inlined component of the service of the given name, from the devfile
+func GetKubernetesComponentFromDevfile(name string, devfileObj parser.DevfileObj) (devfile.Component, error) {
+	components, err := devfileObj.Data.GetComponents(common.DevfileOptions{})
+	if err != nil {
+		return devfile.Component{}, err
+	}
+
+	for _, c := range components {
+		if c.Name == name && c.Kubernetes != nil && c.Kubernetes.Inlined != "" {
+			return c, nil
+		}
+	}
+	return devfile.Component{}, fmt.Errorf("could not find the service %q in devfile", name)
+}
+
+// UpdateKubernetesComponentInDevfile replaces the definition of the service of the given name in the devfile by the given crd.
+// The attributes of the Kubernetes inlined component are kept
+func UpdateKubernetesComponentInDevfile(crd, name string, devfileObj parser.DevfileObj) error {
+	c, err := GetKubernetesComponentFromDevfile(name, devfileObj)
+	if err != nil {
+		return err
+	}
+
+	c.Kubernetes.Inlined = crd
+	err = devfileObj.Data.UpdateComponent(c)
+	if err != nil {
+		return err
+	}
+
+	return devfileObj.WriteYamlDevfile()
+}
+
 // DynamicCRD holds the original CR obtained from the Operator (a CSV), or user
 // (when they use --from-file flag), and few other attributes that are likely
 // to be used to validate a CRD before creating a service from it
@@ -933,7 +973,8 @@ func (d *DynamicCRD) AddComponentLabelsToCRD(labels map[string]string) {
 	metaMap["labels"] = labels
 }
 
-// PushServiceFromKubernetesInlineComponents updates service(s) from Kubernetes Inlined component in a devfile by creating new ones or removing old ones
+// PushServiceFromKubernetesInlineComponents updates service(s) from Kubernetes Inlined component in a devfile by creating new ones,
+// patching the existing ones with the changes made to their definition, or removing old ones
 // returns true if the component needs to be restarted (when a service binding has been created or deleted)
 func PushServiceFromKubernetesInlineComponents(client *kclient.Client, k8sComponents []devfile.Component, labels map[string]string) (bool, error) {
 
@@ -973,12 +1014,21 @@ func PushServiceFromKubernetesInlineComponents(client *kclient.Client, k8sCompon
 
 	// create an object on the kubernetes cluster for all the Kubernetes Inlined components
 	for _, c := range k8sComponents {
+		// the links to other components are applied by odo itself, see PushComponentLinks
+		link, err := getComponentLink(c)
+		if err != nil {
+			return false, err
+		}
+		if link != nil {
+			continue
+		}
+
 		// get the string representation of the YAML definition of a CRD
 		strCRD := c.Kubernetes.Inlined
 
 		// convert the YAML definition into map[string]interface{} since it's needed to create dynamic resource
 		d := NewDynamicCRD()
-		err := yaml.Unmarshal([]byte(strCRD), &d.OriginalCRD)
+		err = yaml.Unmarshal([]byte(strCRD), &d.OriginalCRD)
 		if err != nil {
 			return false, err
 		}
@@ -1014,12 +1064,30 @@ func PushServiceFromKubernetesInlineComponents(client *kclient.Client, k8sCompon
 
 		delete(deployed, cr+"/"+crdName)
 
+		// record the configuration being applied, to compute the changes to apply on the next push
+		configuration, err := setLastAppliedConfiguration(d)
+		if err != nil {
+			return false, err
+		}
+
 		// create the service on cluster
 		err = client.CreateDynamicResource(d.OriginalCRD, group, version, resource)
 		if err != nil {
 			if strings.Contains(err.Error(), "already exists") {
-				// this could be the case when "odo push" was executed after making change to code but there was no change to the service itself
-				// TODO: better way to handle this might be introduced by https://github.com/openshift/odo/issues/4553
+				// the service exists, patch it with the changes made to its definition in the devfile, if any
+				patched, err := patchOperatorService(client, group, version, resource, crdName, configuration)
+				if err != nil {
+					return false, err
+				}
+				if patched {
+					if isLinkResource(cr) {
+						log.Successf("Updated link %q on the cluster; component will be restarted", crdName)
+						needRestart = true
+					} else {
+						log.Successf("Updated service %q on the cluster", strings.Join([]string{kind, crdName}, "/"))
+					}
+					madeChange = true
+				}
 				continue // this ensures that services slice is not updated
 			} else {
 				return false, err
@@ -1065,12 +1133,21 @@ func PushServiceFromKubernetesInlineComponents(client *kclient.Client, k8sCompon
 // if not already present in the list of owner references
 func UpdateKubernetesInlineComponentsOwnerReferences(client *kclient.Client, k8sComponents []devfile.Component, ownerReference metav1.OwnerReference) error {
 	for _, c := range k8sComponents {
+		// the links to other components are not created as Kubernetes resources
+		link, err := getComponentLink(c)
+		if err != nil {
+			return err
+		}
+		if link != nil {
+			continue
+		}
+
 		// get the string representation of the YAML definition of a CRD
 		strCRD := c.Kubernetes.Inlined
 
 		// convert the YAML definition into map[string]interface{} since it's needed to create dynamic resource
 		d := NewDynamicCRD()
-		err := yaml.Unmarshal([]byte(strCRD), &d.OriginalCRD)
+		err = yaml.Unmarshal([]byte(strCRD), &d.OriginalCRD)
 		if err != nil {
 			return err
 		}
@@ -8,6 +8,7 @@ import (
 	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	"github.com/devfile/library/pkg/devfile/parser/data/v2/common"
 
+	"github.com/devfile/api/v2/pkg/attributes"
 	"github.com/devfile/library/pkg/devfile/parser"
 	devfileCtx "github.com/devfile/library/pkg/devfile/parser/context"
 	"github.com/devfile/library/pkg/devfile/parser/data"
@@ -900,3 +901,50 @@ func TestDeleteKubernetesComponentFromDevfile(t *testing.T) {
 		})
 	}
 }
+
+func TestUpdateKubernetesComponentInDevfile(t *testing.T) {
+	fs := devfileFileSystem.NewFakeFs()
+
+	devfileData, err := data.NewDevfileData(string(data.APISchemaVersion200))
+	if err != nil {
+		t.Error(err)
+	}
+	err = devfileData.AddComponents([]v1alpha2.Component{{
+		Name:       "testName",
+		Attributes: attributes.Attributes{}.PutBoolean(WaitForReadyAttribute, true),
+		ComponentUnion: devfile.ComponentUnion{
+			Kubernetes: &devfile.KubernetesComponent{
+				K8sLikeComponent: devfile.K8sLikeComponent{
+					K8sLikeComponentLocation: devfile.K8sLikeComponentLocation{
+						Inlined: "test CRD",
+					},
+				},
+			},
+		},
+	}})
+	if err != nil {
+		t.Error(err)
+	}
+	devfileObj := parser.DevfileObj{
+		Data: devfileData,
+		Ctx:  devfileCtx.FakeContext(fs, parser.OutputDevfileYamlPath),
+	}
+
+	if err := UpdateKubernetesComponentInDevfile("updated CRD", "testName", devfileObj); err != nil {
+		t.Errorf("UpdateKubernetesComponentInDevfile() unexpected error = %v", err)
+	}
+	got, err := GetKubernetesComponentFromDevfile("testName", devfileObj)
+	if err != nil {
+		t.Errorf("GetKubernetesComponentFromDevfile() unexpected error = %v", err)
+	}
+	if got.Kubernetes.Inlined != "updated CRD" {
+		t.Errorf("Inlined = %q, want %q", got.Kubernetes.Inlined, "updated CRD")
+	}
+	if !got.Attributes.GetBoolean(WaitForReadyAttribute, nil) {
+		t.Errorf("the attributes of the component should be kept")
+	}
+
+	if err := UpdateKubernetesComponentInDevfile("updated CRD", "unknown", devfileObj); err == nil {
+		t.Errorf("UpdateKubernetesComponentInDevfile() expected an error for an unknown service")
+	}
+}
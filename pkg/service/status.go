@@ -0,0 +1,347 @@
+package service
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"time"
+
+	devfile "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/preference"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/klog"
+)
+
+const (
+	// WaitForReadyAttribute is the attribute of the Kubernetes inlined component of a service
+	// which makes "odo push" wait for the service to be ready before starting the component
+	WaitForReadyAttribute = "odo.dev/wait-for-ready"
+
+	// StateReady is the state of a service reporting it is ready
+	StateReady = "Ready"
+	// StateNotReady is the state of a service reporting it is not ready yet
+	StateNotReady = "NotReady"
+	// StateUnknown is the state of a service which doesn't report its readiness
+	StateUnknown = "Unknown"
+
+	// phaseXDescriptor is the CSV status descriptor used by operators to expose the phase of a CR
+	phaseXDescriptor = "urn:alm:descriptor:io.kubernetes.phase"
+	// conditionsXDescriptor is the CSV status descriptor used by operators to expose the conditions of a CR
+	conditionsXDescriptor = "urn:alm:descriptor:io.kubernetes.conditions"
+)
+
+// readyConditionTypes are the condition types that operators commonly use to report readiness
+var readyConditionTypes = []string{"Ready", "Available", "Running", "Succeeded", "Healthy", "Complete"}
+
+// readyPhases are the phases that operators commonly use to report readiness
+var readyPhases = []string{"ready", "running", "available", "succeeded", "healthy", "complete", "completed", "active"}
+
+// OperatorServiceStatus is the status of an operator backed service as reported by its custom resource
+type OperatorServiceStatus struct {
+	State      string                                 `json:"state"`
+	Phase      string                                 `json:"phase,omitempty"`
+	Conditions []machineoutput.ServiceStatusCondition `json:"conditions,omitempty"`
+}
+
+// IsReady returns true if the service reports it is ready
+func (s OperatorServiceStatus) IsReady() bool {
+	return s.State == StateReady
+}
+
+// GetOperatorServiceStatus computes the status of an operator backed service from the status.conditions
+// of its custom resource or, when there are none, from the CSV statusDescriptors of the CRD (when known)
+func GetOperatorServiceStatus(u *unstructured.Unstructured, crd *olm.CRDDescription) OperatorServiceStatus {
+	status := OperatorServiceStatus{State: StateUnknown}
+
+	conditionsPath := []string{"status", "conditions"}
+	phasePath := []string{"status", "phase"}
+	if crd != nil {
+		for _, descriptor := range crd.StatusDescriptors {
+			for _, xDescriptor := range descriptor.XDescriptors {
+				switch xDescriptor {
+				case conditionsXDescriptor:
+					conditionsPath = append([]string{"status"}, strings.Split(descriptor.Path, ".")...)
+				case phaseXDescriptor:
+					phasePath = append([]string{"status"}, strings.Split(descriptor.Path, ".")...)
+				}
+			}
+		}
+	}
+
+	rawConditions, found, _ := unstructured.NestedSlice(u.Object, conditionsPath...)
+	if found {
+		for _, raw := range rawConditions {
+			condition, ok := raw.(map[string]interface{})
+			if !ok {
+				continue
+			}
+			status.Conditions = append(status.Conditions, machineoutput.ServiceStatusCondition{
+				Type:    fmt.Sprint(condition["type"]),
+				Status:  fmt.Sprint(condition["status"]),
+				Reason:  stringValue(condition["reason"]),
+				Message: stringValue(condition["message"]),
+			})
+		}
+	}
+	status.Phase, _, _ = unstructured.NestedString(u.Object, phasePath...)
+
+	for _, condition := range status.Conditions {
+		if !containsFold(readyConditionTypes, condition.Type) {
+			continue
+		}
+		if strings.EqualFold(condition.Status, "True") {
+			status.State = StateReady
+			return status
+		}
+		status.State = StateNotReady
+	}
+
+	// the conditions take precedence over the phase
+	if status.State == StateUnknown && status.Phase != "" {
+		if containsFold(readyPhases, status.Phase) {
+			status.State = StateReady
+		} else {
+			status.State = StateNotReady
+		}
+	}
+	return status
+}
+
+// DescribeOperatorService returns the description, including the status, of the operator backed service deployed in the cluster
+// serviceName is of the form <service-kind>/<service-name>
+func DescribeOperatorService(client *kclient.Client, serviceName string) (OperatorServiceDescription, error) {
+	kind, name, err := SplitServiceKindName(serviceName)
+	if err != nil {
+		return OperatorServiceDescription{}, err
+	}
+
+	cr, err := GetCRDescription(client, kind)
+	if err != nil {
+		return OperatorServiceDescription{}, err
+	}
+
+	group, version, resource, err := GetGVRFromCR(cr)
+	if err != nil {
+		return OperatorServiceDescription{}, err
+	}
+
+	u, err := client.GetDynamicResource(group, version, resource, name)
+	if err != nil {
+		return OperatorServiceDescription{}, err
+	}
+
+	spec, _, _ := unstructured.NestedMap(u.Object, "spec")
+	return OperatorServiceDescription{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       u.GetKind(),
+			APIVersion: u.GetAPIVersion(),
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              u.GetName(),
+			Namespace:         u.GetNamespace(),
+			Labels:            u.GetLabels(),
+			CreationTimestamp: u.GetCreationTimestamp(),
+		},
+		Spec:   spec,
+		Status: GetOperatorServiceStatus(u, cr),
+	}, nil
+}
+
+// WaitForOperatorServiceReady waits until the operator backed service reports it is ready, or the timeout is reached.
+// onChange is called with the initial status and then every time the status of the service changes.
+// The returned status is in the Unknown state, without error, when the service exposes no readiness signal to wait for
+func WaitForOperatorServiceReady(client *kclient.Client, serviceName string, timeout time.Duration, onChange func(status OperatorServiceStatus)) (OperatorServiceStatus, error) {
+	kind, name, err := SplitServiceKindName(serviceName)
+	if err != nil {
+		return OperatorServiceStatus{}, err
+	}
+
+	cr, err := GetCRDescription(client, kind)
+	if err != nil {
+		return OperatorServiceStatus{}, err
+	}
+
+	group, version, resource, err := GetGVRFromCR(cr)
+	if err != nil {
+		return OperatorServiceStatus{}, err
+	}
+
+	w, err := client.WatchDynamicResource(group, version, resource, name)
+	if err != nil {
+		return OperatorServiceStatus{}, errors.Wrapf(err, "unable to watch service %q", serviceName)
+	}
+	defer w.Stop()
+
+	var previous *OperatorServiceStatus
+	update := func(u *unstructured.Unstructured) OperatorServiceStatus {
+		current := GetOperatorServiceStatus(u, cr)
+		if previous == nil || !reflect.DeepEqual(*previous, current) {
+			onChange(current)
+		}
+		previous = &current
+		return current
+	}
+
+	u, err := client.GetDynamicResource(group, version, resource, name)
+	if err != nil {
+		return OperatorServiceStatus{}, err
+	}
+	if status := update(u); status.IsReady() || !reportsReadiness(u, cr, status) {
+		return status, nil
+	}
+
+	timeoutChan := time.After(timeout)
+	for {
+		select {
+		case <-timeoutChan:
+			if previous.State == StateUnknown {
+				// the service never reported its readiness, there was nothing to wait for
+				return *previous, nil
+			}
+			return *previous, fmt.Errorf("timeout while waiting for service %q to be ready, current state is %q", serviceName, previous.State)
+
+		case val, ok := <-w.ResultChan():
+			if !ok {
+				return *previous, errors.New("error getting value from resultchan")
+			}
+			u, ok := val.Object.(*unstructured.Unstructured)
+			if !ok {
+				continue
+			}
+			if status := update(u); status.IsReady() || !reportsReadiness(u, cr, status) {
+				return status, nil
+			}
+		}
+	}
+}
+
+// reportsReadiness returns false when the operator has reconciled the service, which has a status,
+// but neither its status nor the statusDescriptors of its CR expose conditions or a phase to wait for
+func reportsReadiness(u *unstructured.Unstructured, crd *olm.CRDDescription, status OperatorServiceStatus) bool {
+	if status.State != StateUnknown || hasReadinessDescriptors(crd) {
+		return true
+	}
+	_, hasStatus, _ := unstructured.NestedFieldNoCopy(u.Object, "status")
+	return !hasStatus
+}
+
+// hasReadinessDescriptors returns true if the CSV declares statusDescriptors for the conditions or the phase of the CR
+func hasReadinessDescriptors(crd *olm.CRDDescription) bool {
+	if crd == nil {
+		return false
+	}
+	for _, descriptor := range crd.StatusDescriptors {
+		for _, xDescriptor := range descriptor.XDescriptors {
+			if xDescriptor == conditionsXDescriptor || xDescriptor == phaseXDescriptor {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// WaitForServicesFromKubernetesInlineComponents waits for the services of the Kubernetes inlined components
+// marked with the WaitForReadyAttribute to be ready. The status transitions are logged and reported as machine events
+func WaitForServicesFromKubernetesInlineComponents(client *kclient.Client, k8sComponents []devfile.Component) error {
+	loggingClient := machineoutput.NewMachineEventLoggingClient()
+
+	// Try to grab the preference in order to set a timeout.. but if not, we'll use the default.
+	timeout := preference.DefaultPushTimeout * time.Second
+	cfg, configReadErr := preference.New()
+	if configReadErr != nil {
+		klog.V(3).Info(errors.Wrap(configReadErr, "unable to read config file"))
+	} else {
+		timeout = time.Duration(cfg.GetPushTimeout()) * time.Second
+	}
+
+	for _, c := range k8sComponents {
+		if c.Kubernetes == nil || !c.Attributes.GetBoolean(WaitForReadyAttribute, nil) {
+			continue
+		}
+
+		var crd map[string]interface{}
+		err := yaml.Unmarshal([]byte(c.Kubernetes.Inlined), &crd)
+		if err != nil {
+			return err
+		}
+		kind, ok := crd["kind"].(string)
+		if !ok || isLinkResource(kind) {
+			continue
+		}
+		name, ok := getCRDName(crd)
+		if !ok {
+			continue
+		}
+		serviceName := strings.Join([]string{kind, name}, "/")
+
+		s := log.Spinnerf("Waiting for service %q to be ready", serviceName)
+		status, err := WaitForOperatorServiceReady(client, serviceName, timeout, func(status OperatorServiceStatus) {
+			klog.V(3).Infof("Service %q is in state %q: %v", serviceName, status.State, status.Conditions)
+			loggingClient.ServiceStatus(name, kind, status.State, status.Conditions, machineoutput.TimestampNow())
+		})
+		if err != nil {
+			s.End(false)
+			if message := status.NotReadyMessage(); message != "" {
+				return errors.Wrap(err, message)
+			}
+			return err
+		}
+		if status.State == StateUnknown {
+			s.End(false)
+			log.Warningf("Service %q doesn't report its readiness, continuing without waiting for it", serviceName)
+			continue
+		}
+		s.End(true)
+	}
+	return nil
+}
+
+// NotReadyMessage returns the messages of the conditions explaining why the service is not ready, if any
+func (s OperatorServiceStatus) NotReadyMessage() string {
+	var messages []string
+	for _, condition := range s.Conditions {
+		if condition.Message != "" && !strings.EqualFold(condition.Status, "True") {
+			messages = append(messages, fmt.Sprintf("%s: %s", condition.Type, condition.Message))
+		}
+	}
+	return strings.Join(messages, "; ")
+}
+
+// GetCRDescription returns the description of the CR of the given kind, from the Operator providing it
+func GetCRDescription(client *kclient.Client, kind string) (*olm.CRDDescription, error) {
+	csv, err := client.GetCSVWithCR(kind)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, c := range *client.GetCustomResourcesFromCSV(csv) {
+		customResource := c
+		if customResource.Kind == kind {
+			return &customResource, nil
+		}
+	}
+	return nil, fmt.Errorf("unable to find any Operator providing the service %q", kind)
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+func stringValue(value interface{}) string {
+	if value == nil {
+		return ""
+	}
+	return fmt.Sprint(value)
+}
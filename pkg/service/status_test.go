@@ -0,0 +1,202 @@
+package service
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/machineoutput"
+	olm "github.com/operator-framework/api/pkg/operators/v1alpha1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+)
+
+func TestGetOperatorServiceStatus(t *testing.T) {
+	tests := []struct {
+		name    string
+		cr      string
+		crd     *olm.CRDDescription
+		want    OperatorServiceStatus
+		wantMsg string
+	}{
+		{
+			name: "case 1: no status at all",
+			cr: `
+kind: EtcdCluster
+metadata:
+  name: example`,
+			want: OperatorServiceStatus{State: StateUnknown},
+		},
+		{
+			name: "case 2: ready condition is true",
+			cr: `
+kind: Database
+status:
+  conditions:
+  - type: Initialized
+    status: "True"
+  - type: Ready
+    status: "True"`,
+			want: OperatorServiceStatus{
+				State: StateReady,
+				Conditions: []machineoutput.ServiceStatusCondition{
+					{Type: "Initialized", Status: "True"},
+					{Type: "Ready", Status: "True"},
+				},
+			},
+		},
+		{
+			name: "case 3: ready condition is false, conditions take precedence over the phase",
+			cr: `
+kind: Database
+status:
+  phase: Running
+  conditions:
+  - type: Ready
+    status: "False"
+    reason: Provisioning
+    message: waiting for the volume`,
+			want: OperatorServiceStatus{
+				State: StateNotReady,
+				Phase: "Running",
+				Conditions: []machineoutput.ServiceStatusCondition{
+					{Type: "Ready", Status: "False", Reason: "Provisioning", Message: "waiting for the volume"},
+				},
+			},
+			wantMsg: "Ready: waiting for the volume",
+		},
+		{
+			name: "case 4: phase from the default path",
+			cr: `
+kind: EtcdCluster
+status:
+  phase: Creating`,
+			want: OperatorServiceStatus{State: StateNotReady, Phase: "Creating"},
+		},
+		{
+			name: "case 5: phase from the CSV status descriptors",
+			cr: `
+kind: EtcdCluster
+status:
+  clusterState: running`,
+			crd: &olm.CRDDescription{
+				StatusDescriptors: []olm.StatusDescriptor{
+					{
+						Path:         "clusterState",
+						XDescriptors: []string{"urn:alm:descriptor:io.kubernetes.phase"},
+					},
+				},
+			},
+			want: OperatorServiceStatus{State: StateReady, Phase: "running"},
+		},
+		{
+			name: "case 6: conditions from the CSV status descriptors",
+			cr: `
+kind: EtcdCluster
+status:
+  health:
+    conditions:
+    - type: Available
+      status: "True"`,
+			crd: &olm.CRDDescription{
+				StatusDescriptors: []olm.StatusDescriptor{
+					{
+						Path:         "health.conditions",
+						XDescriptors: []string{"urn:alm:descriptor:io.kubernetes.conditions"},
+					},
+				},
+			},
+			want: OperatorServiceStatus{
+				State: StateReady,
+				Conditions: []machineoutput.ServiceStatusCondition{
+					{Type: "Available", Status: "True"},
+				},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u unstructured.Unstructured
+			err := yaml.Unmarshal([]byte(tt.cr), &u.Object)
+			if err != nil {
+				t.Fatalf("unable to unmarshal CR: %v", err)
+			}
+
+			got := GetOperatorServiceStatus(&u, tt.crd)
+			if !reflect.DeepEqual(got, tt.want) {
+				t.Errorf("GetOperatorServiceStatus() = %+v, want %+v", got, tt.want)
+			}
+			if msg := got.NotReadyMessage(); msg != tt.wantMsg {
+				t.Errorf("NotReadyMessage() = %q, want %q", msg, tt.wantMsg)
+			}
+		})
+	}
+}
+
+func TestReportsReadiness(t *testing.T) {
+	phaseDescriptor := &olm.CRDDescription{
+		StatusDescriptors: []olm.StatusDescriptor{
+			{Path: "state", XDescriptors: []string{"urn:alm:descriptor:io.kubernetes.phase"}},
+		},
+	}
+	tests := []struct {
+		name string
+		cr   string
+		crd  *olm.CRDDescription
+		want bool
+	}{
+		{
+			name: "case 1: not reconciled yet",
+			cr: `
+kind: EtcdCluster`,
+			want: true,
+		},
+		{
+			name: "case 2: status without conditions nor phase",
+			cr: `
+kind: EtcdCluster
+status:
+  members: 3`,
+			want: false,
+		},
+		{
+			name: "case 3: status with conditions unrelated to readiness",
+			cr: `
+kind: EtcdCluster
+status:
+  conditions:
+  - type: Reconciled
+    status: "True"`,
+			want: false,
+		},
+		{
+			name: "case 4: phase",
+			cr: `
+kind: EtcdCluster
+status:
+  phase: Creating`,
+			want: true,
+		},
+		{
+			name: "case 5: phase declared by the statusDescriptors but not set yet",
+			cr: `
+kind: EtcdCluster
+status:
+  members: 3`,
+			crd:  phaseDescriptor,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var u unstructured.Unstructured
+			err := yaml.Unmarshal([]byte(tt.cr), &u.Object)
+			if err != nil {
+				t.Fatalf("unable to unmarshal CR: %v", err)
+			}
+
+			if got := reportsReadiness(&u, tt.crd, GetOperatorServiceStatus(&u, tt.crd)); got != tt.want {
+				t.Errorf("reportsReadiness() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
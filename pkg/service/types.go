@@ -53,6 +53,14 @@ type ServicePlanParameter struct {
 	validation.Validatable `json:",inline,omitempty"`
 }
 
+// OperatorServiceDescription holds the information about an operator backed service deployed in the cluster
+type OperatorServiceDescription struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              map[string]interface{} `json:"spec,omitempty"`
+	Status            OperatorServiceStatus  `json:"status"`
+}
+
 type ServiceList struct {
 	metav1.TypeMeta `json:",inline"`
 	metav1.ListMeta `json:"metadata,omitempty"`
@@ -0,0 +1,149 @@
+package service
+
+import (
+	"encoding/json"
+	"fmt"
+
+	jsonpatch "github.com/evanphx/json-patch"
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/pkg/errors"
+	"k8s.io/klog"
+)
+
+// LastAppliedConfigurationAnnotation is the annotation of the operator backed services created by odo, containing the
+// definition of the service as it was last pushed. It is used to compute the changes to apply on the next push
+const LastAppliedConfigurationAnnotation = "odo.dev/last-applied-configuration"
+
+// ValidateOperatorServiceDefinition validates the definition of the operator backed service of the given name, of the form
+// <service-kind>/<service-name>. The kind and name of the service can't be changed; its spec is validated against the
+// schema of its CRD, when the client is able to get it
+func ValidateOperatorServiceDefinition(client *kclient.Client, serviceName string, cr map[string]interface{}) error {
+	kind, name, err := SplitServiceKindName(serviceName)
+	if err != nil {
+		return err
+	}
+
+	if crKind, _ := cr["kind"].(string); crKind != kind {
+		return fmt.Errorf("the kind of the service can't be changed, expected %q but found %q", kind, crKind)
+	}
+
+	d := NewDynamicCRD()
+	d.OriginalCRD = cr
+	err = d.ValidateMetadataInCRD()
+	if err != nil {
+		return err
+	}
+	crName, err := d.GetServiceNameFromCRD()
+	if err != nil {
+		return err
+	}
+	if crName != name {
+		return fmt.Errorf("the name of the service can't be changed, expected %q but found %q", name, crName)
+	}
+
+	if client == nil {
+		return nil
+	}
+	crDescription, err := GetCRDescription(client, kind)
+	if err != nil {
+		klog.V(2).Infof("Unable to find the Operator providing %q, the service will not be validated against its schema: %v", kind, err)
+		return nil
+	}
+	schema, err := GetOperandSchema(client, crDescription)
+	if err != nil {
+		klog.V(2).Infof("Unable to get the schema of %q, the service will not be validated against it: %v", kind, err)
+		return nil
+	}
+	return ValidateOperandSpec(schema, cr)
+}
+
+// setLastAppliedConfiguration sets the LastAppliedConfigurationAnnotation on the CR and returns the configuration it contains
+func setLastAppliedConfiguration(d *DynamicCRD) ([]byte, error) {
+	metadata, ok := d.OriginalCRD["metadata"].(map[string]interface{})
+	if !ok {
+		return nil, fmt.Errorf("couldn't find \"metadata\" in the yaml; need metadata start the service")
+	}
+	// the annotation must not be part of the configuration itself
+	if annotations, ok := metadata["annotations"].(map[string]interface{}); ok {
+		delete(annotations, LastAppliedConfigurationAnnotation)
+		if len(annotations) == 0 {
+			delete(metadata, "annotations")
+		}
+	}
+
+	configuration, err := json.Marshal(d.OriginalCRD)
+	if err != nil {
+		return nil, err
+	}
+
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+	annotations[LastAppliedConfigurationAnnotation] = string(configuration)
+	return configuration, nil
+}
+
+// getOperatorServicePatch returns the JSON merge patch to apply on a service last applied with the lastApplied
+// configuration in order to apply the desired configuration. Fields removed from the configuration are removed
+// from the service, while the fields set by the Operator itself are kept. An empty patch is returned when there
+// are no changes to apply
+func getOperatorServicePatch(lastApplied string, desired []byte) ([]byte, error) {
+	if lastApplied == "" {
+		// the service has been created before odo started to record the configuration, nothing can be removed
+		lastApplied = "{}"
+	}
+
+	patch, err := jsonpatch.CreateMergePatch([]byte(lastApplied), desired)
+	if err != nil {
+		return nil, err
+	}
+
+	var patchMap map[string]interface{}
+	err = json.Unmarshal(patch, &patchMap)
+	if err != nil {
+		return nil, err
+	}
+	if len(patchMap) == 0 {
+		return nil, nil
+	}
+
+	// record the new configuration as part of the patch
+	metadata, ok := patchMap["metadata"].(map[string]interface{})
+	if !ok {
+		metadata = map[string]interface{}{}
+		patchMap["metadata"] = metadata
+	}
+	annotations, ok := metadata["annotations"].(map[string]interface{})
+	if !ok {
+		annotations = map[string]interface{}{}
+		metadata["annotations"] = annotations
+	}
+	annotations[LastAppliedConfigurationAnnotation] = string(desired)
+
+	return json.Marshal(patchMap)
+}
+
+// patchOperatorService patches the service deployed on the cluster with the changes between its last applied configuration
+// and the desired one. It returns true if the service has been patched
+func patchOperatorService(client *kclient.Client, group, version, resource, name string, desired []byte) (bool, error) {
+	live, err := client.GetDynamicResource(group, version, resource, name)
+	if err != nil {
+		return false, err
+	}
+
+	patch, err := getOperatorServicePatch(live.GetAnnotations()[LastAppliedConfigurationAnnotation], desired)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to compute the changes to apply on the service %q", name)
+	}
+	if patch == nil {
+		return false, nil
+	}
+
+	err = client.PatchDynamicResource(group, version, resource, name, patch)
+	if err != nil {
+		return false, errors.Wrapf(err, "unable to update the service %q", name)
+	}
+	return true, nil
+}
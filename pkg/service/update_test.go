@@ -0,0 +1,97 @@
+package service
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestGetOperatorServicePatch(t *testing.T) {
+	desired := `{"apiVersion":"etcd.database.coreos.com/v1beta2","kind":"EtcdCluster","metadata":{"name":"example"},"spec":{"size":5}}`
+
+	tests := []struct {
+		name        string
+		lastApplied string
+		desired     string
+		want        map[string]interface{}
+	}{
+		{
+			name:        "case 1: no changes",
+			lastApplied: desired,
+			desired:     desired,
+			want:        nil,
+		},
+		{
+			name:        "case 2: changed and removed fields",
+			lastApplied: `{"apiVersion":"etcd.database.coreos.com/v1beta2","kind":"EtcdCluster","metadata":{"name":"example"},"spec":{"size":3,"version":"3.2.13"}}`,
+			desired:     desired,
+			want: map[string]interface{}{
+				"metadata": map[string]interface{}{
+					"annotations": map[string]interface{}{LastAppliedConfigurationAnnotation: desired},
+				},
+				"spec": map[string]interface{}{"size": float64(5), "version": nil},
+			},
+		},
+		{
+			name:        "case 3: no configuration recorded, nothing is removed",
+			lastApplied: "",
+			desired:     desired,
+			want: map[string]interface{}{
+				"apiVersion": "etcd.database.coreos.com/v1beta2",
+				"kind":       "EtcdCluster",
+				"metadata": map[string]interface{}{
+					"name":        "example",
+					"annotations": map[string]interface{}{LastAppliedConfigurationAnnotation: desired},
+				},
+				"spec": map[string]interface{}{"size": float64(5)},
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			patch, err := getOperatorServicePatch(tt.lastApplied, []byte(tt.desired))
+			require.NoError(t, err)
+			if tt.want == nil {
+				require.Nil(t, patch)
+				return
+			}
+
+			var got map[string]interface{}
+			require.NoError(t, json.Unmarshal(patch, &got))
+			require.Equal(t, tt.want, got)
+		})
+	}
+}
+
+func TestSetLastAppliedConfiguration(t *testing.T) {
+	d := NewDynamicCRD()
+	d.OriginalCRD = map[string]interface{}{
+		"kind": "EtcdCluster",
+		"metadata": map[string]interface{}{
+			"name": "example",
+			"annotations": map[string]interface{}{
+				LastAppliedConfigurationAnnotation: "previous configuration",
+			},
+		},
+	}
+
+	configuration, err := setLastAppliedConfiguration(d)
+	require.NoError(t, err)
+	require.JSONEq(t, `{"kind":"EtcdCluster","metadata":{"name":"example"}}`, string(configuration))
+	require.Equal(t, map[string]interface{}{
+		LastAppliedConfigurationAnnotation: string(configuration),
+	}, d.OriginalCRD["metadata"].(map[string]interface{})["annotations"])
+}
+
+func TestValidateOperatorServiceDefinition(t *testing.T) {
+	cr := map[string]interface{}{
+		"kind":     "EtcdCluster",
+		"metadata": map[string]interface{}{"name": "example"},
+	}
+
+	require.NoError(t, ValidateOperatorServiceDefinition(nil, "EtcdCluster/example", cr))
+	require.EqualError(t, ValidateOperatorServiceDefinition(nil, "EtcdCluster/other", cr), `the name of the service can't be changed, expected "other" but found "example"`)
+	require.EqualError(t, ValidateOperatorServiceDefinition(nil, "Pgcluster/example", cr), `the kind of the service can't be changed, expected "Pgcluster" but found "EtcdCluster"`)
+	require.Error(t, ValidateOperatorServiceDefinition(nil, "EtcdCluster/example", map[string]interface{}{"kind": "EtcdCluster"}))
+}
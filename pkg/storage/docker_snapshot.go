@@ -0,0 +1,205 @@
+package storage
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/pkg/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog"
+)
+
+// dockerSnapshotSuffix is the suffix of the tar backups of the Docker volumes
+const dockerSnapshotSuffix = ".tar"
+
+// dockerSnapshotsDir is the directory of the tar backups of the Docker volumes of the storage,
+// in a directory per component and storage
+var dockerSnapshotsDir = getDefaultDockerSnapshotsDir()
+
+func getDefaultDockerSnapshotsDir() string {
+	dir, err := os.UserHomeDir()
+	if err != nil {
+		dir = os.TempDir()
+	}
+	return filepath.Join(dir, ".odo", "snapshots")
+}
+
+// StorageMounter mounts the storage of a component in a container to run commands on it, like the Docker adapter
+type StorageMounter interface {
+	common.ExecClient
+	MountStorage(storageName string) (common.StorageMount, error)
+	UnmountStorage(mount common.StorageMount) error
+}
+
+// CreateDockerSnapshot takes a snapshot of the Docker volume of the storage of the component, as a local tar backup
+func CreateDockerSnapshot(mounter StorageMounter, snapshotName, storageName, componentName string) (Snapshot, error) {
+	if errs := validation.IsDNS1123Label(snapshotName); len(errs) > 0 {
+		return Snapshot{}, errors.Errorf("invalid snapshot name %q: %s", snapshotName, strings.Join(errs, " "))
+	}
+	if _, err := getDockerSnapshotPath(snapshotName, componentName); err == nil {
+		return Snapshot{}, errors.Errorf("the snapshot %s already exists", snapshotName)
+	}
+
+	dir := filepath.Join(dockerSnapshotsDir, componentName, storageName)
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return Snapshot{}, errors.Wrapf(err, "unable to create the directory %s", dir)
+	}
+	backupPath := filepath.Join(dir, snapshotName+dockerSnapshotSuffix)
+
+	err := withStorageMount(mounter, storageName, func(mount common.StorageMount) error {
+		// the backup is written to a temporary file first, to never keep a partial backup
+		file, err := ioutil.TempFile(dir, snapshotName)
+		if err != nil {
+			return errors.Wrap(err, "unable to create the backup file")
+		}
+		defer os.Remove(file.Name())
+		err = common.ArchiveStorage(mounter, mount, file)
+		if closeErr := file.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			return err
+		}
+		return os.Rename(file.Name(), backupPath)
+	})
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	return getDockerSnapshot(backupPath)
+}
+
+// ListDockerSnapshots lists the local tar backups of the Docker volumes of the storage of the component,
+// or of all the storage of the component if storageName is empty
+func ListDockerSnapshots(storageName, componentName string) (SnapshotList, error) {
+	if storageName == "" {
+		storageName = "*"
+	}
+	paths, err := filepath.Glob(filepath.Join(dockerSnapshotsDir, componentName, storageName, "*"+dockerSnapshotSuffix))
+	if err != nil {
+		return SnapshotList{}, err
+	}
+	sort.Strings(paths)
+
+	snapshots := []Snapshot{}
+	for _, path := range paths {
+		snapshot, err := getDockerSnapshot(path)
+		if err != nil {
+			return SnapshotList{}, err
+		}
+		snapshots = append(snapshots, snapshot)
+	}
+	return SnapshotList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: apiVersion,
+		},
+		Items: snapshots,
+	}, nil
+}
+
+// DeleteDockerSnapshot deletes the local tar backup of a Docker volume of the component
+func DeleteDockerSnapshot(snapshotName, componentName string) error {
+	path, err := getDockerSnapshotPath(snapshotName, componentName)
+	if err != nil {
+		return err
+	}
+	return os.Remove(path)
+}
+
+// RestoreDockerSnapshot replaces the content of the Docker volume of the storage of the snapshot by the content of its tar backup
+func RestoreDockerSnapshot(mounter StorageMounter, snapshotName, componentName string) (Snapshot, error) {
+	path, err := getDockerSnapshotPath(snapshotName, componentName)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	snapshot, err := getDockerSnapshot(path)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	err = withStorageMount(mounter, snapshot.Spec.Storage, func(mount common.StorageMount) error {
+		file, err := os.Open(path)
+		if err != nil {
+			return errors.Wrapf(err, "unable to open the backup %s", path)
+		}
+		defer file.Close()
+
+		spinner := log.Spinnerf("Restoring the volume of the storage %s", snapshot.Spec.Storage)
+		defer spinner.End(false)
+		if err = common.RestoreStorage(mounter, mount, file); err != nil {
+			return errors.Wrapf(err, "unable to restore the storage %s, its data may need to be restored again from the snapshot %s, which has been kept", snapshot.Spec.Storage, snapshotName)
+		}
+		spinner.End(true)
+		return nil
+	})
+	if err != nil {
+		return Snapshot{}, err
+	}
+	return snapshot, nil
+}
+
+// withStorageMount runs the function with the storage mounted in a container of the component,
+// or in a helper container when the component is not running
+func withStorageMount(mounter StorageMounter, storageName string, f func(mount common.StorageMount) error) error {
+	mount, err := mounter.MountStorage(storageName)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if err := mounter.UnmountStorage(mount); err != nil {
+			log.Warningf("%v", err)
+		}
+	}()
+	if mount.Helper {
+		klog.V(3).Infof("The component is not running, a helper container is used to access the storage %s", storageName)
+	}
+	return f(mount)
+}
+
+// getDockerSnapshotPath returns the path of the local tar backup of a Docker volume of the component
+func getDockerSnapshotPath(snapshotName, componentName string) (string, error) {
+	if snapshotName == "" || strings.ContainsAny(snapshotName, `/\*?[`) {
+		return "", errors.Errorf("invalid snapshot name %q", snapshotName)
+	}
+	paths, err := filepath.Glob(filepath.Join(dockerSnapshotsDir, componentName, "*", snapshotName+dockerSnapshotSuffix))
+	if err != nil {
+		return "", err
+	}
+	if len(paths) == 0 {
+		return "", errors.Errorf("the snapshot %s doesn't exist", snapshotName)
+	}
+	return paths[0], nil
+}
+
+// getDockerSnapshot returns the snapshot of the storage from its local tar backup
+func getDockerSnapshot(path string) (Snapshot, error) {
+	stat, err := os.Stat(path)
+	if err != nil {
+		return Snapshot{}, errors.Wrapf(err, "unable to read the backup %s", path)
+	}
+	return Snapshot{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Snapshot",
+			APIVersion: apiVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              strings.TrimSuffix(filepath.Base(path), dockerSnapshotSuffix),
+			CreationTimestamp: metav1.NewTime(stat.ModTime()),
+		},
+		Spec: SnapshotSpec{
+			Storage: filepath.Base(filepath.Dir(path)),
+		},
+		Status: SnapshotStatus{
+			ReadyToUse:  true,
+			RestoreSize: resource.NewQuantity(stat.Size(), resource.BinarySI).String(),
+		},
+	}, nil
+}
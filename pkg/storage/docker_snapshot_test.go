@@ -0,0 +1,98 @@
+package storage
+
+import (
+	"io"
+	"io/ioutil"
+	"os"
+	"testing"
+
+	"github.com/openshift/odo/pkg/devfile/adapters/common"
+)
+
+// fakeStorageMounter archives and restores the content of a storage as a string
+type fakeStorageMounter struct {
+	content  string
+	unmounts int
+}
+
+func (f *fakeStorageMounter) MountStorage(storageName string) (common.StorageMount, error) {
+	return common.StorageMount{Path: "/data", Helper: true}, nil
+}
+
+func (f *fakeStorageMounter) UnmountStorage(mount common.StorageMount) error {
+	f.unmounts++
+	return nil
+}
+
+func (f *fakeStorageMounter) ExecCMDInContainer(info common.ComponentInfo, cmd []string, stdout io.Writer, stderr io.Writer, stdin io.Reader, show bool) error {
+	switch {
+	case cmd[0] == "tar" && cmd[1] == "cf":
+		_, err := io.WriteString(stdout, f.content)
+		return err
+	case cmd[0] == "tar" && cmd[1] == "xf":
+		content, err := ioutil.ReadAll(stdin)
+		f.content = string(content)
+		return err
+	case cmd[0] == "sh":
+		f.content = ""
+	}
+	return nil
+}
+
+func TestDockerSnapshots(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-snapshots")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	defer func(previous string) { dockerSnapshotsDir = previous }(dockerSnapshotsDir)
+	dockerSnapshotsDir = dir
+
+	mounter := &fakeStorageMounter{content: "before migration"}
+	snapshot, err := CreateDockerSnapshot(mounter, "before-migration", "mydb", "nodejs")
+	if err != nil {
+		t.Fatalf("unable to create the snapshot: %v", err)
+	}
+	if snapshot.Name != "before-migration" || snapshot.Spec.Storage != "mydb" || !snapshot.Status.ReadyToUse {
+		t.Errorf("unexpected snapshot %+v", snapshot)
+	}
+	if _, err = CreateDockerSnapshot(mounter, "before-migration", "mydb", "nodejs"); err == nil {
+		t.Errorf("expected an error creating an existing snapshot")
+	}
+	if _, err = CreateDockerSnapshot(mounter, "../escape", "mydb", "nodejs"); err == nil {
+		t.Errorf("expected an error creating a snapshot with an invalid name")
+	}
+
+	list, err := ListDockerSnapshots("", "nodejs")
+	if err != nil {
+		t.Fatalf("unable to list the snapshots: %v", err)
+	}
+	if len(list.Items) != 1 || list.Items[0].Name != "before-migration" {
+		t.Errorf("unexpected snapshots %+v", list.Items)
+	}
+	list, err = ListDockerSnapshots("", "other")
+	if err != nil {
+		t.Fatalf("unable to list the snapshots: %v", err)
+	}
+	if len(list.Items) != 0 {
+		t.Errorf("unexpected snapshots of another component %+v", list.Items)
+	}
+
+	mounter.content = "after migration"
+	if _, err = RestoreDockerSnapshot(mounter, "before-migration", "nodejs"); err != nil {
+		t.Fatalf("unable to restore the snapshot: %v", err)
+	}
+	if mounter.content != "before migration" {
+		t.Errorf("the storage contains %q after the restoration, want %q", mounter.content, "before migration")
+	}
+	if mounter.unmounts != 2 {
+		t.Errorf("the storage has been unmounted %d times, want 2", mounter.unmounts)
+	}
+
+	if err = DeleteDockerSnapshot("before-migration", "nodejs"); err != nil {
+		t.Fatalf("unable to delete the snapshot: %v", err)
+	}
+	if _, err = RestoreDockerSnapshot(mounter, "before-migration", "nodejs"); err == nil {
+		t.Errorf("expected an error restoring a deleted snapshot")
+	}
+}
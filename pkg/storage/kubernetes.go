@@ -2,11 +2,11 @@ package storage
 
 import (
 	"fmt"
-	"reflect"
 	"strings"
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/occlient"
 	storagelabels "github.com/openshift/odo/pkg/storage/labels"
 	"github.com/pkg/errors"
@@ -47,11 +47,19 @@ func (k kubernetesClient) Create(storage Storage) error {
 		return errors.Wrapf(err, "unable to parse size: %v", storage.Spec.Size)
 	}
 
+	if storage.Spec.StorageClass != "" {
+		err = k.client.GetKubeClient().ValidateStorageClass(storage.Spec.StorageClass)
+		if err != nil {
+			return err
+		}
+	}
+
 	pvcParams := generator.PVCParams{
 		ObjectMeta: objectMeta,
 		Quantity:   quantity,
 	}
 	pvc := generator.GetPVC(pvcParams)
+	setPVCSettings(pvc, storage)
 
 	// Create PVC
 	klog.V(2).Infof("Creating a PVC with name %v and labels %v", pvcName, labels)
@@ -62,6 +70,37 @@ func (k kubernetesClient) Create(storage Storage) error {
 	return nil
 }
 
+// setPVCSettings sets the storage class, access modes and volume mode of the storage to the PVC
+func setPVCSettings(pvc *corev1.PersistentVolumeClaim, storage Storage) {
+	if storage.Spec.StorageClass != "" {
+		storageClass := storage.Spec.StorageClass
+		pvc.Spec.StorageClassName = &storageClass
+	}
+	if len(storage.Spec.AccessModes) > 0 {
+		pvc.Spec.AccessModes = nil
+		for _, accessMode := range storage.Spec.AccessModes {
+			pvc.Spec.AccessModes = append(pvc.Spec.AccessModes, corev1.PersistentVolumeAccessMode(accessMode))
+		}
+	}
+	if storage.Spec.VolumeMode != "" {
+		volumeMode := corev1.PersistentVolumeMode(storage.Spec.VolumeMode)
+		pvc.Spec.VolumeMode = &volumeMode
+	}
+}
+
+// getPVCSettings sets the storage class, access modes and volume mode of the PVC to the storage
+func getPVCSettings(pvc corev1.PersistentVolumeClaim, storage *Storage) {
+	if pvc.Spec.StorageClassName != nil {
+		storage.Spec.StorageClass = *pvc.Spec.StorageClassName
+	}
+	for _, accessMode := range pvc.Spec.AccessModes {
+		storage.Spec.AccessModes = append(storage.Spec.AccessModes, string(accessMode))
+	}
+	if pvc.Spec.VolumeMode != nil {
+		storage.Spec.VolumeMode = string(*pvc.Spec.VolumeMode)
+	}
+}
+
 // Delete deletes the pvc belonging to the given Storage
 func (k kubernetesClient) Delete(name string) error {
 	pvcName, err := getPVCNameFromStorageName(&k.client, name)
@@ -78,6 +117,37 @@ func (k kubernetesClient) Delete(name string) error {
 	return nil
 }
 
+// Expand expands the volume of the pvc of the given Storage to its size and waits for the resize of the volume
+func (k kubernetesClient) Expand(storage Storage) error {
+	pvcName, err := getPVCNameFromStorageName(&k.client, storage.Name)
+	if err != nil {
+		return err
+	}
+
+	quantity, err := resource.ParseQuantity(storage.Spec.Size)
+	if err != nil {
+		return errors.Wrapf(err, "unable to parse size: %v", storage.Spec.Size)
+	}
+
+	_, err = k.client.GetKubeClient().ExpandPVC(pvcName, quantity)
+	if err != nil {
+		return errors.Wrapf(err, "unable to expand the storage %s, please delete the storage and push it again to change its size", storage.Name)
+	}
+
+	spinner := log.Spinnerf("Waiting for the expansion of the storage %s to %s", storage.Name, storage.Spec.Size)
+	defer spinner.End(false)
+	fileSystemResizePending, err := k.client.GetKubeClient().WaitForPVCResize(pvcName, quantity, getPushTimeout())
+	if err != nil {
+		return err
+	}
+	spinner.End(true)
+
+	if fileSystemResizePending {
+		log.Infof("The file system of the storage %s will be resized by the node once mounted by the component", storage.Name)
+	}
+	return nil
+}
+
 // ListFromCluster lists pvc based Storage from the cluster
 func (k kubernetesClient) ListFromCluster() (StorageList, error) {
 	pod, err := k.client.GetKubeClient().GetOnePod(k.localConfig.GetName(), k.localConfig.GetApplication())
@@ -98,6 +168,16 @@ func (k kubernetesClient) ListFromCluster() (StorageList, error) {
 	var storage []Storage
 	var volumeMounts []Storage
 	for _, container := range pod.Spec.Containers {
+		// the block volumes are attached to the containers as devices
+		for _, volumeDevice := range container.VolumeDevices {
+			volumeMounts = append(volumeMounts, Storage{
+				ObjectMeta: metav1.ObjectMeta{Name: volumeDevice.Name},
+				Spec: StorageSpec{
+					Path:          volumeDevice.DevicePath,
+					ContainerName: container.Name,
+				},
+			})
+		}
 		for _, volumeMount := range container.VolumeMounts {
 
 			// avoid the volume mounts from the init containers
@@ -141,7 +221,9 @@ func (k kubernetesClient) ListFromCluster() (StorageList, error) {
 
 				found = true
 				size := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
-				storage = append(storage, GetMachineFormatWithContainer(pvc.Labels[storagelabels.DevfileStorageLabel], size.String(), volumeMount.Spec.Path, volumeMount.Spec.ContainerName))
+				pvcStorage := GetMachineFormatWithContainer(pvc.Labels[storagelabels.DevfileStorageLabel], size.String(), volumeMount.Spec.Path, volumeMount.Spec.ContainerName)
+				getPVCSettings(pvc, &pvcStorage)
+				storage = append(storage, pvcStorage)
 			}
 		}
 		if !found {
@@ -187,8 +269,12 @@ func (k kubernetesClient) List() (StorageList, error) {
 	for _, localStore := range localStorage.Items {
 		found := false
 		for _, clusterStore := range clusterStorage.Items {
-			if reflect.DeepEqual(localStore, clusterStore) {
+			if matchesLocalStorage(localStore, clusterStore) {
 				found = true
+				// show the settings of the PVC set by the cluster
+				localStore.Spec.StorageClass = clusterStore.Spec.StorageClass
+				localStore.Spec.AccessModes = clusterStore.Spec.AccessModes
+				localStore.Spec.VolumeMode = clusterStore.Spec.VolumeMode
 			}
 		}
 		if found {
@@ -203,7 +289,7 @@ func (k kubernetesClient) List() (StorageList, error) {
 	for _, clusterStore := range clusterStorage.Items {
 		found := false
 		for _, localStore := range localStorage.Items {
-			if reflect.DeepEqual(localStore, clusterStore) {
+			if matchesLocalStorage(localStore, clusterStore) {
 				found = true
 			}
 		}
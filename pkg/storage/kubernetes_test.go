@@ -1,6 +1,7 @@
 package storage
 
 import (
+	"context"
 	"reflect"
 	"strings"
 	"testing"
@@ -12,6 +13,7 @@ import (
 	storageLabels "github.com/openshift/odo/pkg/storage/labels"
 	"github.com/openshift/odo/pkg/testingutil"
 	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -751,6 +753,116 @@ func Test_kubernetesClient_Create(t *testing.T) {
 	}
 }
 
+func Test_kubernetesClient_CreatePVCSettings(t *testing.T) {
+	fkclient, fkclientset := kclient.FakeNew()
+	fkclient.Namespace = "default"
+
+	fakeocclient, _ := occlient.FakeNew()
+	fakeocclient.SetKubeClient(fkclient)
+
+	k := kubernetesClient{
+		generic: generic{
+			appName:       "app",
+			componentName: "nodejs",
+		},
+		client: *fakeocclient,
+	}
+
+	storage := GetMachineFormatWithContainer("storage-0", "5Gi", "/dev/xvda", "runtime")
+	storage.Spec.StorageClass = "fast"
+	storage.Spec.AccessModes = []string{"ReadWriteMany"}
+	storage.Spec.VolumeMode = "Block"
+
+	if err := k.Create(storage); err == nil {
+		t.Errorf("Create() expected an error for a storage class which doesn't exist")
+	}
+
+	_, err := fkclientset.Kubernetes.StorageV1().StorageClasses().Create(context.TODO(), &storagev1.StorageClass{ObjectMeta: metav1.ObjectMeta{Name: "fast"}}, metav1.CreateOptions{})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = k.Create(storage); err != nil {
+		t.Fatalf("Create() unexpected error: %v", err)
+	}
+
+	pvcs, err := fkclientset.Kubernetes.CoreV1().PersistentVolumeClaims("default").List(context.TODO(), metav1.ListOptions{})
+	if err != nil || len(pvcs.Items) != 1 {
+		t.Fatalf("expected one PVC, got %v (%v)", pvcs, err)
+	}
+	var got Storage
+	getPVCSettings(pvcs.Items[0], &got)
+	if got.Spec.StorageClass != "fast" || !reflect.DeepEqual(got.Spec.AccessModes, []string{"ReadWriteMany"}) || got.Spec.VolumeMode != "Block" {
+		t.Errorf("unexpected settings of the PVC: %+v", pvcs.Items[0].Spec)
+	}
+}
+
+func Test_kubernetesClient_Expand(t *testing.T) {
+	allow := true
+	tests := []struct {
+		name                 string
+		allowVolumeExpansion *bool
+		wantErr              bool
+	}{
+		{
+			name:                 "case 1: storage class allowing the expansion of the volumes",
+			allowVolumeExpansion: &allow,
+		},
+		{
+			name:    "case 2: storage class not allowing the expansion of the volumes",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			fkclient, fkclientset := kclient.FakeNew()
+			fkclient.Namespace = "default"
+
+			fakeocclient, _ := occlient.FakeNew()
+			fakeocclient.SetKubeClient(fkclient)
+
+			_, err := fkclientset.Kubernetes.StorageV1().StorageClasses().Create(context.TODO(), &storagev1.StorageClass{
+				ObjectMeta:           metav1.ObjectMeta{Name: "standard"},
+				AllowVolumeExpansion: tt.allowVolumeExpansion,
+			}, metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			storageClass := "standard"
+			pvc := testingutil.FakePVC("storage-0-nodejs-app", "1Gi", map[string]string{storageLabels.StorageLabel: "storage-0"})
+			pvc.Namespace = "default"
+			pvc.Spec.StorageClassName = &storageClass
+			// the volume has already been resized by the controller, its file system being resized by the node
+			pvc.Status.Conditions = []corev1.PersistentVolumeClaimCondition{{Type: corev1.PersistentVolumeClaimFileSystemResizePending, Status: corev1.ConditionTrue}}
+			_, err = fkclientset.Kubernetes.CoreV1().PersistentVolumeClaims("default").Create(context.TODO(), pvc, metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			k := kubernetesClient{
+				generic: generic{
+					appName:       "app",
+					componentName: "nodejs",
+				},
+				client: *fakeocclient,
+			}
+			err = k.Expand(GetMachineFormatWithContainer("storage-0", "5Gi", "/data", "runtime"))
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Expand() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if tt.wantErr {
+				return
+			}
+			expanded, err := fkclientset.Kubernetes.CoreV1().PersistentVolumeClaims("default").Get(context.TODO(), pvc.Name, metav1.GetOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+			if size := expanded.Spec.Resources.Requests[corev1.ResourceStorage]; size.String() != "5Gi" {
+				t.Errorf("the PVC has not been expanded, its size is %s", size.String())
+			}
+		})
+	}
+}
+
 func Test_kubernetesClient_Delete(t *testing.T) {
 	pvcName := "pvc-0"
 	returnedPVCs := corev1.PersistentVolumeClaimList{
@@ -60,6 +60,20 @@ func (mr *MockClientMockRecorder) Delete(arg0 interface{}) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Delete", reflect.TypeOf((*MockClient)(nil).Delete), arg0)
 }
 
+// Expand mocks base method
+func (m *MockClient) Expand(arg0 Storage) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "Expand", arg0)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// Expand indicates an expected call of Expand
+func (mr *MockClientMockRecorder) Expand(arg0 interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "Expand", reflect.TypeOf((*MockClient)(nil).Expand), arg0)
+}
+
 // ListFromCluster mocks base method
 func (m *MockClient) ListFromCluster() (StorageList, error) {
 	m.ctrl.T.Helper()
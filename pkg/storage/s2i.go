@@ -31,6 +31,11 @@ func (s s2iClient) Delete(name string) error {
 	return nil
 }
 
+// Expand returns an error as the expansion of the storage is not supported for s2i components
+func (s s2iClient) Expand(storage Storage) error {
+	return errors.Errorf("the expansion of the storage %s is not supported for s2i components", storage.Name)
+}
+
 // ListFromCluster lists pvc based Storage from the cluster for s2i components
 func (s s2iClient) ListFromCluster() (StorageList, error) {
 	componentLabels := componentlabels.GetLabels(s.localConfig.GetName(), s.localConfig.GetApplication(), false)
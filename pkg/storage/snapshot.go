@@ -0,0 +1,270 @@
+package storage
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/machineoutput"
+	storagelabels "github.com/openshift/odo/pkg/storage/labels"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
+	kerrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+// GenerateSnapshotName returns the default name of a snapshot of the storage, based on the current time
+func GenerateSnapshotName(storageName string) string {
+	return fmt.Sprintf("%s-%s", storageName, time.Now().Format("20060102-150405"))
+}
+
+// CreateSnapshot takes a snapshot of the PVC of the storage of the component with the given VolumeSnapshotClass,
+// or the default class if empty, and waits for the snapshot to be ready to use
+func CreateSnapshot(client *kclient.Client, snapshotName, snapshotClass, storageName, componentName, appName string) (Snapshot, error) {
+	pvc, err := getStoragePVC(client, storageName, componentName)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	labels := storagelabels.GetLabels(storageName, componentName, appName, true)
+	labels["component"] = componentName
+	labels[storagelabels.DevfileStorageLabel] = storageName
+
+	volumeSnapshot, err := client.CreateVolumeSnapshot(snapshotName, pvc.Name, snapshotClass, labels)
+	if err != nil {
+		return Snapshot{}, err
+	}
+
+	spinner := log.Spinnerf("Waiting for the snapshot %s of the storage %s to be ready", snapshotName, storageName)
+	defer spinner.End(false)
+	volumeSnapshot, err = client.WaitForVolumeSnapshotReady(snapshotName, getPushTimeout())
+	if err != nil {
+		return Snapshot{}, err
+	}
+	spinner.End(true)
+	return convertSnapshot(*volumeSnapshot), nil
+}
+
+// ListSnapshots lists the snapshots of the storage of the component, or of all the storage of the component if storageName is empty
+func ListSnapshots(client *kclient.Client, storageName, componentName string) (SnapshotList, error) {
+	labels := map[string]string{
+		"component": componentName,
+	}
+	if storageName != "" {
+		labels[storagelabels.DevfileStorageLabel] = storageName
+	}
+	volumeSnapshots, err := client.ListVolumeSnapshots(util.ConvertLabelsToSelector(labels))
+	if err != nil {
+		return SnapshotList{}, err
+	}
+
+	snapshots := []Snapshot{}
+	for _, volumeSnapshot := range volumeSnapshots {
+		snapshots = append(snapshots, convertSnapshot(volumeSnapshot))
+	}
+	return SnapshotList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: apiVersion,
+		},
+		Items: snapshots,
+	}, nil
+}
+
+// DeleteSnapshot deletes the snapshot of a storage of the component
+func DeleteSnapshot(client *kclient.Client, snapshotName, componentName string) error {
+	if _, err := getComponentSnapshot(client, snapshotName, componentName); err != nil {
+		return err
+	}
+	return client.DeleteVolumeSnapshot(snapshotName)
+}
+
+// RestoreSnapshot replaces the PVC of the storage of the snapshot by a PVC restored from the snapshot.
+// The Deployment of the component is scaled down while the PVC is replaced, and rolled out again afterwards
+func RestoreSnapshot(client *kclient.Client, snapshotName, componentName, appName string) (Snapshot, error) {
+	volumeSnapshot, err := getComponentSnapshot(client, snapshotName, componentName)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	snapshot := convertSnapshot(*volumeSnapshot)
+	if !volumeSnapshot.ReadyToUse {
+		return Snapshot{}, errors.Errorf("the snapshot %s is not ready to use yet", snapshotName)
+	}
+
+	pvc, err := getStoragePVC(client, snapshot.Spec.Storage, componentName)
+	if err != nil {
+		return Snapshot{}, err
+	}
+	restoredPVC := getRestoredPVC(pvc, *volumeSnapshot)
+
+	// the PVC can't be deleted as long as it is used by the pod of the component
+	var replicas int32
+	deployment, err := client.GetOneDeployment(componentName, appName)
+	if err != nil {
+		if _, ok := err.(*kclient.DeploymentNotFoundError); !ok {
+			return Snapshot{}, errors.Wrapf(err, "unable to get the deployment of the component %s", componentName)
+		}
+		deployment = nil
+	}
+	if deployment != nil {
+		replicas = 1
+		if deployment.Spec.Replicas != nil {
+			replicas = *deployment.Spec.Replicas
+		}
+		if replicas > 0 {
+			if _, err = client.ScaleDeployment(deployment.Name, 0); err != nil {
+				return Snapshot{}, err
+			}
+		}
+	}
+	// the component is started again when the PVC can't be replaced
+	replaced := false
+	defer func() {
+		if replaced || deployment == nil || replicas == 0 {
+			return
+		}
+		if _, err := client.ScaleDeployment(deployment.Name, replicas); err != nil {
+			log.Warningf("Unable to scale the deployment %s back to %d replicas: %v", deployment.Name, replicas, err)
+		}
+	}()
+
+	spinner := log.Spinnerf("Replacing the PVC of the storage %s", snapshot.Spec.Storage)
+	defer spinner.End(false)
+	klog.V(3).Infof("Deleting the PVC %s to restore it from the snapshot %s", pvc.Name, snapshotName)
+	err = client.DeletePVC(pvc.Name)
+	if err != nil && !kerrors.IsNotFound(err) {
+		return Snapshot{}, errors.Wrapf(err, "unable to delete the PVC %s", pvc.Name)
+	}
+	err = client.WaitForPVCDeletion(pvc.Name, getPushTimeout())
+	if err != nil {
+		return Snapshot{}, errors.Wrapf(err, "unable to replace the PVC %s, the data of the storage %s may need to be restored again from the snapshot %s, which has been kept", pvc.Name, snapshot.Spec.Storage, snapshotName)
+	}
+	_, err = client.CreatePVC(*restoredPVC)
+	if err != nil {
+		return Snapshot{}, errors.Wrapf(err, "unable to restore the PVC %s from the snapshot %s, the data of the storage %s may need to be restored again from the snapshot, which has been kept", pvc.Name, snapshotName, snapshot.Spec.Storage)
+	}
+	replaced = true
+	spinner.End(true)
+
+	if deployment != nil && replicas > 0 {
+		if _, err = client.ScaleDeployment(deployment.Name, replicas); err != nil {
+			return Snapshot{}, err
+		}
+		if _, err = client.WaitForDeploymentRollout(deployment.Name); err != nil {
+			return Snapshot{}, err
+		}
+	}
+	return snapshot, nil
+}
+
+// getStoragePVC returns the PVC of the storage of the component
+func getStoragePVC(client *kclient.Client, storageName, componentName string) (*corev1.PersistentVolumeClaim, error) {
+	selector := util.ConvertLabelsToSelector(map[string]string{
+		"component":                       componentName,
+		storagelabels.DevfileStorageLabel: storageName,
+	})
+	pvcs, err := client.ListPVCs(selector)
+	if err != nil {
+		return nil, err
+	}
+	if len(pvcs) != 1 {
+		return nil, errors.Errorf("expected exactly one PVC for the storage %s of the component %s, but got %d, please use `odo push` to create it", storageName, componentName, len(pvcs))
+	}
+	return &pvcs[0], nil
+}
+
+// getComponentSnapshot returns the snapshot, returning an error if it is not a snapshot of a storage of the component
+func getComponentSnapshot(client *kclient.Client, snapshotName, componentName string) (*kclient.VolumeSnapshot, error) {
+	volumeSnapshot, err := client.GetVolumeSnapshot(snapshotName)
+	if err != nil {
+		if kerrors.IsNotFound(err) {
+			return nil, errors.Errorf("the snapshot %s doesn't exist", snapshotName)
+		}
+		return nil, errors.Wrapf(err, "unable to get the snapshot %s", snapshotName)
+	}
+	if volumeSnapshot.Labels["component"] != componentName || volumeSnapshot.Labels[storagelabels.DevfileStorageLabel] == "" {
+		return nil, errors.Errorf("the snapshot %s is not a snapshot of a storage of the component %s", snapshotName, componentName)
+	}
+	return volumeSnapshot, nil
+}
+
+// getRestoredPVC returns the PVC replacing the given PVC, restored from the snapshot.
+// Its size is increased to the restore size of the snapshot if it is smaller
+func getRestoredPVC(pvc *corev1.PersistentVolumeClaim, volumeSnapshot kclient.VolumeSnapshot) *corev1.PersistentVolumeClaim {
+	apiGroup := kclient.VolumeSnapshotGroup
+	restored := &corev1.PersistentVolumeClaim{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       kclient.PersistentVolumeClaimKind,
+			APIVersion: kclient.PersistentVolumeClaimAPIVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:            pvc.Name,
+			Labels:          pvc.Labels,
+			OwnerReferences: pvc.OwnerReferences,
+		},
+		Spec: *pvc.Spec.DeepCopy(),
+	}
+	restored.Spec.VolumeName = ""
+	restored.Spec.DataSource = &corev1.TypedLocalObjectReference{
+		APIGroup: &apiGroup,
+		Kind:     kclient.VolumeSnapshotKind,
+		Name:     volumeSnapshot.Name,
+	}
+
+	if volumeSnapshot.RestoreSize != nil {
+		size, ok := restored.Spec.Resources.Requests[corev1.ResourceStorage]
+		if !ok || size.Cmp(*volumeSnapshot.RestoreSize) < 0 {
+			if restored.Spec.Resources.Requests == nil {
+				restored.Spec.Resources.Requests = corev1.ResourceList{}
+			}
+			restored.Spec.Resources.Requests[corev1.ResourceStorage] = *volumeSnapshot.RestoreSize
+		}
+	}
+	return restored
+}
+
+// convertSnapshot returns the snapshot of the storage from the VolumeSnapshot
+func convertSnapshot(volumeSnapshot kclient.VolumeSnapshot) Snapshot {
+	snapshot := Snapshot{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Snapshot",
+			APIVersion: apiVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              volumeSnapshot.Name,
+			CreationTimestamp: volumeSnapshot.CreationTimestamp,
+		},
+		Spec: SnapshotSpec{
+			Storage:       volumeSnapshot.Labels[storagelabels.DevfileStorageLabel],
+			SnapshotClass: volumeSnapshot.SnapshotClass,
+		},
+		Status: SnapshotStatus{
+			ReadyToUse: volumeSnapshot.ReadyToUse,
+			Error:      volumeSnapshot.Error,
+		},
+	}
+	if volumeSnapshot.RestoreSize != nil {
+		snapshot.Status.RestoreSize = volumeSnapshot.RestoreSize.String()
+	}
+	return snapshot
+}
+
+// SnapshotMachineReadableSuccessOutput outputs a success output that includes
+// snapshot information
+func SnapshotMachineReadableSuccessOutput(snapshotName string, message string) {
+	machineOutput := machineoutput.GenericSuccess{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "Snapshot",
+			APIVersion: apiVersion,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name: snapshotName,
+		},
+		Message: message,
+	}
+
+	machineoutput.OutputSuccess(machineOutput)
+}
@@ -0,0 +1,110 @@
+package storage
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/odo/pkg/kclient"
+	storagelabels "github.com/openshift/odo/pkg/storage/labels"
+	"github.com/openshift/odo/pkg/testingutil"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func Test_getRestoredPVC(t *testing.T) {
+	restoreSize := resource.MustParse("2Gi")
+	tests := []struct {
+		name        string
+		pvcSize     string
+		restoreSize *resource.Quantity
+		wantSize    string
+	}{
+		{
+			name:     "Case 1: restore size unknown",
+			pvcSize:  "1Gi",
+			wantSize: "1Gi",
+		},
+		{
+			name:        "Case 2: PVC larger than the restore size",
+			pvcSize:     "5Gi",
+			restoreSize: &restoreSize,
+			wantSize:    "5Gi",
+		},
+		{
+			name:        "Case 3: PVC smaller than the restore size",
+			pvcSize:     "1Gi",
+			restoreSize: &restoreSize,
+			wantSize:    "2Gi",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			labels := map[string]string{"component": "nodejs", storagelabels.DevfileStorageLabel: "mydb"}
+			pvc := testingutil.FakePVC("mydb-nodejs-pvc", tt.pvcSize, labels)
+			pvc.ResourceVersion = "42"
+			pvc.Spec.VolumeName = "pvc-1234"
+			storageClass := "csi-hostpath-sc"
+			pvc.Spec.StorageClassName = &storageClass
+			pvc.OwnerReferences = []metav1.OwnerReference{{Kind: "Deployment", Name: "nodejs-app"}}
+
+			restored := getRestoredPVC(pvc, kclient.VolumeSnapshot{Name: "mydb-snapshot", RestoreSize: tt.restoreSize})
+
+			if restored.Name != pvc.Name || restored.ResourceVersion != "" {
+				t.Errorf("getRestoredPVC() name = %s, resource version = %s, want %s and no resource version", restored.Name, restored.ResourceVersion, pvc.Name)
+			}
+			if !reflect.DeepEqual(restored.Labels, labels) || !reflect.DeepEqual(restored.OwnerReferences, pvc.OwnerReferences) {
+				t.Errorf("getRestoredPVC() labels = %v, owner references = %v, want the ones of the PVC", restored.Labels, restored.OwnerReferences)
+			}
+			if restored.Spec.VolumeName != "" {
+				t.Errorf("getRestoredPVC() volume name = %s, want no volume name", restored.Spec.VolumeName)
+			}
+			if restored.Spec.StorageClassName == nil || *restored.Spec.StorageClassName != storageClass {
+				t.Errorf("getRestoredPVC() storage class = %v, want %s", restored.Spec.StorageClassName, storageClass)
+			}
+			wantDataSource := &corev1.TypedLocalObjectReference{
+				APIGroup: &[]string{kclient.VolumeSnapshotGroup}[0],
+				Kind:     kclient.VolumeSnapshotKind,
+				Name:     "mydb-snapshot",
+			}
+			if !reflect.DeepEqual(restored.Spec.DataSource, wantDataSource) {
+				t.Errorf("getRestoredPVC() data source = %v, want %v", restored.Spec.DataSource, wantDataSource)
+			}
+			size := restored.Spec.Resources.Requests[corev1.ResourceStorage]
+			if size.String() != tt.wantSize {
+				t.Errorf("getRestoredPVC() size = %s, want %s", size.String(), tt.wantSize)
+			}
+			pvcSize := pvc.Spec.Resources.Requests[corev1.ResourceStorage]
+			if pvcSize.String() != tt.pvcSize {
+				t.Errorf("getRestoredPVC() modified the size of the PVC to %s", pvcSize.String())
+			}
+		})
+	}
+}
+
+func Test_convertSnapshot(t *testing.T) {
+	restoreSize := resource.MustParse("2Gi")
+	got := convertSnapshot(kclient.VolumeSnapshot{
+		Name:          "mydb-snapshot",
+		Labels:        map[string]string{"component": "nodejs", storagelabels.DevfileStorageLabel: "mydb"},
+		PVCName:       "mydb-nodejs-pvc",
+		SnapshotClass: "csi-snapclass",
+		ReadyToUse:    true,
+		RestoreSize:   &restoreSize,
+	})
+	want := Snapshot{
+		TypeMeta:   metav1.TypeMeta{Kind: "Snapshot", APIVersion: apiVersion},
+		ObjectMeta: metav1.ObjectMeta{Name: "mydb-snapshot"},
+		Spec: SnapshotSpec{
+			Storage:       "mydb",
+			SnapshotClass: "csi-snapclass",
+		},
+		Status: SnapshotStatus{
+			ReadyToUse:  true,
+			RestoreSize: "2Gi",
+		},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("convertSnapshot() = %#v, want %#v", got, want)
+	}
+}
@@ -2,11 +2,14 @@ package storage
 
 import (
 	"fmt"
+	"reflect"
+	"time"
 
 	"github.com/openshift/odo/pkg/config"
 	"github.com/openshift/odo/pkg/localConfigProvider"
 	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/machineoutput"
+	"github.com/openshift/odo/pkg/preference"
 
 	applabels "github.com/openshift/odo/pkg/application/labels"
 	componentlabels "github.com/openshift/odo/pkg/component/labels"
@@ -16,6 +19,7 @@ import (
 	"github.com/openshift/odo/pkg/util"
 	"github.com/pkg/errors"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/klog"
 )
@@ -530,6 +534,58 @@ func isPushed(storageName string, storageCluster StorageList) bool {
 	return false
 }
 
+// isExpanded returns true if the size of the local storage is larger than the size of the storage on the cluster,
+// and an error if it is smaller as the size of a PVC can't be reduced
+func isExpanded(local, cluster Storage) (bool, error) {
+	localSize, err := resource.ParseQuantity(local.Spec.Size)
+	if err != nil {
+		return false, errors.Wrapf(err, "invalid size %s of the storage %s", local.Spec.Size, local.Name)
+	}
+	clusterSize, err := resource.ParseQuantity(cluster.Spec.Size)
+	if err != nil {
+		return false, errors.Errorf("config mismatch for storage with the same name %s", local.Name)
+	}
+	switch localSize.Cmp(clusterSize) {
+	case 1:
+		return true, nil
+	case -1:
+		return false, errors.Errorf("the storage %s can't be shrunk from %s to %s as the size of a PVC can only be increased, please delete the storage and push it again to reduce its size", local.Name, cluster.Spec.Size, local.Spec.Size)
+	}
+	return false, nil
+}
+
+// matchesLocalStorage returns true if the storage of the cluster is the local storage,
+// the storage class, access modes and volume mode being only compared when they are set locally
+// as the cluster sets their default values
+func matchesLocalStorage(local, cluster Storage) bool {
+	if local.Name != cluster.Name || local.Spec.Size != cluster.Spec.Size || local.Spec.Path != cluster.Spec.Path || local.Spec.ContainerName != cluster.Spec.ContainerName {
+		return false
+	}
+	return checkPVCSettings(local, cluster) == nil
+}
+
+// checkPVCSettings returns an error if the storage class, access modes or volume mode of the local storage
+// are not the ones of the PVC of the storage on the cluster, which can't be changed once the PVC is created
+func checkPVCSettings(local, cluster Storage) error {
+	if local.Spec.StorageClass != "" && local.Spec.StorageClass != cluster.Spec.StorageClass {
+		return errors.Errorf("the storage class of the storage %s can't be changed from %q to %q once pushed, please delete the storage and push it again", local.Name, cluster.Spec.StorageClass, local.Spec.StorageClass)
+	}
+	if len(local.Spec.AccessModes) > 0 && !reflect.DeepEqual(local.Spec.AccessModes, cluster.Spec.AccessModes) {
+		return errors.Errorf("the access modes of the storage %s can't be changed from %v to %v once pushed, please delete the storage and push it again", local.Name, cluster.Spec.AccessModes, local.Spec.AccessModes)
+	}
+	localVolumeMode, clusterVolumeMode := local.Spec.VolumeMode, cluster.Spec.VolumeMode
+	if localVolumeMode == "" {
+		localVolumeMode = string(corev1.PersistentVolumeFilesystem)
+	}
+	if clusterVolumeMode == "" {
+		clusterVolumeMode = string(corev1.PersistentVolumeFilesystem)
+	}
+	if localVolumeMode != clusterVolumeMode {
+		return errors.Errorf("the volume mode of the storage %s can't be changed from %s to %s once pushed, please delete the storage and push it again", local.Name, clusterVolumeMode, localVolumeMode)
+	}
+	return nil
+}
+
 // It converts storage config list to StorageList type
 func ConvertListLocalToMachine(storageListConfig []localConfigProvider.LocalStorage) StorageList {
 
@@ -538,6 +594,9 @@ func ConvertListLocalToMachine(storageListConfig []localConfigProvider.LocalStor
 	for _, storeLocal := range storageListConfig {
 		s := GetMachineReadableFormat(storeLocal.Name, storeLocal.Size, storeLocal.Path)
 		s.Spec.ContainerName = storeLocal.Container
+		s.Spec.StorageClass = storeLocal.StorageClass
+		s.Spec.AccessModes = storeLocal.AccessModes
+		s.Spec.VolumeMode = storeLocal.VolumeMode
 		storageListLocal = append(storageListLocal, s)
 	}
 
@@ -623,6 +682,7 @@ type ClientOptions struct {
 type Client interface {
 	Create(Storage) error
 	Delete(string) error
+	Expand(Storage) error
 	ListFromCluster() (StorageList, error)
 	List() (StorageList, error)
 }
@@ -693,8 +753,19 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 			log.Successf("Deleted storage %v from %v", storage.Name, configProvider.GetName())
 			continue
 		} else if storage.Name == val.Name {
-			if val.Spec.Size != storage.Spec.Size {
-				return errors.Errorf("config mismatch for storage with the same name %s", storage.Name)
+			if err = checkPVCSettings(val, storage); err != nil {
+				return err
+			}
+			expand, err := isExpanded(val, storage)
+			if err != nil {
+				return err
+			}
+			if expand {
+				err = client.Expand(val)
+				if err != nil {
+					return err
+				}
+				log.Successf("Expanded storage %v of %v from %v to %v", storage.Name, configProvider.GetName(), storage.Spec.Size, val.Spec.Size)
 			}
 		}
 	}
@@ -713,3 +784,14 @@ func Push(client Client, configProvider localConfigProvider.LocalConfigProvider)
 
 	return err
 }
+
+// getPushTimeout returns the PushTimeout preference, used to wait for the expansion and the snapshots of the storage
+func getPushTimeout() time.Duration {
+	// Try to grab the preference in order to set a timeout.. but if not, we'll use the default.
+	cfg, err := preference.New()
+	if err != nil {
+		klog.V(3).Info(errors.Wrap(err, "unable to read config file"))
+		return preference.DefaultPushTimeout * time.Second
+	}
+	return time.Duration(cfg.GetPushTimeout()) * time.Second
+}
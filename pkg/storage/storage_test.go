@@ -1067,6 +1067,7 @@ func TestPush(t *testing.T) {
 		returnedFromCluster StorageList
 		createdItems        []localConfigProvider.LocalStorage
 		deletedItems        []string
+		expandedItems       []localConfigProvider.LocalStorage
 		wantErr             bool
 	}{
 		{
@@ -1209,6 +1210,103 @@ func TestPush(t *testing.T) {
 			},
 			deletedItems: []string{"storage-0"},
 		},
+		{
+			name: "case 10: the storage class of a pushed storage can't be changed",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:         "storage-1",
+					Size:         "5Gi",
+					Path:         "/path",
+					Container:    "runtime-1",
+					StorageClass: "fast",
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					func() Storage {
+						storage := clusterStorage1
+						storage.Spec.StorageClass = "standard"
+						return storage
+					}(),
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 11: the volume mode of a pushed storage can't be changed",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:       "storage-1",
+					Size:       "5Gi",
+					Path:       "/path",
+					Container:  "runtime-1",
+					VolumeMode: "Block",
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			wantErr: true,
+		},
+		{
+			name: "case 12: the settings set by the cluster are ignored when they are not set locally",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				localStorage1,
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					func() Storage {
+						storage := clusterStorage1
+						storage.Spec.StorageClass = "standard"
+						storage.Spec.AccessModes = []string{"ReadWriteOnce"}
+						storage.Spec.VolumeMode = "Filesystem"
+						return storage
+					}(),
+				},
+			},
+		},
+		{
+			name: "case 13: the PVC is expanded when the size of the storage increases",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+			expandedItems: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "10Gi",
+					Path:      "/path",
+					Container: "runtime-1",
+				},
+			},
+		},
+		{
+			name: "case 14: the same size written differently doesn't expand the PVC",
+			returnedFromLocal: []localConfigProvider.LocalStorage{
+				{
+					Name:      "storage-1",
+					Size:      "5120Mi",
+					Path:      "/path",
+					Container: "runtime-1",
+				},
+			},
+			returnedFromCluster: StorageList{
+				Items: []Storage{
+					clusterStorage1,
+				},
+			},
+		},
 	}
 	for _, tt := range tests {
 		t.Run(tt.name, func(t *testing.T) {
@@ -1233,6 +1331,11 @@ func TestPush(t *testing.T) {
 				fakeStorageClient.EXPECT().Delete(tt.deletedItems[i]).Return(nil).Times(1)
 			}
 
+			expanded := ConvertListLocalToMachine(tt.expandedItems)
+			for i := range expanded.Items {
+				fakeStorageClient.EXPECT().Expand(expanded.Items[i]).Return(nil).Times(1)
+			}
+
 			if err := Push(fakeStorageClient, fakeLocalConfig); (err != nil) != tt.wantErr {
 				t.Errorf("Push() error = %v, wantErr %v", err, tt.wantErr)
 			}
@@ -31,6 +31,11 @@ type StorageSpec struct {
 	Path string `json:"path,omitempty"`
 
 	ContainerName string `json:"containerName,omitempty"`
+
+	// StorageClass, AccessModes and VolumeMode are the settings of the PVC of the storage
+	StorageClass string   `json:"storageClass,omitempty"`
+	AccessModes  []string `json:"accessModes,omitempty"`
+	VolumeMode   string   `json:"volumeMode,omitempty"`
 }
 
 // StorageList is a list of storages
@@ -39,3 +44,31 @@ type StorageList struct {
 	metav1.ListMeta `json:"metadata,omitempty"`
 	Items           []Storage `json:"items"`
 }
+
+// Snapshot holds the information about a snapshot of a storage of the component
+type Snapshot struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+	Spec              SnapshotSpec   `json:"spec,omitempty"`
+	Status            SnapshotStatus `json:"status,omitempty"`
+}
+
+// SnapshotSpec indicates the storage and the VolumeSnapshotClass of the snapshot
+type SnapshotSpec struct {
+	Storage       string `json:"storage"`
+	SnapshotClass string `json:"snapshotClass,omitempty"`
+}
+
+// SnapshotStatus indicates whether the snapshot can be restored and the minimum size of the restored storage
+type SnapshotStatus struct {
+	ReadyToUse  bool   `json:"readyToUse"`
+	RestoreSize string `json:"restoreSize,omitempty"`
+	Error       string `json:"error,omitempty"`
+}
+
+// SnapshotList is a list of snapshots
+type SnapshotList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Snapshot `json:"items"`
+}
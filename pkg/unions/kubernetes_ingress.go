@@ -78,6 +78,38 @@ func (ki *KubernetesIngress) GetURLString() string {
 	return fmt.Sprintf("%v://%v", ki.GetProtocol(), ki.GetHost())
 }
 
+// ingressClassAnnotation is the annotation used to set the class of the ingresses before the ingressClassName field
+const ingressClassAnnotation = "kubernetes.io/ingress.class"
+
+//GetIngressClassName returns the class of the underlying networking v1 or extensions v1 ingress, from its
+//ingressClassName field or its kubernetes.io/ingress.class annotation
+func (ki *KubernetesIngress) GetIngressClassName() string {
+	var className *string
+	var annotations map[string]string
+	if ki.NetworkingV1Ingress != nil {
+		className, annotations = ki.NetworkingV1Ingress.Spec.IngressClassName, ki.NetworkingV1Ingress.Annotations
+	} else if ki.ExtensionV1Beta1Ingress != nil {
+		className, annotations = ki.ExtensionV1Beta1Ingress.Spec.IngressClassName, ki.ExtensionV1Beta1Ingress.Annotations
+	}
+	if className != nil {
+		return *className
+	}
+	return annotations[ingressClassAnnotation]
+}
+
+//SetIngressClassName sets the ingressClassName field of the underlying networking v1 and extensions v1 ingresses
+func (ki *KubernetesIngress) SetIngressClassName(className string) {
+	if className == "" {
+		return
+	}
+	if ki.NetworkingV1Ingress != nil {
+		ki.NetworkingV1Ingress.Spec.IngressClassName = &className
+	}
+	if ki.ExtensionV1Beta1Ingress != nil {
+		ki.ExtensionV1Beta1Ingress.Spec.IngressClassName = &className
+	}
+}
+
 type KubernetesIngressList struct {
 	Items []*KubernetesIngress
 }
@@ -0,0 +1,131 @@
+package url
+
+import (
+	"crypto/x509"
+	"encoding/pem"
+	"fmt"
+	"time"
+
+	"github.com/openshift/odo/pkg/localConfigProvider"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	// certManagerIssuerAnnotation is the annotation of the ingresses requesting their certificate from a cert-manager Issuer
+	certManagerIssuerAnnotation = "cert-manager.io/issuer"
+	// certManagerClusterIssuerAnnotation is the annotation of the ingresses requesting their certificate from a cert-manager ClusterIssuer
+	certManagerClusterIssuerAnnotation = "cert-manager.io/cluster-issuer"
+	// tlsSecretNamespaceAnnotation is the annotation of the ingresses using a copy of a TLS secret of another namespace
+	tlsSecretNamespaceAnnotation = "odo.dev/tls-secret-namespace"
+
+	// certificateExpiringSoonPeriod is the period before the expiry of a certificate during which it is reported as expiring soon
+	certificateExpiringSoonPeriod = 30 * 24 * time.Hour
+)
+
+// CertificateState is the validity of the certificate of a secure URL
+type CertificateState string
+
+const (
+	// CertificateStateValid means that the certificate is valid for the host of the URL
+	CertificateStateValid CertificateState = "Valid"
+	// CertificateStateExpiringSoon means that the certificate is valid but expires in less than 30 days
+	CertificateStateExpiringSoon CertificateState = "Expiring Soon"
+	// CertificateStateExpired means that the certificate has expired
+	CertificateStateExpired CertificateState = "Expired"
+	// CertificateStateNotYetValid means that the validity period of the certificate has not started
+	CertificateStateNotYetValid CertificateState = "Not Yet Valid"
+	// CertificateStateInvalid means that the TLS secret is missing or its certificate can't be used for the host of the URL
+	CertificateStateInvalid CertificateState = "Invalid"
+	// CertificateStatePending means that the certificate has not been issued by cert-manager yet
+	CertificateStatePending CertificateState = "Pending"
+)
+
+// CertificateStatus is the status of the certificate of a secure URL
+type CertificateStatus struct {
+	State      CertificateState `json:"state"`
+	Issuer     string           `json:"issuer,omitempty"`
+	SelfSigned bool             `json:"selfSigned,omitempty"`
+	NotAfter   *metav1.Time     `json:"notAfter,omitempty"`
+	Message    string           `json:"message,omitempty"`
+}
+
+// String returns the state of the certificate with its expiry date
+func (cs CertificateStatus) String() string {
+	if cs.NotAfter == nil {
+		return string(cs.State)
+	}
+	return fmt.Sprintf("%s (expires %s)", cs.State, cs.NotAfter.Format("2006-01-02"))
+}
+
+// getCertificateStatus returns the status of the PEM encoded certificate for the given host at the given time
+func getCertificateStatus(certPEM []byte, host string, now time.Time) CertificateStatus {
+	block, _ := pem.Decode(certPEM)
+	if block == nil || block.Type != "CERTIFICATE" {
+		return CertificateStatus{State: CertificateStateInvalid, Message: "the TLS secret doesn't contain a PEM encoded certificate"}
+	}
+	cert, err := x509.ParseCertificate(block.Bytes)
+	if err != nil {
+		return CertificateStatus{State: CertificateStateInvalid, Message: fmt.Sprintf("unable to parse the certificate: %v", err)}
+	}
+
+	notAfter := metav1.NewTime(cert.NotAfter)
+	status := CertificateStatus{
+		Issuer:     cert.Issuer.CommonName,
+		SelfSigned: cert.Issuer.String() == cert.Subject.String() && cert.CheckSignature(cert.SignatureAlgorithm, cert.RawTBSCertificate, cert.Signature) == nil,
+		NotAfter:   &notAfter,
+	}
+	switch {
+	case now.Before(cert.NotBefore):
+		status.State = CertificateStateNotYetValid
+	case now.After(cert.NotAfter):
+		status.State = CertificateStateExpired
+	case host != "" && cert.VerifyHostname(host) != nil:
+		status.State = CertificateStateInvalid
+		status.Message = fmt.Sprintf("the certificate is not valid for the host %s", host)
+	case cert.NotAfter.Sub(now) < certificateExpiringSoonPeriod:
+		status.State = CertificateStateExpiringSoon
+	default:
+		status.State = CertificateStateValid
+	}
+	return status
+}
+
+// getTLSSecretCertificateStatus returns the status of the certificate of the TLS secret of the URL,
+// a nil secret meaning that the secret doesn't exist
+func getTLSSecretCertificateStatus(url URL, secret *corev1.Secret, now time.Time) CertificateStatus {
+	if secret == nil {
+		if url.Spec.Issuer != "" {
+			return CertificateStatus{State: CertificateStatePending, Message: fmt.Sprintf("waiting for the certificate from the %s %s", url.Spec.IssuerKind, url.Spec.Issuer)}
+		}
+		return CertificateStatus{State: CertificateStateInvalid, Message: fmt.Sprintf("the TLS secret %s doesn't exist", url.Spec.TLSSecret)}
+	}
+	return getCertificateStatus(secret.Data[corev1.TLSCertKey], url.Spec.Host, now)
+}
+
+// getCertificateAnnotations returns the annotations of the ingress of the URL requesting its certificate from cert-manager
+// or recording the namespace its TLS secret is copied from
+func getCertificateAnnotations(url URL) map[string]string {
+	annotations := map[string]string{}
+	switch {
+	case url.Spec.Issuer != "" && url.Spec.IssuerKind == localConfigProvider.ClusterIssuerKind:
+		annotations[certManagerClusterIssuerAnnotation] = url.Spec.Issuer
+	case url.Spec.Issuer != "":
+		annotations[certManagerIssuerAnnotation] = url.Spec.Issuer
+	case url.Spec.TLSSecretNamespace != "":
+		annotations[tlsSecretNamespaceAnnotation] = url.Spec.TLSSecretNamespace
+	}
+	return annotations
+}
+
+// setCertificateSource sets the cert-manager issuer and the namespace of the TLS secret of the URL from the annotations of its ingress
+func setCertificateSource(spec *URLSpec, annotations map[string]string) {
+	if issuer, ok := annotations[certManagerClusterIssuerAnnotation]; ok {
+		spec.Issuer = issuer
+		spec.IssuerKind = localConfigProvider.ClusterIssuerKind
+	} else if issuer, ok := annotations[certManagerIssuerAnnotation]; ok {
+		spec.Issuer = issuer
+		spec.IssuerKind = localConfigProvider.IssuerKind
+	}
+	spec.TLSSecretNamespace = annotations[tlsSecretNamespaceAnnotation]
+}
@@ -0,0 +1,254 @@
+package url
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/openshift/odo/pkg/kclient"
+	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/openshift/odo/pkg/occlient"
+	"github.com/openshift/odo/pkg/testingutil"
+	urlLabels "github.com/openshift/odo/pkg/url/labels"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	ktesting "k8s.io/client-go/testing"
+)
+
+// generateCertificate returns a PEM encoded self-signed certificate for the given host and validity period
+func generateCertificate(t *testing.T, host string, notBefore, notAfter time.Time) ([]byte, []byte) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	template := x509.Certificate{
+		SerialNumber: big.NewInt(1),
+		Subject:      pkix.Name{CommonName: host},
+		NotBefore:    notBefore,
+		NotAfter:     notAfter,
+		DNSNames:     []string{host},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, &template, &template, &key.PublicKey, key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	certPEM := pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der})
+	keyPEM := pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)})
+	return certPEM, keyPEM
+}
+
+func TestGetCertificateStatus(t *testing.T) {
+	now := time.Date(2021, 6, 1, 0, 0, 0, 0, time.UTC)
+	tests := []struct {
+		name      string
+		host      string
+		notBefore time.Time
+		notAfter  time.Time
+		certPEM   []byte
+		wantState CertificateState
+	}{
+		{
+			name:      "Case 1: valid certificate",
+			host:      "myurl.example.com",
+			notBefore: now.AddDate(0, -1, 0),
+			notAfter:  now.AddDate(1, 0, 0),
+			wantState: CertificateStateValid,
+		},
+		{
+			name:      "Case 2: certificate expiring in less than 30 days",
+			host:      "myurl.example.com",
+			notBefore: now.AddDate(0, -1, 0),
+			notAfter:  now.AddDate(0, 0, 10),
+			wantState: CertificateStateExpiringSoon,
+		},
+		{
+			name:      "Case 3: expired certificate",
+			host:      "myurl.example.com",
+			notBefore: now.AddDate(-1, 0, 0),
+			notAfter:  now.AddDate(0, 0, -1),
+			wantState: CertificateStateExpired,
+		},
+		{
+			name:      "Case 4: certificate not yet valid",
+			host:      "myurl.example.com",
+			notBefore: now.AddDate(0, 0, 1),
+			notAfter:  now.AddDate(1, 0, 0),
+			wantState: CertificateStateNotYetValid,
+		},
+		{
+			name:      "Case 5: certificate of another host",
+			host:      "other.example.com",
+			notBefore: now.AddDate(0, -1, 0),
+			notAfter:  now.AddDate(1, 0, 0),
+			wantState: CertificateStateInvalid,
+		},
+		{
+			name:      "Case 6: secret without certificate",
+			host:      "myurl.example.com",
+			certPEM:   []byte("invalid"),
+			wantState: CertificateStateInvalid,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			certPEM := tt.certPEM
+			if certPEM == nil {
+				certPEM, _ = generateCertificate(t, "myurl.example.com", tt.notBefore, tt.notAfter)
+			}
+			status := getCertificateStatus(certPEM, tt.host, now)
+			if status.State != tt.wantState {
+				t.Errorf("getCertificateStatus() state = %q, want %q (%s)", status.State, tt.wantState, status.Message)
+			}
+			if tt.certPEM == nil && (!status.SelfSigned || !status.NotAfter.Time.Equal(tt.notAfter)) {
+				t.Errorf("getCertificateStatus() unexpected status: %+v", status)
+			}
+		})
+	}
+
+	url := getFakeURL("myurl", "myurl.example.com", 8080, "/", "https", localConfigProvider.INGRESS, StateTypePushed)
+	url.Spec.Issuer, url.Spec.IssuerKind = "letsencrypt", localConfigProvider.ClusterIssuerKind
+	if status := getTLSSecretCertificateStatus(url, nil, now); status.State != CertificateStatePending {
+		t.Errorf("getTLSSecretCertificateStatus() state = %q, want %q", status.State, CertificateStatePending)
+	}
+}
+
+func Test_kubernetesClient_createIngressCertificate(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-url-certificate")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certPEM, keyPEM := generateCertificate(t, "example.example.com", time.Now(), time.Now().AddDate(1, 0, 0))
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err = ioutil.WriteFile(certFile, certPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err = ioutil.WriteFile(keyFile, keyPEM, 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	tests := []struct {
+		name            string
+		setSpec         func(spec *URLSpec)
+		wantSecret      string
+		wantAnnotations map[string]string
+		wantSecretData  []byte
+	}{
+		{
+			name: "Case 1: certificate requested from a cert-manager ClusterIssuer",
+			setSpec: func(spec *URLSpec) {
+				spec.Issuer, spec.IssuerKind = "letsencrypt", localConfigProvider.ClusterIssuerKind
+			},
+			wantSecret:      "example-nodejs-app-tlssecret",
+			wantAnnotations: map[string]string{certManagerClusterIssuerAnnotation: "letsencrypt"},
+		},
+		{
+			name: "Case 2: certificate requested from a cert-manager Issuer in the given secret",
+			setSpec: func(spec *URLSpec) {
+				spec.Issuer, spec.IssuerKind = "ca-issuer", localConfigProvider.IssuerKind
+				spec.TLSSecret = "my-cert"
+			},
+			wantSecret:      "my-cert",
+			wantAnnotations: map[string]string{certManagerIssuerAnnotation: "ca-issuer"},
+		},
+		{
+			name: "Case 3: secret created from the local certificate files",
+			setSpec: func(spec *URLSpec) {
+				spec.TLSCertFile, spec.TLSKeyFile = certFile, keyFile
+			},
+			wantSecret:      "example-nodejs-app-tlssecret",
+			wantAnnotations: map[string]string{},
+			wantSecretData:  certPEM,
+		},
+		{
+			name: "Case 4: wildcard secret copied from another namespace",
+			setSpec: func(spec *URLSpec) {
+				spec.TLSSecret, spec.TLSSecretNamespace = "wildcard", "certs"
+			},
+			wantSecret:      "wildcard",
+			wantAnnotations: map[string]string{tlsSecretNamespaceAnnotation: "certs"},
+			wantSecretData:  []byte("wildcard-cert"),
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client, _ := occlient.FakeNew()
+			fakeKClient, fakeKClientSet := kclient.FakeNewWithIngressSupports(true, false)
+			fakeKClient.Namespace = "default"
+			client.SetKubeClient(fakeKClient)
+
+			fakeKClientSet.Kubernetes.PrependReactor("list", "services", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return true, &corev1.ServiceList{Items: []corev1.Service{testingutil.FakeKubeService("nodejs", "nodejs-app")}}, nil
+			})
+			fakeKClientSet.Kubernetes.PrependReactor("list", "deployments", func(action ktesting.Action) (bool, runtime.Object, error) {
+				return true, &appsv1.DeploymentList{Items: []appsv1.Deployment{*testingutil.CreateFakeDeployment("nodejs")}}, nil
+			})
+			_, err := fakeKClientSet.Kubernetes.CoreV1().Secrets("certs").Create(context.TODO(), &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: "wildcard", Namespace: "certs"},
+				Type:       corev1.SecretTypeTLS,
+				Data:       map[string][]byte{corev1.TLSCertKey: []byte("wildcard-cert"), corev1.TLSPrivateKeyKey: []byte("wildcard-key")},
+			}, metav1.CreateOptions{})
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			k := kubernetesClient{
+				generic: generic{componentName: "nodejs", appName: "app"},
+				client:  *client,
+			}
+			url := getFakeURL("example", "com", 8080, "/", "https", localConfigProvider.INGRESS, StateTypeNotPushed)
+			url.Spec.Secure = true
+			tt.setSpec(&url.Spec)
+
+			_, err = k.createIngress(url, urlLabels.GetLabels(url.Name, k.componentName, k.appName, true))
+			if err != nil {
+				t.Fatalf("createIngress() unexpected error: %v", err)
+			}
+
+			ingress, err := fakeKClientSet.Kubernetes.NetworkingV1().Ingresses("default").Get(context.TODO(), "example-nodejs-app", metav1.GetOptions{})
+			if err != nil {
+				t.Fatalf("the ingress has not been created: %v", err)
+			}
+			if secret := ingress.Spec.TLS[0].SecretName; secret != tt.wantSecret {
+				t.Errorf("ingress TLS secret = %q, want %q", secret, tt.wantSecret)
+			}
+			for key, value := range tt.wantAnnotations {
+				if ingress.Annotations[key] != value {
+					t.Errorf("ingress annotation %s = %q, want %q", key, ingress.Annotations[key], value)
+				}
+			}
+			checkCertificateSource(t, ingress, url.Spec)
+
+			if tt.wantSecretData != nil {
+				secret, err := fakeKClientSet.Kubernetes.CoreV1().Secrets("default").Get(context.TODO(), tt.wantSecret, metav1.GetOptions{})
+				if err != nil {
+					t.Fatalf("the TLS secret has not been created: %v", err)
+				}
+				if string(secret.Data[corev1.TLSCertKey]) != string(tt.wantSecretData) {
+					t.Errorf("unexpected certificate of the TLS secret: %s", secret.Data[corev1.TLSCertKey])
+				}
+			}
+		})
+	}
+}
+
+// checkCertificateSource checks that the certificate source of the URL is found back from the annotations of the ingress
+func checkCertificateSource(t *testing.T, ingress *networkingv1.Ingress, spec URLSpec) {
+	var got URLSpec
+	setCertificateSource(&got, ingress.Annotations)
+	if got.Issuer != spec.Issuer || got.IssuerKind != spec.IssuerKind || got.TLSSecretNamespace != spec.TLSSecretNamespace {
+		t.Errorf("unexpected certificate source %+v from the annotations %v", got, ingress.Annotations)
+	}
+}
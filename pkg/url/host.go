@@ -0,0 +1,78 @@
+package url
+
+import (
+	"net"
+	neturl "net/url"
+
+	"github.com/openshift/odo/pkg/kclient"
+	"k8s.io/klog"
+)
+
+// HostSource describes where the default host of the ingress URLs has been found
+type HostSource string
+
+const (
+	// HostSourcePreference is used when the host is the IngressDomain preference
+	HostSourcePreference HostSource = "the IngressDomain preference"
+	// HostSourceOpenShiftIngress is used when the host is the domain of the OpenShift ingress configuration
+	HostSourceOpenShiftIngress HostSource = "the ingress configuration of the cluster"
+	// HostSourceLocalCluster is used when the host is a nip.io host resolving to the address of a local cluster
+	HostSourceLocalCluster HostSource = "the address of the local cluster"
+)
+
+// GetDefaultIngressHost returns the host used for the ingress URLs created without a host and where it has been found:
+// the IngressDomain preference, the domain of the OpenShift ingress configuration or, for the clusters reachable on
+// a loopback or private address, the nip.io host resolving to this address. An empty host is returned when none is found
+func GetDefaultIngressHost(client *kclient.Client, preferenceDomain string) (string, HostSource, error) {
+	if preferenceDomain != "" {
+		return preferenceDomain, HostSourcePreference, nil
+	}
+	if client == nil {
+		return "", "", nil
+	}
+
+	domain, err := client.GetClusterIngressDomain()
+	if err != nil {
+		klog.V(4).Infof("unable to get the ingress domain of the cluster: %v", err)
+	} else if domain != "" {
+		return domain, HostSourceOpenShiftIngress, nil
+	}
+
+	if client.KubeClientConfig != nil {
+		if host := getLocalClusterHost(client.KubeClientConfig.Host); host != "" {
+			return host, HostSourceLocalCluster, nil
+		}
+	}
+	return "", "", nil
+}
+
+// getLocalClusterHost returns the nip.io host of the API server address when it is a loopback or private IP
+func getLocalClusterHost(server string) string {
+	u, err := neturl.Parse(server)
+	if err != nil || u.Hostname() == "" {
+		return ""
+	}
+	hostname := u.Hostname()
+	if hostname == "localhost" {
+		hostname = "127.0.0.1"
+	}
+	ip := net.ParseIP(hostname)
+	if ip == nil || ip.To4() == nil {
+		return ""
+	}
+	if !ip.IsLoopback() && !isPrivateIP(ip) {
+		return ""
+	}
+	return ip.String() + ".nip.io"
+}
+
+// isPrivateIP returns true if the IPv4 address belongs to the private address ranges of RFC 1918
+func isPrivateIP(ip net.IP) bool {
+	for _, cidr := range []string{"10.0.0.0/8", "172.16.0.0/12", "192.168.0.0/16"} {
+		_, network, _ := net.ParseCIDR(cidr)
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,136 @@
+package url
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/openshift/odo/pkg/kclient"
+	"k8s.io/client-go/rest"
+)
+
+func TestGetDefaultIngressHost(t *testing.T) {
+	tests := []struct {
+		name             string
+		preferenceDomain string
+		server           string
+		wantHost         string
+		wantSource       HostSource
+	}{
+		{
+			name:             "Case 1: the preference is used first",
+			preferenceDomain: "apps.example.com",
+			server:           "https://127.0.0.1:6443",
+			wantHost:         "apps.example.com",
+			wantSource:       HostSourcePreference,
+		},
+		{
+			name:       "Case 2: loopback address of the API server",
+			server:     "https://127.0.0.1:6443",
+			wantHost:   "127.0.0.1.nip.io",
+			wantSource: HostSourceLocalCluster,
+		},
+		{
+			name:       "Case 3: localhost API server",
+			server:     "https://localhost:8443",
+			wantHost:   "127.0.0.1.nip.io",
+			wantSource: HostSourceLocalCluster,
+		},
+		{
+			name:       "Case 4: private address of the API server",
+			server:     "https://192.168.39.247:8443",
+			wantHost:   "192.168.39.247.nip.io",
+			wantSource: HostSourceLocalCluster,
+		},
+		{
+			name:   "Case 5: public address of the API server",
+			server: "https://8.8.8.8:6443",
+		},
+		{
+			name:   "Case 6: API server with a name",
+			server: "https://api.cluster.example.com:6443",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			client := &kclient.Client{KubeClientConfig: &rest.Config{Host: tt.server}}
+			host, source, err := GetDefaultIngressHost(client, tt.preferenceDomain)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if host != tt.wantHost || source != tt.wantSource {
+				t.Errorf("got host %q from %q, want %q from %q", host, source, tt.wantHost, tt.wantSource)
+			}
+		})
+	}
+}
+
+func TestFilterAnnotations(t *testing.T) {
+	cluster := map[string]string{
+		"nginx.ingress.kubernetes.io/rewrite-target": "/",
+		"kubernetes.io/ingress.class":                "nginx",
+	}
+	got := filterAnnotations(cluster, map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/app"})
+	if len(got) != 1 || got["nginx.ingress.kubernetes.io/rewrite-target"] != "/" {
+		t.Errorf("unexpected filtered annotations: %v", got)
+	}
+	if got := filterAnnotations(cluster, nil); got != nil {
+		t.Errorf("expected nil annotations, got %v", got)
+	}
+}
+
+func TestGetAppliedIngressSettings(t *testing.T) {
+	tests := []struct {
+		name            string
+		cluster         URLSpec
+		local           URLSpec
+		wantAnnotations map[string]string
+		wantClass       string
+	}{
+		{
+			name: "annotation and class added by the cluster",
+			cluster: URLSpec{
+				IngressClass: "default",
+				Annotations: map[string]string{
+					appliedAnnotationsAnnotation:                 "nginx.ingress.kubernetes.io/rewrite-target",
+					"nginx.ingress.kubernetes.io/rewrite-target": "/",
+					"kubernetes.io/ingress.class":                "nginx",
+				},
+			},
+			local:           URLSpec{Annotations: map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"}},
+			wantAnnotations: map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"},
+		},
+		{
+			name: "annotation and class removed from the local URL",
+			cluster: URLSpec{
+				IngressClass: "nginx",
+				Annotations: map[string]string{
+					appliedAnnotationsAnnotation:                 "nginx.ingress.kubernetes.io/rewrite-target",
+					appliedIngressClassAnnotation:                "nginx",
+					"nginx.ingress.kubernetes.io/rewrite-target": "/",
+				},
+			},
+			wantAnnotations: map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"},
+			wantClass:       "nginx",
+		},
+		{
+			name: "ingress created by a previous version",
+			cluster: URLSpec{
+				IngressClass: "default",
+				Annotations: map[string]string{
+					"nginx.ingress.kubernetes.io/rewrite-target": "/",
+					"kubernetes.io/ingress.class":                "nginx",
+				},
+			},
+			local:           URLSpec{Annotations: map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/app"}},
+			wantAnnotations: map[string]string{"nginx.ingress.kubernetes.io/rewrite-target": "/"},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			annotations, class := getAppliedIngressSettings(tt.cluster, tt.local)
+			if !reflect.DeepEqual(annotations, tt.wantAnnotations) || class != tt.wantClass {
+				t.Errorf("getAppliedIngressSettings() = %v, %q, want %v, %q", annotations, class, tt.wantAnnotations, tt.wantClass)
+			}
+		})
+	}
+}
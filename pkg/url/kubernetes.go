@@ -3,13 +3,16 @@ package url
 import (
 	"fmt"
 	"github.com/openshift/odo/pkg/unions"
+	"io/ioutil"
 	"sort"
+	"time"
 
 	"github.com/devfile/library/pkg/devfile/generator"
 	routev1 "github.com/openshift/api/route/v1"
 	componentlabels "github.com/openshift/odo/pkg/component/labels"
 	"github.com/openshift/odo/pkg/kclient"
 	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/openshift/odo/pkg/log"
 	"github.com/openshift/odo/pkg/occlient"
 	urlLabels "github.com/openshift/odo/pkg/url/labels"
 	"github.com/openshift/odo/pkg/util"
@@ -109,6 +112,13 @@ func (k kubernetesClient) List() (URLList, error) {
 		if found {
 			// URL is in both local env file and cluster
 			clusterURL.Status.State = StateTypePushed
+			// the status of the certificate is informative, the URL is still listed if the secret can't be read
+			certificate, err := k.getCertificateStatus(clusterURL)
+			if err != nil {
+				log.Warningf("unable to get the certificate status of the URL %s: %v", URLName, err)
+			} else {
+				clusterURL.Status.Certificate = certificate
+			}
 			urls = append(urls, clusterURL)
 		} else {
 			// URL is on the cluster but not in local env file
@@ -134,6 +144,33 @@ func (k kubernetesClient) List() (URLList, error) {
 	return urlList, nil
 }
 
+// getCertificateStatus returns the status of the certificate of the TLS secret of the secure ingress URLs,
+// nil is returned for the other URLs
+func (k kubernetesClient) getCertificateStatus(url URL) (*CertificateStatus, error) {
+	if url.Spec.Kind != localConfigProvider.INGRESS || !url.Spec.Secure || url.Spec.TLSSecret == "" {
+		return nil, nil
+	}
+	secret, err := k.client.GetKubeClient().GetSecret(url.Spec.TLSSecret, k.client.Namespace)
+	if kerrors.IsNotFound(err) {
+		secret = nil
+	} else if err != nil {
+		return nil, err
+	}
+	status := getTLSSecretCertificateStatus(url, secret, time.Now())
+	return &status, nil
+}
+
+// GetTLSSecretData returns the data of the TLS secret of the given name, or nil if the secret doesn't exist
+func (k kubernetesClient) GetTLSSecretData(name string) (map[string][]byte, error) {
+	secret, err := k.client.GetKubeClient().GetSecret(name, k.client.Namespace)
+	if kerrors.IsNotFound(err) {
+		return nil, nil
+	} else if err != nil {
+		return nil, errors.Wrapf(err, "unable to get the TLS secret %s", name)
+	}
+	return secret.Data, nil
+}
+
 // Delete deletes the URL with the given name and kind
 func (k kubernetesClient) Delete(name string, kind localConfigProvider.URLKind) error {
 	selector := util.ConvertLabelsToSelector(urlLabels.GetLabels(name, k.componentName, k.appName, false))
@@ -200,7 +237,44 @@ func (k kubernetesClient) createIngress(url URL, labels map[string]string) (stri
 	}
 	ownerReference := generator.GetOwnerReference(deployment)
 
-	if url.Spec.Secure {
+	secretLabels := componentlabels.GetLabels(k.componentName, k.appName, true)
+	annotations := map[string]string{}
+	if url.Spec.Secure && (url.Spec.TLSSecretNamespace != "" || url.Spec.TLSCertFile != "" || url.Spec.Issuer != "") {
+		annotations = getCertificateAnnotations(url)
+		url.Spec.TLSSecret = getTLSSecretName(url, k.componentName, k.appName)
+		objectMeta := metav1.ObjectMeta{
+			Name:   url.Spec.TLSSecret,
+			Labels: secretLabels,
+			OwnerReferences: []v1.OwnerReference{
+				ownerReference,
+			},
+		}
+		switch {
+		case url.Spec.TLSSecretNamespace != "":
+			// reuse the secret of the other namespace, like a wildcard certificate
+			_, err = k.client.GetKubeClient().CopyTLSSecret(url.Spec.TLSSecret, url.Spec.TLSSecretNamespace, objectMeta)
+			if err != nil {
+				return "", errors.Wrapf(err, "unable to copy the TLS secret %s of the namespace %s", url.Spec.TLSSecret, url.Spec.TLSSecretNamespace)
+			}
+		case url.Spec.TLSCertFile != "":
+			// create the secret from the local certificate and key
+			certificate, err := ioutil.ReadFile(url.Spec.TLSCertFile)
+			if err != nil {
+				return "", errors.Wrapf(err, "unable to read the certificate file %s", url.Spec.TLSCertFile)
+			}
+			key, err := ioutil.ReadFile(url.Spec.TLSKeyFile)
+			if err != nil {
+				return "", errors.Wrapf(err, "unable to read the key file %s", url.Spec.TLSKeyFile)
+			}
+			_, err = k.client.GetKubeClient().ApplyTLSSecret(certificate, key, objectMeta)
+			if err != nil {
+				return "", errors.Wrap(err, "unable to create tls secret")
+			}
+		default:
+			// the secret is created by cert-manager from the annotation of the ingress
+			klog.V(4).Infof("requesting the certificate of the URL %s from the %s %s", url.Name, url.Spec.IssuerKind, url.Spec.Issuer)
+		}
+	} else if url.Spec.Secure {
 		if len(url.Spec.TLSSecret) != 0 {
 			// get the user given secret
 			_, err := k.client.GetKubeClient().GetSecret(url.Spec.TLSSecret, k.client.Namespace)
@@ -219,7 +293,6 @@ func (k kubernetesClient) createIngress(url URL, labels map[string]string) (stri
 					return "", errors.Wrap(err, "unable to generate self-signed certificate for clutser: "+url.Spec.Host)
 				}
 				// create tls secret
-				secretLabels := componentlabels.GetLabels(k.componentName, k.appName, true)
 				objectMeta := metav1.ObjectMeta{
 					Name:   defaultTLSSecretName,
 					Labels: secretLabels,
@@ -247,7 +320,13 @@ func (k kubernetesClient) createIngress(url URL, labels map[string]string) (stri
 	if err != nil {
 		return "", err
 	}
-	objectMeta := generator.GetObjectMeta(k.componentName, k.client.Namespace, labels, nil)
+	for key, value := range url.Spec.Annotations {
+		annotations[key] = value
+	}
+	for key, value := range getAppliedAnnotations(url) {
+		annotations[key] = value
+	}
+	objectMeta := generator.GetObjectMeta(k.componentName, k.client.Namespace, labels, annotations)
 	// to avoid error due to duplicate ingress name defined in different devfile components
 	objectMeta.Name = ingressName
 	objectMeta.OwnerReferences = append(objectMeta.OwnerReferences, ownerReference)
@@ -263,6 +342,7 @@ func (k kubernetesClient) createIngress(url URL, labels map[string]string) (stri
 		},
 	}
 	ingress := unions.NewKubernetesIngressFromParams(ingressParam)
+	ingress.SetIngressClassName(url.Spec.IngressClass)
 	// Pass in the namespace name, link to the service (componentName) and labels to create a ingress
 	i, err := k.client.GetKubeClient().CreateIngress(*ingress)
 	if err != nil {
@@ -91,3 +91,18 @@ func (mr *MockClientMockRecorder) List() *gomock.Call {
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "List", reflect.TypeOf((*MockClient)(nil).List))
 }
+
+// GetTLSSecretData mocks base method
+func (m *MockClient) GetTLSSecretData(name string) (map[string][]byte, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetTLSSecretData", name)
+	ret0, _ := ret[0].(map[string][]byte)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetTLSSecretData indicates an expected call of GetTLSSecretData
+func (mr *MockClientMockRecorder) GetTLSSecretData(name interface{}) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetTLSSecretData", reflect.TypeOf((*MockClient)(nil).GetTLSSecretData), name)
+}
@@ -108,6 +108,11 @@ func (s s2iClient) Delete(name string, kind localConfigProvider.URLKind) error {
 	return s.client.DeleteRoute(routeName)
 }
 
+// GetTLSSecretData returns nil, the secrets of the URLs are not created from local files for s2i components
+func (s s2iClient) GetTLSSecretData(name string) (map[string][]byte, error) {
+	return nil, nil
+}
+
 // Create creates a route based on the given URL
 func (s s2iClient) Create(url URL) (string, error) {
 	routeName, err := util.NamespaceOpenShiftObject(url.Name, s.appName)
@@ -4,11 +4,14 @@ import (
 	"crypto/tls"
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	"github.com/openshift/odo/pkg/localConfigProvider"
 
+	devfilev1 "github.com/devfile/api/v2/pkg/apis/workspaces/v1alpha2"
 	routev1 "github.com/openshift/api/route/v1"
+	"github.com/openshift/odo/pkg/debug"
 	"github.com/openshift/odo/pkg/kclient"
 	"github.com/openshift/odo/pkg/machineoutput"
 	"github.com/openshift/odo/pkg/occlient"
@@ -20,6 +23,9 @@ import (
 const (
 	// URLFailureWaitTime is how long to wait on error from URL connection
 	URLFailureWaitTime = time.Duration(5) * time.Second
+
+	// PortForwardKind is the kind reported for endpoints forwarded to localhost
+	PortForwardKind = "portforward"
 )
 
 // StartURLHttpRequestStatusWatchForK8S begins testing URLs for responses, outputting the result to console
@@ -55,6 +61,26 @@ func StartURLHttpRequestStatusWatchForK8S(occlient *occlient.Client, client *kcl
 	}()
 }
 
+// StartURLHttpRequestStatusWatchForPortForward begins testing the endpoints forwarded to localhost for responses, outputting the result to console
+func StartURLHttpRequestStatusWatchForPortForward(endpoints []debug.ForwardedEndpoint, loggingClient machineoutput.MachineEventLoggingClient) {
+	var urlsToTest [][]statusURL
+	for _, endpoint := range endpoints {
+		// only HTTP based endpoints can be tested for responses
+		if endpoint.Protocol != "" && endpoint.Protocol != devfilev1.HTTPEndpointProtocol && endpoint.Protocol != devfilev1.HTTPSEndpointProtocol {
+			continue
+		}
+		urlsToTest = append(urlsToTest, []statusURL{{
+			name:   endpoint.Name,
+			url:    endpoint.LocalURL(),
+			port:   endpoint.LocalPort,
+			secure: strings.HasPrefix(endpoint.LocalURL(), "https"),
+			kind:   PortForwardKind,
+		}})
+	}
+
+	startURLTester(urlsToTest, loggingClient)
+}
+
 // startURLTester kicks off a new goroutine for each set of URLs to test
 func startURLTester(urlsToTest [][]statusURL, loggingClient machineoutput.MachineEventLoggingClient) {
 
@@ -27,6 +27,16 @@ type URLSpec struct {
 	TLSSecret    string                      `json:"tlssecret,omitempty"`
 	ExternalPort int                         `json:"externalport,omitempty"`
 	Path         string                      `json:"path,omitempty"`
+	IngressClass string                      `json:"ingressClass,omitempty"`
+	Annotations  map[string]string           `json:"annotations,omitempty"`
+	// TLSSecretNamespace is the namespace the TLS secret is copied from
+	TLSSecretNamespace string `json:"tlsSecretNamespace,omitempty"`
+	// TLSCertFile and TLSKeyFile are the local files the TLS secret is created from
+	TLSCertFile string `json:"tlsCertFile,omitempty"`
+	TLSKeyFile  string `json:"tlsKeyFile,omitempty"`
+	// Issuer and IssuerKind are the cert-manager issuer the certificate is requested from
+	Issuer     string `json:"issuer,omitempty"`
+	IssuerKind string `json:"issuerKind,omitempty"`
 }
 
 // URLList is a list of applications
@@ -40,6 +50,8 @@ type URLList struct {
 type URLStatus struct {
 	// "Pushed" or "Not Pushed" or "Locally Delted"
 	State StateType `json:"state"`
+	// Certificate is the status of the certificate of the secure URLs pushed on the cluster
+	Certificate *CertificateStatus `json:"certificate,omitempty"`
 }
 
 type StateType string
@@ -83,6 +95,9 @@ func NewURLFromKubernetesIngress(ki *unions.KubernetesIngress, skipIfGenerated b
 		if len(ki.NetworkingV1Ingress.Spec.TLS) > 0 {
 			u.Spec.TLSSecret = ki.NetworkingV1Ingress.Spec.TLS[0].SecretName
 		}
+		u.Spec.IngressClass = ki.GetIngressClassName()
+		u.Spec.Annotations = ki.NetworkingV1Ingress.Annotations
+		setCertificateSource(&u.Spec, u.Spec.Annotations)
 		if u.Spec.Secure {
 			u.Spec.Protocol = "https"
 		} else {
@@ -100,6 +115,9 @@ func NewURLFromKubernetesIngress(ki *unions.KubernetesIngress, skipIfGenerated b
 		if len(ki.ExtensionV1Beta1Ingress.Spec.TLS) > 0 {
 			u.Spec.TLSSecret = ki.ExtensionV1Beta1Ingress.Spec.TLS[0].SecretName
 		}
+		u.Spec.IngressClass = ki.GetIngressClassName()
+		u.Spec.Annotations = ki.ExtensionV1Beta1Ingress.Annotations
+		setCertificateSource(&u.Spec, u.Spec.Annotations)
 		if u.Spec.Secure {
 			u.Spec.Protocol = "https"
 		} else {
@@ -1,10 +1,14 @@
 package url
 
 import (
+	"crypto/sha256"
 	"fmt"
+	"io/ioutil"
 	"net"
 	"reflect"
+	"sort"
 	"strconv"
+	"strings"
 
 	"github.com/openshift/odo/pkg/log"
 
@@ -18,6 +22,7 @@ import (
 	urlLabels "github.com/openshift/odo/pkg/url/labels"
 	"github.com/openshift/odo/pkg/util"
 	"github.com/pkg/errors"
+	corev1 "k8s.io/api/core/v1"
 	iextensionsv1 "k8s.io/api/extensions/v1beta1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/intstr"
@@ -26,6 +31,13 @@ import (
 
 const apiVersion = "odo.dev/v1alpha1"
 
+const (
+	// appliedAnnotationsAnnotation is the annotation of the ingress of a URL listing the keys of the annotations of the URL
+	appliedAnnotationsAnnotation = "odo.dev/url-annotations"
+	// appliedIngressClassAnnotation is the annotation of the ingress of a URL created with an ingress class, containing the class
+	appliedIngressClassAnnotation = "odo.dev/url-ingress-class"
+)
+
 // ListPushed lists the URLs in an application that are in cluster. The results can further be narrowed
 /// down if a component name is provided, which will only list URLs for the
 // given component
@@ -144,6 +156,13 @@ func ConvertEnvinfoURL(envinfoURL localConfigProvider.LocalURL, serviceName stri
 	}
 	if kind == localConfigProvider.INGRESS {
 		url.Spec.Host = hostString
+		url.Spec.IngressClass = envinfoURL.IngressClass
+		url.Spec.Annotations = envinfoURL.Annotations
+		url.Spec.TLSSecretNamespace = envinfoURL.TLSSecretNamespace
+		url.Spec.TLSCertFile = envinfoURL.TLSCertFile
+		url.Spec.TLSKeyFile = envinfoURL.TLSKeyFile
+		url.Spec.Issuer = envinfoURL.Issuer
+		url.Spec.IssuerKind = envinfoURL.IssuerKind
 		if envinfoURL.Secure && len(envinfoURL.TLSSecret) > 0 {
 			url.Spec.TLSSecret = envinfoURL.TLSSecret
 		} else if envinfoURL.Secure {
@@ -164,13 +183,21 @@ func ConvertLocalURL(localURL localConfigProvider.LocalURL) URL {
 			Name: localURL.Name,
 		},
 		Spec: URLSpec{
-			Host:      localURL.Host,
-			Protocol:  localURL.Protocol,
-			Port:      localURL.Port,
-			Secure:    localURL.Secure,
-			Kind:      localURL.Kind,
-			TLSSecret: localURL.TLSSecret,
-			Path:      localURL.Path,
+			Host:         localURL.Host,
+			Protocol:     localURL.Protocol,
+			Port:         localURL.Port,
+			Secure:       localURL.Secure,
+			Kind:         localURL.Kind,
+			TLSSecret:    localURL.TLSSecret,
+			Path:         localURL.Path,
+			IngressClass: localURL.IngressClass,
+			Annotations:  localURL.Annotations,
+
+			TLSSecretNamespace: localURL.TLSSecretNamespace,
+			TLSCertFile:        localURL.TLSCertFile,
+			TLSKeyFile:         localURL.TLSKeyFile,
+			Issuer:             localURL.Issuer,
+			IssuerKind:         localURL.IssuerKind,
 		},
 	}
 }
@@ -255,6 +282,24 @@ func getDefaultTLSSecretName(componentName, appName string) string {
 	return componentName + "-" + appName + "-tlssecret"
 }
 
+// getURLTLSSecretName returns the name of the TLS secret of the URL created from local files or by cert-manager,
+// when no TLS secret name is given
+func getURLTLSSecretName(urlName, componentName, appName string) string {
+	return urlName + "-" + componentName + "-" + appName + "-tlssecret"
+}
+
+// getTLSSecretName returns the name of the TLS secret used by the secure URL of ingress kind
+func getTLSSecretName(url URL, componentName, appName string) string {
+	switch {
+	case url.Spec.TLSSecret != "":
+		return url.Spec.TLSSecret
+	case url.Spec.Issuer != "" || url.Spec.TLSCertFile != "":
+		return getURLTLSSecretName(url.Name, componentName, appName)
+	default:
+		return getDefaultTLSSecretName(componentName, appName)
+	}
+}
+
 // ConvertExtensionV1IngressURLToIngress converts IngressURL to Ingress
 func ConvertExtensionV1IngressURLToIngress(ingressURL URL, serviceName string) iextensionsv1.Ingress {
 	port := intstr.IntOrString{
@@ -303,6 +348,61 @@ func ConvertExtensionV1IngressURLToIngress(ingressURL URL, serviceName string) i
 	return ingress
 }
 
+// filterAnnotations returns the annotations of the cluster which are annotations of the local URL
+func filterAnnotations(clusterAnnotations, localAnnotations map[string]string) map[string]string {
+	var annotations map[string]string
+	for key, value := range clusterAnnotations {
+		if _, ok := localAnnotations[key]; ok {
+			if annotations == nil {
+				annotations = map[string]string{}
+			}
+			annotations[key] = value
+		}
+	}
+	return annotations
+}
+
+// getAppliedIngressSettings returns the annotations and the ingress class of the ingress of a URL applied by odo, recorded in the
+// annotations of the ingress. The annotations added by the cluster and the default class it sets on the ingresses created without
+// class are ignored, while the annotations and the class removed from the local URL are reported
+func getAppliedIngressSettings(cluster, local URLSpec) (map[string]string, string) {
+	keys, ok := cluster.Annotations[appliedAnnotationsAnnotation]
+	if !ok {
+		// the ingresses created by previous versions of odo don't record the applied settings
+		class := cluster.IngressClass
+		if local.IngressClass == "" {
+			class = ""
+		}
+		return filterAnnotations(cluster.Annotations, local.Annotations), class
+	}
+
+	applied := map[string]string{}
+	for _, key := range strings.Split(keys, ",") {
+		if key != "" {
+			applied[key] = ""
+		}
+	}
+	class := ""
+	if _, ok := cluster.Annotations[appliedIngressClassAnnotation]; ok {
+		class = cluster.IngressClass
+	}
+	return filterAnnotations(cluster.Annotations, applied), class
+}
+
+// getAppliedAnnotations returns the annotations recording the annotations and the ingress class of the URL applied to its ingress
+func getAppliedAnnotations(url URL) map[string]string {
+	var keys []string
+	for key := range url.Spec.Annotations {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	annotations := map[string]string{appliedAnnotationsAnnotation: strings.Join(keys, ",")}
+	if url.Spec.IngressClass != "" {
+		annotations[appliedIngressClassAnnotation] = url.Spec.IngressClass
+	}
+	return annotations
+}
+
 type PushParameters struct {
 	LocalConfig      localConfigProvider.LocalConfigProvider
 	URLClient        Client
@@ -357,9 +457,28 @@ func Push(parameters PushParameters) error {
 				// the default secret name is used during creation
 				// thus setting it to the local URLs to avoid config mismatch
 				if val.Spec.Secure && val.Spec.TLSSecret == "" {
-					val.Spec.TLSSecret = getDefaultTLSSecretName(parameters.LocalConfig.GetName(), parameters.LocalConfig.GetApplication())
+					val.Spec.TLSSecret = getTLSSecretName(val, parameters.LocalConfig.GetName(), parameters.LocalConfig.GetApplication())
+				}
+				// the local files of the certificate are not known by the cluster,
+				// their content is compared with the data of the secret instead to update it when the certificate is renewed
+				urlSpec.Spec.TLSCertFile, urlSpec.Spec.TLSKeyFile = val.Spec.TLSCertFile, val.Spec.TLSKeyFile
+				if val.Spec.TLSCertFile != "" {
+					configMismatch, err = tlsFilesChanged(parameters.URLClient, val.Spec)
+					if err != nil {
+						return err
+					}
+				}
+				// the cert-manager issuer can also be set by the annotations of the URL
+				if val.Spec.Issuer == "" && (val.Spec.Annotations[certManagerIssuerAnnotation] != "" || val.Spec.Annotations[certManagerClusterIssuerAnnotation] != "") {
+					urlSpec.Spec.Issuer, urlSpec.Spec.IssuerKind = "", ""
 				}
 				val.Spec.Host = fmt.Sprintf("%v.%v", urlName, val.Spec.Host)
+				// the cluster can add annotations to the ingresses and set its default class on the ingresses created without class,
+				// only the annotations and the class applied by odo are compared
+				urlSpec.Spec.Annotations, urlSpec.Spec.IngressClass = getAppliedIngressSettings(urlSpec.Spec, val.Spec)
+				if len(val.Spec.Annotations) == 0 {
+					val.Spec.Annotations = nil
+				}
 			} else if val.Spec.Kind == localConfigProvider.ROUTE {
 				// we don't allow the host input for route based URLs
 				// removing it for the urls from the cluster to avoid config mismatch
@@ -413,6 +532,30 @@ func Push(parameters PushParameters) error {
 	return nil
 }
 
+// tlsFilesChanged returns true if the content of the local certificate or key file of the URL
+// differs from the data of its TLS secret on the cluster
+func tlsFilesChanged(client Client, spec URLSpec) (bool, error) {
+	data, err := client.GetTLSSecretData(spec.TLSSecret)
+	if err != nil {
+		return false, err
+	}
+	files := map[string]string{
+		corev1.TLSCertKey:       spec.TLSCertFile,
+		corev1.TLSPrivateKeyKey: spec.TLSKeyFile,
+	}
+	for key, file := range files {
+		content, err := ioutil.ReadFile(file)
+		if err != nil {
+			return false, errors.Wrapf(err, "unable to read the file %s", file)
+		}
+		if sha256.Sum256(content) != sha256.Sum256(data[key]) {
+			klog.V(4).Infof("the file %s differs from the TLS secret %s", file, spec.TLSSecret)
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
 type ClientOptions struct {
 	OCClient            occlient.Client
 	IsRouteSupported    bool
@@ -424,6 +567,7 @@ type Client interface {
 	Delete(string, localConfigProvider.URLKind) error
 	ListFromCluster() (URLList, error)
 	List() (URLList, error)
+	GetTLSSecretData(name string) (map[string][]byte, error)
 }
 
 // NewClient gets the appropriate URL client based on the parameters
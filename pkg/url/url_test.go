@@ -2,6 +2,9 @@ package url
 
 import (
 	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
 	"reflect"
 	"testing"
 
@@ -839,6 +842,60 @@ func TestPush(t *testing.T) {
 	}
 }
 
+func TestTLSFilesChanged(t *testing.T) {
+	dir, err := ioutil.TempDir("", "odo-url")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	certFile, keyFile := filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key")
+	if err := ioutil.WriteFile(certFile, []byte("certificate"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(keyFile, []byte("key"), 0600); err != nil {
+		t.Fatal(err)
+	}
+	spec := URLSpec{TLSSecret: "example-tlssecret", TLSCertFile: certFile, TLSKeyFile: keyFile}
+
+	tests := []struct {
+		name string
+		data map[string][]byte
+		want bool
+	}{
+		{
+			name: "the secret contains the files",
+			data: map[string][]byte{"tls.crt": []byte("certificate"), "tls.key": []byte("key")},
+			want: false,
+		},
+		{
+			name: "the certificate was renewed",
+			data: map[string][]byte{"tls.crt": []byte("expired certificate"), "tls.key": []byte("key")},
+			want: true,
+		},
+		{
+			name: "the secret doesn't exist",
+			data: nil,
+			want: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ctrl := gomock.NewController(t)
+			defer ctrl.Finish()
+			mockURLClient := NewMockClient(ctrl)
+			mockURLClient.EXPECT().GetTLSSecretData(spec.TLSSecret).Return(tt.data, nil)
+
+			got, err := tlsFilesChanged(mockURLClient, spec)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if got != tt.want {
+				t.Errorf("tlsFilesChanged() = %v, want %v", got, tt.want)
+			}
+		})
+	}
+}
+
 func TestConvertEnvinfoURL(t *testing.T) {
 	serviceName := "testService"
 	urlName := "testURL"
@@ -0,0 +1,118 @@
+package util
+
+// MergeHunk is a region of a three-way merge changed in the local or in the remote version
+type MergeHunk struct {
+	// BaseLine is the line of the base version where the hunk starts, from 1
+	BaseLine int      `json:"baseLine"`
+	Base     []string `json:"base"`
+	Local    []string `json:"local"`
+	Remote   []string `json:"remote"`
+	// Conflict indicates that the local and the remote versions changed the region differently
+	Conflict bool `json:"conflict"`
+}
+
+// LocalChanged indicates that the local version changed the region of the hunk
+func (h MergeHunk) LocalChanged() bool {
+	return !equalLines(h.Base, h.Local)
+}
+
+// RemoteChanged indicates that the remote version changed the region of the hunk
+func (h MergeHunk) RemoteChanged() bool {
+	return !equalLines(h.Base, h.Remote)
+}
+
+// ThreeWayMerge merges the changes of the local and remote versions of the lines of a common base version.
+// It returns the merged lines, keeping the local lines of the conflicting regions, and the changed regions
+func ThreeWayMerge(base, local, remote []string) ([]string, []MergeHunk) {
+	localMatches := matchLines(base, local)
+	remoteMatches := matchLines(base, remote)
+
+	var merged []string
+	var hunks []MergeHunk
+	i, j, k := 0, 0, 0
+	for i < len(base) || j < len(local) || k < len(remote) {
+		// the line is unchanged in both versions
+		if i < len(base) && localMatches[i] == j && remoteMatches[i] == k {
+			merged = append(merged, base[i])
+			i, j, k = i+1, j+1, k+1
+			continue
+		}
+
+		// the changed region ends at the next line unchanged in both versions
+		i2, j2, k2 := len(base), len(local), len(remote)
+		for n := i; n < len(base); n++ {
+			if localMatches[n] >= 0 && remoteMatches[n] >= 0 {
+				i2, j2, k2 = n, localMatches[n], remoteMatches[n]
+				break
+			}
+		}
+		hunk := MergeHunk{
+			BaseLine: i + 1,
+			Base:     base[i:i2],
+			Local:    local[j:j2],
+			Remote:   remote[k:k2],
+		}
+		switch {
+		case !hunk.LocalChanged():
+			merged = append(merged, hunk.Remote...)
+		case !hunk.RemoteChanged() || equalLines(hunk.Local, hunk.Remote):
+			merged = append(merged, hunk.Local...)
+		default:
+			hunk.Conflict = true
+			merged = append(merged, hunk.Local...)
+		}
+		hunks = append(hunks, hunk)
+		i, j, k = i2, j2, k2
+	}
+	return merged, hunks
+}
+
+// matchLines returns, for each line of the base, the index of the matching line of the other version
+// in a longest common subsequence of the two versions, or -1 if the line has been changed
+func matchLines(base, other []string) []int {
+	// lcs[i][j] is the length of the longest common subsequence of base[i:] and other[j:]
+	lcs := make([][]int, len(base)+1)
+	for i := range lcs {
+		lcs[i] = make([]int, len(other)+1)
+	}
+	for i := len(base) - 1; i >= 0; i-- {
+		for j := len(other) - 1; j >= 0; j-- {
+			if base[i] == other[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	matches := make([]int, len(base))
+	i, j := 0, 0
+	for i < len(base) {
+		switch {
+		case j < len(other) && base[i] == other[j]:
+			matches[i] = j
+			i, j = i+1, j+1
+		case j < len(other) && lcs[i][j+1] > lcs[i+1][j]:
+			j++
+		default:
+			matches[i] = -1
+			i++
+		}
+	}
+	return matches
+}
+
+// equalLines indicates if the two slices of lines are equal
+func equalLines(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
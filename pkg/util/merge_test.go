@@ -0,0 +1,75 @@
+package util
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestThreeWayMerge(t *testing.T) {
+	base := "schemaVersion: 2.0.0\nmetadata:\n  name: nodejs\n  version: 1.0.0\ncomponents:\n- name: runtime\n  container:\n    image: nodejs:12\n    memoryLimit: 512Mi"
+	tests := []struct {
+		name          string
+		local         string
+		remote        string
+		wantMerged    string
+		wantHunks     int
+		wantConflicts int
+	}{
+		{
+			name:       "unchanged",
+			local:      base,
+			remote:     base,
+			wantMerged: base,
+		},
+		{
+			name:       "remote change only",
+			local:      base,
+			remote:     strings.Replace(base, "nodejs:12", "nodejs:14", 1),
+			wantMerged: strings.Replace(base, "nodejs:12", "nodejs:14", 1),
+			wantHunks:  1,
+		},
+		{
+			name:       "local and remote changes in different regions",
+			local:      strings.Replace(base, "512Mi", "1Gi", 1),
+			remote:     strings.Replace(base, "version: 1.0.0", "version: 1.1.0", 1),
+			wantMerged: strings.Replace(strings.Replace(base, "512Mi", "1Gi", 1), "version: 1.0.0", "version: 1.1.0", 1),
+			wantHunks:  2,
+		},
+		{
+			name:       "same change in both versions",
+			local:      base + "\n    mountSources: true",
+			remote:     base + "\n    mountSources: true",
+			wantMerged: base + "\n    mountSources: true",
+			wantHunks:  1,
+		},
+		{
+			name:          "conflicting changes keep the local lines",
+			local:         strings.Replace(base, "nodejs:12", "nodejs:12-slim", 1),
+			remote:        strings.Replace(base, "nodejs:12", "nodejs:14", 1),
+			wantMerged:    strings.Replace(base, "nodejs:12", "nodejs:12-slim", 1),
+			wantHunks:     1,
+			wantConflicts: 1,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			merged, hunks := ThreeWayMerge(strings.Split(base, "\n"), strings.Split(tt.local, "\n"), strings.Split(tt.remote, "\n"))
+			if !reflect.DeepEqual(merged, strings.Split(tt.wantMerged, "\n")) {
+				t.Errorf("ThreeWayMerge() merged =\n%s\nwant\n%s", strings.Join(merged, "\n"), tt.wantMerged)
+			}
+			if len(hunks) != tt.wantHunks {
+				t.Errorf("ThreeWayMerge() returned %d hunks, want %d: %+v", len(hunks), tt.wantHunks, hunks)
+			}
+			conflicts := 0
+			for _, hunk := range hunks {
+				if hunk.Conflict {
+					conflicts++
+				}
+			}
+			if conflicts != tt.wantConflicts {
+				t.Errorf("ThreeWayMerge() returned %d conflicts, want %d", conflicts, tt.wantConflicts)
+			}
+		})
+	}
+}
@@ -834,6 +834,73 @@ func HTTPGetRequest(request HTTPRequestParams, cacheFor int) ([]byte, error) {
 	return bytes, err
 }
 
+// HTTPCacheValidators are the validators of a cached HTTP response, sent with conditional requests
+type HTTPCacheValidators struct {
+	ETag         string
+	LastModified string
+}
+
+// HTTPStatusError is returned when a HTTP request gets a non 1xx / 2xx / 304 status
+type HTTPStatusError struct {
+	URL        string
+	StatusCode int
+}
+
+func (e HTTPStatusError) Error() string {
+	return fmt.Sprintf("failed to retrieve %s: %s", e.URL, http.StatusText(e.StatusCode))
+}
+
+// HTTPConditionalGetRequest gets resource contents given URL and token (if applicable), revalidating a cached response
+// with its ETag and Last-Modified validators. It returns the contents with their validators, or notModified if the
+// cached response is still valid
+func HTTPConditionalGetRequest(request HTTPRequestParams, validators HTTPCacheValidators) (data []byte, newValidators HTTPCacheValidators, notModified bool, err error) {
+	req, err := http.NewRequest("GET", request.URL, nil)
+	if err != nil {
+		return nil, newValidators, false, err
+	}
+	if request.Token != "" {
+		req.Header.Add("Authorization", "Bearer "+request.Token)
+	}
+	if validators.ETag != "" {
+		req.Header.Add("If-None-Match", validators.ETag)
+	}
+	if validators.LastModified != "" {
+		req.Header.Add("If-Modified-Since", validators.LastModified)
+	}
+
+	httpClient := &http.Client{
+		Transport: &http.Transport{
+			ResponseHeaderTimeout: ResponseHeaderTimeout,
+		},
+		Timeout: HTTPRequestTimeout,
+	}
+
+	klog.V(4).Infof("HTTPConditionalGetRequest: %s", req.URL.String())
+	resp, err := httpClient.Do(req)
+	if err != nil {
+		return nil, newValidators, false, err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode == http.StatusNotModified {
+		klog.V(4).Infof("Cached response of %s is still valid", request.URL)
+		return nil, validators, true, nil
+	}
+	if (resp.StatusCode - 300) > 0 {
+		return nil, newValidators, false, HTTPStatusError{URL: request.URL, StatusCode: resp.StatusCode}
+	}
+
+	data, err = ioutil.ReadAll(resp.Body)
+	if err != nil {
+		return nil, newValidators, false, err
+	}
+	newValidators = HTTPCacheValidators{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	return data, newValidators, false, nil
+}
+
 // FilterIgnores applies the glob rules on the filesChanged and filesDeleted and filters them
 // returns the filtered results which match any of the glob rules
 func FilterIgnores(filesChanged, filesDeleted, absIgnoreRules []string) (filesChangedFiltered, filesDeletedFiltered []string) {
@@ -859,28 +926,6 @@ func FilterIgnores(filesChanged, filesDeleted, absIgnoreRules []string) (filesCh
 	return filesChangedFiltered, filesDeletedFiltered
 }
 
-// IsValidProjectDir checks that the folder to download the project from devfile is
-// either empty or contains the devfile used.
-func IsValidProjectDir(path string, devfilePath string) error {
-	files, err := ioutil.ReadDir(path)
-	if err != nil {
-		return err
-	}
-
-	if len(files) >= 1 {
-		for _, file := range files {
-			fileName := file.Name()
-			devfilePath = strings.TrimPrefix(devfilePath, "./")
-			if !file.IsDir() && fileName == devfilePath {
-				return nil
-			}
-		}
-		return errors.Errorf("Folder %s doesn't contain the devfile used.", path)
-	}
-
-	return nil
-}
-
 // Converts Git ssh remote to https
 func ConvertGitSSHRemoteToHTTPS(remote string) string {
 	remote = strings.Replace(remote, ":", "/", 1)
@@ -941,6 +986,60 @@ func GetAndExtractZip(zipURL string, destination string, pathToUnzip string, sta
 	return nil
 }
 
+// Zip archives the content of the source directory into the zip file, under the topDir directory.
+// The files are placed under a top directory, as expected by Unzip
+func Zip(src, dest, topDir string) error {
+	zipFile, err := os.Create(dest)
+	if err != nil {
+		return err
+	}
+	defer zipFile.Close() // #nosec G307
+
+	w := zip.NewWriter(zipFile)
+	err = filepath.Walk(src, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(src, path)
+		if err != nil {
+			return err
+		}
+
+		header, err := zip.FileInfoHeader(info)
+		if err != nil {
+			return err
+		}
+		header.Name = filepath.ToSlash(filepath.Join(topDir, rel))
+		if info.IsDir() {
+			header.Name += "/"
+			_, err = w.CreateHeader(header)
+			return err
+		}
+		if !info.Mode().IsRegular() {
+			// symbolic links and special files are not archived
+			return nil
+		}
+		header.Method = zip.Deflate
+
+		writer, err := w.CreateHeader(header)
+		if err != nil {
+			return err
+		}
+		file, err := os.Open(path)
+		if err != nil {
+			return err
+		}
+		defer file.Close() // #nosec G307
+		_, err = io.Copy(writer, file)
+		return err
+	})
+	if err != nil {
+		_ = w.Close()
+		return err
+	}
+	return w.Close()
+}
+
 // Unzip will decompress a zip archive, moving specified files and folders
 // within the zip file (parameter 1) to an output directory (parameter 2)
 // Source: https://golangcode.com/unzip-files-in-go/
@@ -1458,71 +1557,6 @@ func cleanDir(originalPath string, leaveBehindFiles map[string]bool, fs filesyst
 	return err
 }
 
-// GitSubDir handles subDir for git components using the default filesystem
-func GitSubDir(srcPath, destinationPath, subDir string) error {
-	return gitSubDir(srcPath, destinationPath, subDir, filesystem.DefaultFs{})
-}
-
-// gitSubDir handles subDir for git components
-func gitSubDir(srcPath, destinationPath, subDir string, fs filesystem.Filesystem) error {
-	go StartSignalWatcher(func() {
-		err := cleanDir(destinationPath, map[string]bool{
-			"devfile.yaml": true,
-		}, fs)
-		if err != nil {
-			klog.V(4).Infof("error %v occurred while calling handleInterruptedSubDir", err)
-		}
-		err = fs.RemoveAll(srcPath)
-		if err != nil {
-			klog.V(4).Infof("error %v occurred during temp folder clean up", err)
-		}
-	})
-
-	err := func() error {
-		// Open the directory.
-		outputDirRead, err := fs.Open(filepath.Join(srcPath, subDir))
-		if err != nil {
-			return err
-		}
-		defer func() {
-			if err1 := outputDirRead.Close(); err1 != nil {
-				klog.V(4).Infof("err occurred while closing temp dir: %v", err1)
-
-			}
-		}()
-		// Call Readdir to get all files.
-		outputDirFiles, err := outputDirRead.Readdir(0)
-		if err != nil {
-			return err
-		}
-
-		// Loop over files.
-		for outputIndex := range outputDirFiles {
-			outputFileHere := outputDirFiles[outputIndex]
-
-			// Get name of file.
-			fileName := outputFileHere.Name()
-
-			oldPath := filepath.Join(srcPath, subDir, fileName)
-
-			if outputFileHere.IsDir() {
-				err = copyDirWithFS(oldPath, filepath.Join(destinationPath, fileName), fs)
-			} else {
-				err = copyFileWithFs(oldPath, filepath.Join(destinationPath, fileName), fs)
-			}
-
-			if err != nil {
-				return err
-			}
-		}
-		return nil
-	}()
-	if err != nil {
-		return err
-	}
-	return fs.RemoveAll(srcPath)
-}
-
 // GetCommandStringFromEnvs creates a string from the given environment variables
 func GetCommandStringFromEnvs(envVars []v1alpha2.EnvVar) string {
 	var setEnvVariable string
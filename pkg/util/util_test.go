@@ -1546,85 +1546,44 @@ func TestUnzip(t *testing.T) {
 	}
 }
 
-func TestIsValidProjectDir(t *testing.T) {
-	tests := []struct {
-		name          string
-		devfilePath   string
-		filesToCreate []string
-		dirToCreate   []string
-		expectedError string
-	}{
-		{
-			name:          "Case 1: Empty Folder",
-			devfilePath:   "",
-			filesToCreate: []string{},
-			dirToCreate:   []string{},
-			expectedError: "",
-		},
-		{
-			name:          "Case 2: Folder contains devfile.yaml",
-			devfilePath:   "devfile.yaml",
-			filesToCreate: []string{"devfile.yaml"},
-			dirToCreate:   []string{},
-			expectedError: "",
-		},
-		{
-			name:          "Case 3: Folder contains a file which is not the devfile",
-			devfilePath:   "devfile.yaml",
-			filesToCreate: []string{"file1.yaml"},
-			dirToCreate:   []string{},
-			expectedError: "Folder %s doesn't contain the devfile used.",
-		},
-		{
-			name:          "Case 4: Folder contains a hidden file which is not the devfile",
-			devfilePath:   "devfile.yaml",
-			filesToCreate: []string{".file1.yaml"},
-			dirToCreate:   []string{},
-			expectedError: "Folder %s doesn't contain the devfile used.",
-		},
-		{
-			name:          "Case 5: Folder contains devfile.yaml and more files",
-			devfilePath:   "devfile.yaml",
-			filesToCreate: []string{"devfile.yaml", "file1.yaml", "file2.yaml"},
-			dirToCreate:   []string{},
-			expectedError: "Folder %s is not empty. It can only contain the devfile used.",
-		},
+func TestZip(t *testing.T) {
+	src, err := ioutil.TempDir("", "odo-zip-src")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(src)
+	err = os.MkdirAll(filepath.Join(src, "app"), os.ModePerm)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = ioutil.WriteFile(filepath.Join(src, "app", "server.js"), []byte("console.log('hello')"), 0600)
+	if err != nil {
+		t.Fatal(err)
 	}
 
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			tmpDir, err := ioutil.TempDir("", "valid-project")
-			if err != nil {
-				t.Errorf("Error creating temp dir: %s", err)
-			}
-			defer os.RemoveAll(tmpDir)
-
-			for _, file := range tt.filesToCreate {
-				file := filepath.Join(tmpDir, file)
-				_, err := os.Create(file)
-				if err != nil {
-					t.Errorf("Error creating file %s. Err: %s", file, err)
-				}
-			}
-
-			for _, dir := range tt.dirToCreate {
-				dir := filepath.Join(tmpDir, dir)
-				err := os.Mkdir(dir, os.FileMode(644))
-				if err != nil {
-					t.Errorf("Error creating dir %s. Err: %s", dir, err)
-				}
-			}
-
-			err = IsValidProjectDir(tmpDir, tt.devfilePath)
-			expectedError := tt.expectedError
-			if expectedError != "" {
-				expectedError = fmt.Sprintf(expectedError, tmpDir)
-			}
+	archive := filepath.Join(os.TempDir(), "odo-zip-test.zip")
+	defer os.Remove(archive)
+	err = Zip(src, archive, "starter")
+	if err != nil {
+		t.Fatalf("Zip() unexpected error: %v", err)
+	}
 
-			if err != nil && !reflect.DeepEqual(err.Error(), expectedError) {
-				t.Errorf("Got err: %s, expected err %s", err.Error(), expectedError)
-			}
-		})
+	// the archive can be extracted by Unzip, which removes the top directory
+	dest, err := ioutil.TempDir("", "odo-zip-dest")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dest)
+	_, err = Unzip(archive, dest, "")
+	if err != nil {
+		t.Fatalf("Unzip() unexpected error: %v", err)
+	}
+	content, err := ioutil.ReadFile(filepath.Join(dest, "app", "server.js"))
+	if err != nil {
+		t.Fatalf("the file has not been extracted: %v", err)
+	}
+	if string(content) != "console.log('hello')" {
+		t.Errorf("unexpected content of the extracted file: %q", content)
 	}
 }
 
@@ -2396,169 +2355,6 @@ func TestCleanDir(t *testing.T) {
 	}
 }
 
-func TestGitSubDir(t *testing.T) {
-
-	fs := filesystem.NewFakeFs()
-
-	sourceName := filepath.Join(os.TempDir(), "source")
-	destinationName := filepath.Join(os.TempDir(), "destination")
-
-	filePaths := []FileProperties{
-		{
-			FilePath: sourceName,
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main"),
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "blah.js"),
-			FileType: RegularFile,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "some-other-blah.js"),
-			FileType: RegularFile,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "test"),
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "test", "test.java"),
-			FileType: RegularFile,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "src", "java"),
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "src", "java", "main.java"),
-			FileType: RegularFile,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "src", "resources"),
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "src", "resources", "layout"),
-			FileType: Directory,
-		},
-		{
-			FilePath: filepath.Join(sourceName, "main", "src", "resources", "index.html"),
-			FileType: RegularFile,
-		},
-	}
-
-	type args struct {
-		destinationPath string
-		srcPath         string
-		subDir          string
-		fs              filesystem.Filesystem
-	}
-	tests := []struct {
-		name    string
-		args    args
-		wantErr bool
-	}{
-		{
-			name: "case 1: normal sub dir exist",
-			args: args{
-				srcPath:         sourceName,
-				destinationPath: destinationName,
-				subDir:          filepath.Join("main", "src"),
-				fs:              fs,
-			},
-		},
-		{
-			name: "case 2: sub dir doesn't exist",
-			args: args{
-				srcPath:         sourceName,
-				destinationPath: destinationName,
-				subDir:          filepath.Join("main", "blah"),
-				fs:              fs,
-			},
-			wantErr: true,
-		},
-		{
-			name: "case 3: src doesn't exist",
-			args: args{
-				srcPath:         sourceName + "blah",
-				destinationPath: destinationName,
-				subDir:          filepath.Join("main", "src"),
-				fs:              fs,
-			},
-			wantErr: true,
-		},
-	}
-	for _, tt := range tests {
-		t.Run(tt.name, func(t *testing.T) {
-			_, _, err := setupFileTest(fs, sourceName, filePaths)
-			if err != nil {
-				t.Errorf("error while setting up test: %v", err)
-			}
-
-			err = gitSubDir(tt.args.srcPath, tt.args.destinationPath, tt.args.subDir, tt.args.fs)
-			if (err != nil) != tt.wantErr {
-				t.Errorf("GitSubDir() error = %v, wantErr %v", err, tt.wantErr)
-			}
-
-			if tt.wantErr && err != nil {
-				return
-			}
-
-			pathsToValidate := map[string]bool{
-				filepath.Join(tt.args.destinationPath, "java"):                    true,
-				filepath.Join(tt.args.destinationPath, "java", "main.java"):       true,
-				filepath.Join(tt.args.destinationPath, "resources"):               true,
-				filepath.Join(tt.args.destinationPath, "resources", "layout"):     true,
-				filepath.Join(tt.args.destinationPath, "resources", "index.html"): true,
-			}
-
-			pathsNotToBePresent := map[string]bool{
-				filepath.Join(tt.args.destinationPath, "src"):  true,
-				filepath.Join(tt.args.destinationPath, "main"): true,
-			}
-
-			found := 0
-			notToBeFound := 0
-			err = fs.Walk(tt.args.destinationPath, func(path string, info os.FileInfo, err error) error {
-				if err != nil {
-					return err
-				}
-
-				if ok := pathsToValidate[path]; ok {
-					found++
-				}
-
-				if ok := pathsNotToBePresent[path]; ok {
-					notToBeFound++
-				}
-				return nil
-			})
-			if err != nil {
-				t.Errorf("unexpected error: %v", err)
-			}
-
-			if found != 5 {
-				t.Errorf("all files were not copied")
-			}
-
-			if notToBeFound != 0 {
-				t.Errorf("extra files were created")
-			}
-
-			_, err = os.Stat(tt.args.srcPath)
-			if !os.IsNotExist(err) {
-				t.Errorf("src path was not deleted")
-			}
-
-			_ = fs.RemoveAll(tt.args.srcPath)
-			_ = fs.RemoveAll(tt.args.destinationPath)
-		})
-	}
-}
-
 func TestGetCommandStringFromEnvs(t *testing.T) {
 	type args struct {
 		envVars []v1alpha2.EnvVar
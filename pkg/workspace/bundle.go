@@ -0,0 +1,564 @@
+package workspace
+
+import (
+	"bytes"
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/devfile"
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/openshift/odo/pkg/log"
+	"github.com/openshift/odo/pkg/util"
+	"github.com/pkg/errors"
+	yamlv3 "gopkg.in/yaml.v3"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/klog"
+)
+
+const (
+	bundleKind     = "Bundle"
+	bundleFileName = "odo-bundle.yaml"
+	// bundleTopDir is the top directory of the files of the bundle archive
+	bundleTopDir = "odo-bundle"
+	// componentsDir is the directory of the bundle containing the contexts of the components
+	componentsDir = "components"
+	// dataDir is the directory of the bundle containing the data of the storage of the components
+	dataDir = "data"
+	// fileIndexPath is the file index of the context, which must not be exported to get a full push after the import
+	fileIndexPath = ".odo/odo-file-index.json"
+)
+
+// Bundle describes the components of an application exported in a bundle archive
+type Bundle struct {
+	metav1.TypeMeta `json:",inline"`
+	Components      []BundleComponent `json:"components"`
+}
+
+// BundleComponent is a component exported in a bundle
+type BundleComponent struct {
+	Component
+	// Project and Application are the project and the application of the component when it was exported
+	Project     string `json:"project,omitempty"`
+	Application string `json:"application,omitempty"`
+	// Storages are the storages whose data is in the bundle
+	Storages []string `json:"storages,omitempty"`
+}
+
+// ExportOptions are the options to export the components of a workspace
+type ExportOptions struct {
+	// Source exports the source files of the contexts, except the files ignored by their .odoignore or .gitignore file.
+	// Otherwise, only the devfiles and the env files are exported
+	Source bool
+	// ExportData copies the data of the storage of the component to the directory, the data is not exported when nil
+	ExportData func(c Component, contextDir, storage, dir string) error
+}
+
+// Export exports the contexts of the components of the workspace to the bundle archive dest
+func (w Workspace) Export(dest string, options ExportOptions) (*Bundle, error) {
+	staging, err := ioutil.TempDir("", "odo-bundle")
+	if err != nil {
+		return nil, err
+	}
+	defer os.RemoveAll(staging) // #nosec G307
+
+	ordered, err := w.Order()
+	if err != nil {
+		return nil, err
+	}
+	bundle := Bundle{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       bundleKind,
+			APIVersion: workspaceAPIVersion,
+		},
+	}
+	for _, c := range ordered {
+		contextDir := w.GetContext(c)
+		envInfo, err := envinfo.NewEnvSpecificInfoForEnvironment(contextDir, c.Env)
+		if err != nil {
+			return nil, errors.Wrapf(err, "unable to read the env file of the component %s", c.Name)
+		}
+		if !util.CheckPathExists(envInfo.GetDevfilePath()) {
+			return nil, fmt.Errorf("the context %s of the component %s has no devfile", contextDir, c.Name)
+		}
+
+		klog.V(3).Infof("Exporting the context %s of the component %s", contextDir, c.Name)
+		exportedContext := filepath.Join(staging, componentsDir, c.Name)
+		if err := exportContext(contextDir, exportedContext, options.Source); err != nil {
+			return nil, errors.Wrapf(err, "unable to export the context of the component %s", c.Name)
+		}
+		if err := exportURLFiles(contextDir, exportedContext, c.Env, envInfo.GetComponentSettings()); err != nil {
+			return nil, errors.Wrapf(err, "unable to export the URL files of the component %s", c.Name)
+		}
+
+		exported := BundleComponent{
+			Component:   c,
+			Project:     envInfo.GetComponentSettings().Project,
+			Application: envInfo.GetComponentSettings().AppName,
+		}
+		// the component is in its own directory of the bundle
+		exported.Context = c.Name
+
+		if options.ExportData != nil {
+			storages, err := listStorageNames(envInfo)
+			if err != nil {
+				return nil, errors.Wrapf(err, "unable to list the storage of the component %s", c.Name)
+			}
+			for _, storage := range storages {
+				dir := filepath.Join(staging, dataDir, c.Name, storage)
+				if err := os.MkdirAll(dir, os.ModePerm); err != nil {
+					return nil, err
+				}
+				if err := options.ExportData(c, contextDir, storage, dir); err != nil {
+					return nil, errors.Wrapf(err, "unable to export the data of the storage %s of the component %s", storage, c.Name)
+				}
+				exported.Storages = append(exported.Storages, storage)
+			}
+		}
+		bundle.Components = append(bundle.Components, exported)
+	}
+
+	data, err := yaml.Marshal(bundle)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(staging, bundleFileName), data, 0600); err != nil {
+		return nil, err
+	}
+	if err := util.Zip(staging, dest, bundleTopDir); err != nil {
+		return nil, errors.Wrapf(err, "unable to create the bundle %s", dest)
+	}
+	return &bundle, nil
+}
+
+// exportContext copies the files of the context to the directory of the component in the bundle
+func exportContext(contextDir, dest string, source bool) error {
+	ignoreRules, err := util.GetIgnoreRulesFromDirectory(contextDir)
+	if err != nil {
+		return err
+	}
+	absIgnoreRules := util.GetAbsGlobExps(contextDir, ignoreRules)
+
+	return filepath.Walk(contextDir, func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			return err
+		}
+		rel, err := filepath.Rel(contextDir, path)
+		if err != nil {
+			return err
+		}
+		rel = filepath.ToSlash(rel)
+		if rel == fileIndexPath || rel == ".git" {
+			return skip(info)
+		}
+		// the devfile and the env files are always exported
+		odoFile := rel == "." || rel == "devfile.yaml" || rel == ".odo" || strings.HasPrefix(rel, ".odo/")
+		if !odoFile {
+			if !source {
+				return skip(info)
+			}
+			if match, err := util.IsGlobExpMatch(path, absIgnoreRules); err != nil || match {
+				return skip(info)
+			}
+		}
+
+		target := filepath.Join(dest, filepath.FromSlash(rel))
+		if info.IsDir() {
+			return os.MkdirAll(target, info.Mode()|0700)
+		}
+		if !info.Mode().IsRegular() {
+			// symbolic links and special files are not exported
+			return nil
+		}
+		return util.CopyFile(path, target, info)
+	})
+}
+
+// skip returns the error skipping the file or directory while walking the context
+func skip(info os.FileInfo) error {
+	if info.IsDir() {
+		return filepath.SkipDir
+	}
+	return nil
+}
+
+// exportURLFiles exports the certificate and key files of the URLs of the component, even without the source files,
+// and stores their paths relative to the context in the exported env file.
+// The files outside of the context are exported to the directory .odo/tls/<url name> of the exported context
+func exportURLFiles(contextDir, dest, env string, settings envinfo.ComponentSettings) error {
+	if settings.URL == nil {
+		return nil
+	}
+	urls := append([]localConfigProvider.LocalURL{}, *settings.URL...)
+	changed := false
+	for i := range urls {
+		for _, file := range []*string{&urls[i].TLSCertFile, &urls[i].TLSKeyFile} {
+			if *file == "" {
+				continue
+			}
+			path := filepath.FromSlash(*file)
+			if !filepath.IsAbs(path) {
+				path = filepath.Join(contextDir, path)
+			}
+			rel, err := filepath.Rel(contextDir, path)
+			if err != nil || !isRelativeWithin(rel) {
+				rel = filepath.Join(".odo", "tls", urls[i].Name, filepath.Base(path))
+			}
+			info, err := os.Stat(path)
+			if err != nil {
+				return errors.Wrapf(err, "unable to read the file %s of the URL %s", *file, urls[i].Name)
+			}
+			target := filepath.Join(dest, rel)
+			if err := os.MkdirAll(filepath.Dir(target), os.ModePerm); err != nil {
+				return err
+			}
+			if err := util.CopyFile(path, target, info); err != nil {
+				return err
+			}
+			if filepath.ToSlash(rel) != *file {
+				*file = filepath.ToSlash(rel)
+				changed = true
+			}
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	exported, err := envinfo.NewEnvSpecificInfoForEnvironment(dest, env)
+	if err != nil {
+		return err
+	}
+	settings.URL = &urls
+	return exported.SetComponentSettings(settings)
+}
+
+// isRelativeWithin returns true if the relative path is a path inside of the directory it is relative to
+func isRelativeWithin(rel string) bool {
+	return rel != "." && rel != ".." && !strings.HasPrefix(rel, ".."+string(filepath.Separator)) && !filepath.IsAbs(rel)
+}
+
+// joinWithin joins the path of the bundle to the base directory, returning an error if the result is outside of it
+func joinWithin(base, path string) (string, error) {
+	joined := filepath.Join(base, filepath.FromSlash(path))
+	if rel, err := filepath.Rel(base, joined); err != nil || !isRelativeWithin(rel) {
+		return "", fmt.Errorf("the path %q is outside of its directory", path)
+	}
+	return joined, nil
+}
+
+// listStorageNames returns the names of the storage of the devfile of the component
+func listStorageNames(envInfo *envinfo.EnvSpecificInfo) ([]string, error) {
+	devObj, err := devfile.ParseFromFileWithVariables(envInfo.GetDevfilePath(), envInfo.GetComponentSettings().Variable)
+	if err != nil {
+		return nil, err
+	}
+	envInfo.SetDevfileObj(devObj)
+	storages, err := envInfo.ListStorage()
+	if err != nil {
+		return nil, err
+	}
+	var names []string
+	found := map[string]bool{}
+	for _, storage := range storages {
+		// a storage mounted in several containers is listed once per container
+		if !found[storage.Name] {
+			found[storage.Name] = true
+			names = append(names, storage.Name)
+		}
+	}
+	return names, nil
+}
+
+// ImportOptions are the options to import the components of a bundle
+type ImportOptions struct {
+	// Project is the project the components are imported into, the projects of the bundle are kept when empty.
+	// The namespaces of the bundle are replaced by the project in the Kubernetes manifests of the devfiles
+	Project string
+	// Application is the application of the imported components, the applications of the bundle are kept when empty
+	Application string
+	// Host is the cluster host of the URLs of the imported components, the hosts of the bundle are kept when empty
+	Host string
+}
+
+// ExtractedBundle is a bundle archive extracted to a temporary directory
+type ExtractedBundle struct {
+	Bundle
+	dir string
+}
+
+// ExtractBundle extracts the bundle archive, the extracted bundle must be closed to delete the extracted files
+func ExtractBundle(src string) (*ExtractedBundle, error) {
+	dir, err := ioutil.TempDir("", "odo-bundle")
+	if err != nil {
+		return nil, err
+	}
+	extracted := &ExtractedBundle{dir: dir}
+	if _, err := util.Unzip(src, dir, ""); err != nil {
+		_ = extracted.Close()
+		return nil, errors.Wrapf(err, "unable to extract the bundle %s", src)
+	}
+
+	data, err := ioutil.ReadFile(filepath.Join(dir, bundleFileName))
+	if err != nil {
+		_ = extracted.Close()
+		return nil, errors.Wrapf(err, "the file %s is not a bundle exported by odo", src)
+	}
+	if err := yaml.Unmarshal(data, &extracted.Bundle); err != nil {
+		_ = extracted.Close()
+		return nil, errors.Wrapf(err, "unable to parse the description of the bundle %s", src)
+	}
+	if extracted.Kind != bundleKind || len(extracted.Components) == 0 {
+		_ = extracted.Close()
+		return nil, fmt.Errorf("the file %s is not a bundle exported by odo", src)
+	}
+	if err := extracted.validate(); err != nil {
+		_ = extracted.Close()
+		return nil, errors.Wrapf(err, "invalid bundle %s", src)
+	}
+	return extracted, nil
+}
+
+// validate returns an error if a name, a context or a storage of a component of the bundle is invalid,
+// the paths of the imported files being built from them
+func (b *ExtractedBundle) validate() error {
+	names := map[string]bool{}
+	for _, c := range b.Components {
+		if errs := validation.IsDNS1123Label(c.Name); len(errs) > 0 {
+			return fmt.Errorf("invalid component name %q: %s", c.Name, strings.Join(errs, " "))
+		}
+		if names[c.Name] {
+			return fmt.Errorf("the component %s is listed more than once", c.Name)
+		}
+		names[c.Name] = true
+		if _, err := joinWithin(filepath.Join(b.dir, componentsDir), c.Context); err != nil {
+			return errors.Wrapf(err, "invalid context of the component %s", c.Name)
+		}
+		for _, storage := range c.Storages {
+			if errs := validation.IsDNS1123Label(storage); len(errs) > 0 {
+				return fmt.Errorf("invalid storage name %q of the component %s: %s", storage, c.Name, strings.Join(errs, " "))
+			}
+		}
+	}
+	return nil
+}
+
+// Close deletes the extracted files of the bundle
+func (b *ExtractedBundle) Close() error {
+	return os.RemoveAll(b.dir)
+}
+
+// GetDataDir returns the directory of the data of the storage of the component,
+// the names of the components and of their storage being validated when the bundle is extracted
+func (b *ExtractedBundle) GetDataDir(componentName, storage string) string {
+	return filepath.Join(b.dir, dataDir, componentName, storage)
+}
+
+// Import creates the contexts of the components of the bundle in the directory, with a workspace file
+// listing them, and returns the workspace
+func (b *ExtractedBundle) Import(dir string, options ImportOptions) (*Workspace, error) {
+	projects := map[string]bool{}
+	for _, c := range b.Components {
+		if c.Project != "" {
+			projects[c.Project] = true
+		}
+	}
+
+	w := Workspace{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       workspaceKind,
+			APIVersion: workspaceAPIVersion,
+		},
+	}
+	for _, c := range b.Components {
+		contextDir := filepath.Join(dir, c.Name)
+		if files, err := ioutil.ReadDir(contextDir); err == nil && len(files) > 0 {
+			return nil, fmt.Errorf("the context %s of the component %s already exists and is not empty", contextDir, c.Name)
+		}
+		exportedContext, err := joinWithin(filepath.Join(b.dir, componentsDir), c.Context)
+		if err != nil {
+			return nil, errors.Wrapf(err, "invalid context of the component %s", c.Name)
+		}
+		if err := util.CopyDirWithFS(exportedContext, contextDir); err != nil {
+			return nil, errors.Wrapf(err, "unable to create the context of the component %s", c.Name)
+		}
+		if err := remapContext(contextDir, c.Env, projects, options); err != nil {
+			return nil, errors.Wrapf(err, "unable to update the context of the component %s", c.Name)
+		}
+
+		imported := c.Component
+		imported.Context = c.Name
+		w.Components = append(w.Components, imported)
+	}
+
+	data, err := yaml.Marshal(w)
+	if err != nil {
+		return nil, err
+	}
+	if err := ioutil.WriteFile(filepath.Join(dir, FileName), data, 0600); err != nil {
+		return nil, err
+	}
+	return Read(dir)
+}
+
+// remapContext replaces the project, the application and the URL hosts of the environment of the component,
+// and the namespaces of the Kubernetes manifests of its devfile
+func remapContext(contextDir, env string, projects map[string]bool, options ImportOptions) error {
+	envInfo, err := envinfo.NewEnvSpecificInfoForEnvironment(contextDir, env)
+	if err != nil {
+		return err
+	}
+	settings := envInfo.GetComponentSettings()
+	if options.Project != "" {
+		settings.Project = options.Project
+	}
+	if options.Application != "" {
+		settings.AppName = options.Application
+	}
+	if settings.URL != nil {
+		urls := *settings.URL
+		for i := range urls {
+			if options.Host != "" && urls[i].Host != "" {
+				urls[i].Host = options.Host
+			}
+			if options.Project != "" && projects[urls[i].TLSSecretNamespace] {
+				urls[i].TLSSecretNamespace = options.Project
+			}
+			// the files of the URLs are exported relative to the context, and resolved relative to the new context
+			for _, file := range []string{urls[i].TLSCertFile, urls[i].TLSKeyFile} {
+				path := filepath.FromSlash(file)
+				if file != "" && !filepath.IsAbs(path) {
+					path = filepath.Join(contextDir, path)
+				}
+				if file != "" && !util.CheckPathExists(path) {
+					log.Warningf("The file %s of the URL %s doesn't exist in the context %s", file, urls[i].Name, contextDir)
+				}
+			}
+		}
+	}
+	if err := envInfo.SetComponentSettings(settings); err != nil {
+		return err
+	}
+
+	if options.Project == "" {
+		return nil
+	}
+	return remapDevfileNamespaces(envInfo.GetDevfilePath(), projects, options.Project)
+}
+
+// remapDevfileNamespaces replaces the namespaces of the inlined Kubernetes manifests of the devfile, like the
+// services of the ServiceBindings, keeping the rest of the devfile as it is
+func remapDevfileNamespaces(devfilePath string, namespaces map[string]bool, namespace string) error {
+	data, err := ioutil.ReadFile(devfilePath)
+	if err != nil {
+		return err
+	}
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal(data, &root); err != nil {
+		return errors.Wrapf(err, "unable to parse the devfile %s", devfilePath)
+	}
+
+	changed := false
+	components := mappingValue(documentRoot(&root), "components")
+	if components == nil || components.Kind != yamlv3.SequenceNode {
+		return nil
+	}
+	for _, component := range components.Content {
+		inlined := mappingValue(mappingValue(component, "kubernetes"), "inlined")
+		if inlined == nil || inlined.Kind != yamlv3.ScalarNode {
+			continue
+		}
+		manifest, manifestChanged, err := remapManifestNamespaces(inlined.Value, namespaces, namespace)
+		if err != nil {
+			return err
+		}
+		if manifestChanged {
+			inlined.Value = manifest
+			changed = true
+		}
+	}
+	if !changed {
+		return nil
+	}
+
+	data, err = marshalYAMLNode(&root)
+	if err != nil {
+		return err
+	}
+	return ioutil.WriteFile(devfilePath, data, 0600)
+}
+
+// remapManifestNamespaces replaces the values of the namespace fields of the manifest which are one of the namespaces
+func remapManifestNamespaces(manifest string, namespaces map[string]bool, namespace string) (string, bool, error) {
+	var root yamlv3.Node
+	if err := yamlv3.Unmarshal([]byte(manifest), &root); err != nil {
+		return manifest, false, errors.Wrap(err, "unable to parse the Kubernetes manifest")
+	}
+
+	changed := false
+	var walk func(node *yamlv3.Node)
+	walk = func(node *yamlv3.Node) {
+		if node.Kind == yamlv3.MappingNode {
+			for i := 0; i+1 < len(node.Content); i += 2 {
+				key, value := node.Content[i], node.Content[i+1]
+				if key.Value == "namespace" && value.Kind == yamlv3.ScalarNode && namespaces[value.Value] {
+					value.Value = namespace
+					changed = true
+				}
+			}
+		}
+		for _, child := range node.Content {
+			walk(child)
+		}
+	}
+	walk(&root)
+	if !changed {
+		return manifest, false, nil
+	}
+
+	data, err := marshalYAMLNode(&root)
+	if err != nil {
+		return manifest, false, err
+	}
+	return string(data), true, nil
+}
+
+// marshalYAMLNode marshals the YAML node with the indentation of the devfiles written by odo
+func marshalYAMLNode(node *yamlv3.Node) ([]byte, error) {
+	var buffer bytes.Buffer
+	encoder := yamlv3.NewEncoder(&buffer)
+	encoder.SetIndent(2)
+	if err := encoder.Encode(node); err != nil {
+		return nil, err
+	}
+	if err := encoder.Close(); err != nil {
+		return nil, err
+	}
+	return buffer.Bytes(), nil
+}
+
+// documentRoot returns the root node of the YAML document
+func documentRoot(node *yamlv3.Node) *yamlv3.Node {
+	if node.Kind == yamlv3.DocumentNode && len(node.Content) > 0 {
+		return node.Content[0]
+	}
+	return node
+}
+
+// mappingValue returns the value of the key of the YAML mapping, or nil if the node is not a mapping or has no such key
+func mappingValue(node *yamlv3.Node, key string) *yamlv3.Node {
+	if node == nil || node.Kind != yamlv3.MappingNode {
+		return nil
+	}
+	for i := 0; i+1 < len(node.Content); i += 2 {
+		if node.Content[i].Value == key {
+			return node.Content[i+1]
+		}
+	}
+	return nil
+}
@@ -0,0 +1,320 @@
+package workspace
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/openshift/odo/pkg/localConfigProvider"
+	"github.com/openshift/odo/pkg/util"
+)
+
+const bundleTestDevfile = `schemaVersion: 2.0.0
+metadata:
+  name: nodejs
+components:
+- name: runtime
+  container:
+    image: registry.access.redhat.com/ubi8/nodejs-12:1-36
+    memoryLimit: 1024Mi
+- name: mydb-binding
+  kubernetes:
+    inlined: |
+      apiVersion: binding.operators.coreos.com/v1alpha1
+      kind: ServiceBinding
+      metadata:
+        name: mydb-binding
+        namespace: myproject
+      spec:
+        services:
+        - group: postgresql.baiju.dev
+          kind: Database
+          name: mydb
+          namespace: myproject
+        - group: redis.example.com
+          kind: Redis
+          name: cache
+          namespace: shared
+`
+
+func TestExportImport(t *testing.T) {
+	sourceDir, err := ioutil.TempDir("", "odo-workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	// the context of the component, with a file ignored by the .gitignore file and a file index
+	contextDir := filepath.Join(sourceDir, "nodejs")
+	files := map[string]string{
+		"devfile.yaml":              bundleTestDevfile,
+		"server.js":                 "console.log('hello')",
+		".gitignore":                "node_modules\n",
+		"node_modules/dep/index.js": "module.exports = {}",
+		fileIndexPath:               "{}",
+	}
+	for name, content := range files {
+		path := filepath.Join(contextDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	envInfo, err := envinfo.NewEnvSpecificInfo(contextDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = envInfo.SetComponentSettings(envinfo.ComponentSettings{
+		Name:    "nodejs",
+		Project: "myproject",
+		AppName: "app",
+		URL: &[]localConfigProvider.LocalURL{
+			{Name: "http-3000", Host: "192.168.1.10.nip.io", Kind: localConfigProvider.INGRESS, TLSSecret: "mysecret", TLSSecretNamespace: "myproject"},
+			{Name: "route", Kind: localConfigProvider.ROUTE},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(sourceDir, FileName), []byte("components:\n- context: nodejs\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := Read(sourceDir)
+	if err != nil {
+		t.Fatalf("Read() unexpected error %v", err)
+	}
+	bundlePath := filepath.Join(sourceDir, "myapp.zip")
+	bundle, err := w.Export(bundlePath, ExportOptions{Source: true})
+	if err != nil {
+		t.Fatalf("Export() unexpected error %v", err)
+	}
+	wantComponents := []BundleComponent{{Component: Component{Name: "nodejs", Context: "nodejs"}, Project: "myproject", Application: "app"}}
+	if !reflect.DeepEqual(bundle.Components, wantComponents) {
+		t.Errorf("Export() components = %#v, want %#v", bundle.Components, wantComponents)
+	}
+
+	extracted, err := ExtractBundle(bundlePath)
+	if err != nil {
+		t.Fatalf("ExtractBundle() unexpected error %v", err)
+	}
+	defer extracted.Close()
+	if !reflect.DeepEqual(extracted.Components, wantComponents) {
+		t.Errorf("ExtractBundle() components = %#v, want %#v", extracted.Components, wantComponents)
+	}
+
+	targetDir, err := ioutil.TempDir("", "odo-workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(targetDir)
+	imported, err := extracted.Import(targetDir, ImportOptions{Project: "newproject", Application: "newapp", Host: "apps.example.com"})
+	if err != nil {
+		t.Fatalf("Import() unexpected error %v", err)
+	}
+	importedContext := imported.GetContext(imported.Components[0])
+	if importedContext != filepath.Join(targetDir, "nodejs") {
+		t.Errorf("Import() context = %s, want %s", importedContext, filepath.Join(targetDir, "nodejs"))
+	}
+
+	for name, exported := range map[string]bool{
+		"devfile.yaml":              true,
+		"server.js":                 true,
+		".odo/env/env.yaml":         true,
+		"node_modules/dep/index.js": false,
+		fileIndexPath:               false,
+	} {
+		if _, err := os.Stat(filepath.Join(importedContext, filepath.FromSlash(name))); (err == nil) != exported {
+			t.Errorf("Import() the file %s exists = %v, want %v", name, err == nil, exported)
+		}
+	}
+
+	importedEnv, err := envinfo.NewEnvSpecificInfo(importedContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	settings := importedEnv.GetComponentSettings()
+	if settings.Project != "newproject" || settings.AppName != "newapp" {
+		t.Errorf("Import() project = %s, application = %s, want newproject and newapp", settings.Project, settings.AppName)
+	}
+	wantURLs := []localConfigProvider.LocalURL{
+		{Name: "http-3000", Host: "apps.example.com", Kind: localConfigProvider.INGRESS, TLSSecret: "mysecret", TLSSecretNamespace: "newproject"},
+		{Name: "route", Kind: localConfigProvider.ROUTE},
+	}
+	if settings.URL == nil || !reflect.DeepEqual(*settings.URL, wantURLs) {
+		t.Errorf("Import() URLs = %v, want %v", settings.URL, wantURLs)
+	}
+
+	devfile, err := ioutil.ReadFile(filepath.Join(importedContext, "devfile.yaml"))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if strings.Contains(string(devfile), "namespace: myproject") || strings.Count(string(devfile), "namespace: newproject") != 2 {
+		t.Errorf("Import() devfile namespaces not replaced:\n%s", devfile)
+	}
+	if !strings.Contains(string(devfile), "namespace: shared") {
+		t.Errorf("Import() devfile replaced a namespace of another project:\n%s", devfile)
+	}
+
+	if _, err := extracted.Import(targetDir, ImportOptions{}); err == nil {
+		t.Errorf("Import() expected an error when the contexts already exist")
+	}
+}
+
+func TestExportURLFiles(t *testing.T) {
+	sourceDir, err := ioutil.TempDir("", "odo-workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(sourceDir)
+
+	// the certificate is in the context of the component and the key outside of it
+	contextDir := filepath.Join(sourceDir, "nodejs")
+	files := map[string]string{
+		"nodejs/devfile.yaml":  bundleTestDevfile,
+		"nodejs/certs/tls.crt": "certificate",
+		"secrets/nodejs.key":   "key",
+	}
+	for name, content := range files {
+		path := filepath.Join(sourceDir, filepath.FromSlash(name))
+		if err := os.MkdirAll(filepath.Dir(path), os.ModePerm); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+	}
+	envInfo, err := envinfo.NewEnvSpecificInfo(contextDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	err = envInfo.SetComponentSettings(envinfo.ComponentSettings{
+		Name: "nodejs",
+		URL: &[]localConfigProvider.LocalURL{
+			{Name: "https", Host: "example.com", Kind: localConfigProvider.INGRESS, Secure: true, TLSCertFile: "certs/tls.crt", TLSKeyFile: filepath.Join(sourceDir, "secrets", "nodejs.key")},
+		},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := ioutil.WriteFile(filepath.Join(sourceDir, FileName), []byte("components:\n- context: nodejs\n"), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	w, err := Read(sourceDir)
+	if err != nil {
+		t.Fatalf("Read() unexpected error %v", err)
+	}
+	bundlePath := filepath.Join(sourceDir, "myapp.zip")
+	if _, err := w.Export(bundlePath, ExportOptions{Source: false}); err != nil {
+		t.Fatalf("Export() unexpected error %v", err)
+	}
+	extracted, err := ExtractBundle(bundlePath)
+	if err != nil {
+		t.Fatalf("ExtractBundle() unexpected error %v", err)
+	}
+	defer extracted.Close()
+	targetDir, err := ioutil.TempDir("", "odo-workspace")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(targetDir)
+	imported, err := extracted.Import(targetDir, ImportOptions{})
+	if err != nil {
+		t.Fatalf("Import() unexpected error %v", err)
+	}
+	importedContext := imported.GetContext(imported.Components[0])
+
+	importedEnv, err := envinfo.NewEnvSpecificInfo(importedContext)
+	if err != nil {
+		t.Fatal(err)
+	}
+	url := (*importedEnv.GetComponentSettings().URL)[0]
+	for file, content := range map[string]string{
+		"certs/tls.crt":             "certificate",
+		".odo/tls/https/nodejs.key": "key",
+	} {
+		if url.TLSCertFile != file && url.TLSKeyFile != file {
+			t.Errorf("Import() URL files = %s and %s, want %s", url.TLSCertFile, url.TLSKeyFile, file)
+		}
+		data, err := ioutil.ReadFile(filepath.Join(importedContext, filepath.FromSlash(file)))
+		if err != nil || string(data) != content {
+			t.Errorf("Import() the file %s contains %q (error %v), want %q", file, data, err, content)
+		}
+	}
+	// the env file of the exported context is not modified
+	envInfo, err = envinfo.NewEnvSpecificInfo(contextDir)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if key := (*envInfo.GetComponentSettings().URL)[0].TLSKeyFile; key != filepath.Join(sourceDir, "secrets", "nodejs.key") {
+		t.Errorf("Export() modified the key file of the context to %s", key)
+	}
+}
+
+func TestExtractBundleValidation(t *testing.T) {
+	tests := []struct {
+		name    string
+		bundle  string
+		wantErr bool
+	}{
+		{
+			name:   "valid bundle",
+			bundle: "kind: Bundle\ncomponents:\n- name: nodejs\n  context: nodejs\n  storages:\n  - mydb\n",
+		},
+		{
+			name:    "component name escaping the import directory",
+			bundle:  "kind: Bundle\ncomponents:\n- name: ../nodejs\n  context: nodejs\n",
+			wantErr: true,
+		},
+		{
+			name:    "context escaping the bundle",
+			bundle:  "kind: Bundle\ncomponents:\n- name: nodejs\n  context: ../../home\n",
+			wantErr: true,
+		},
+		{
+			name:    "context of the whole bundle",
+			bundle:  "kind: Bundle\ncomponents:\n- name: nodejs\n  context: .\n",
+			wantErr: true,
+		},
+		{
+			name:    "storage name escaping the data directory",
+			bundle:  "kind: Bundle\ncomponents:\n- name: nodejs\n  context: nodejs\n  storages:\n  - ../../mydb\n",
+			wantErr: true,
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "odo-bundle")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			staging := filepath.Join(dir, "staging")
+			if err := os.MkdirAll(filepath.Join(staging, componentsDir, "nodejs"), os.ModePerm); err != nil {
+				t.Fatal(err)
+			}
+			if err := ioutil.WriteFile(filepath.Join(staging, bundleFileName), []byte(tt.bundle), 0600); err != nil {
+				t.Fatal(err)
+			}
+			bundlePath := filepath.Join(dir, "bundle.zip")
+			if err := util.Zip(staging, bundlePath, bundleTopDir); err != nil {
+				t.Fatal(err)
+			}
+
+			extracted, err := ExtractBundle(bundlePath)
+			if (err != nil) != tt.wantErr {
+				t.Errorf("ExtractBundle() error = %v, wantErr %v", err, tt.wantErr)
+			}
+			if extracted != nil {
+				extracted.Close()
+			}
+		})
+	}
+}
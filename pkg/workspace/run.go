@@ -0,0 +1,183 @@
+package workspace
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/openshift/odo/pkg/util"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// Status is the status of a task run on a component of the workspace
+type Status string
+
+const (
+	// StatusSucceeded is the status of a task which succeeded
+	StatusSucceeded Status = "Succeeded"
+	// StatusFailed is the status of a task which failed
+	StatusFailed Status = "Failed"
+	// StatusSkipped is the status of a task not run because a dependency of the component failed
+	StatusSkipped Status = "Skipped"
+	// StatusCancelled is the status of a task cancelled before its end, or before it could start
+	StatusCancelled Status = "Cancelled"
+)
+
+// Result is the result of a task run on a component of the workspace
+type Result struct {
+	Name     string          `json:"name"`
+	Context  string          `json:"context"`
+	Status   Status          `json:"status"`
+	Duration metav1.Duration `json:"duration"`
+	Output   string          `json:"output,omitempty"`
+	Error    string          `json:"error,omitempty"`
+}
+
+// Task runs an operation on a component of the workspace, with the absolute path of its context,
+// and returns the output of the operation. The task must stop when the context is cancelled
+type Task func(ctx context.Context, c Component, contextDir string) (string, error)
+
+// RunOptions are the options to run a task on the components of the workspace
+type RunOptions struct {
+	// Concurrency is the maximum number of tasks running at the same time, 0 for no limit
+	Concurrency int
+	// FailFast cancels all the tasks as soon as a task fails.
+	// Otherwise, only the tasks of the components depending on the failed component are skipped
+	FailFast bool
+	// IgnoreDependencies runs the tasks without waiting for the dependencies, for the tasks which never end like watch
+	IgnoreDependencies bool
+	// OnDone is called with the result of each task when it ends, one call at a time
+	OnDone func(Result)
+}
+
+// Run runs the task on all the components of the workspace concurrently, starting the task of a component
+// once the tasks of its dependencies succeeded. It returns the results in the order of the dependencies
+func (w Workspace) Run(ctx context.Context, task Task, options RunOptions) []Result {
+	ordered, err := w.Order()
+	if err != nil {
+		// the dependencies have been validated when reading the workspace
+		ordered = w.Components
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	concurrency := options.Concurrency
+	if concurrency <= 0 || concurrency > len(ordered) {
+		concurrency = len(ordered)
+	}
+	slots := make(chan struct{}, concurrency)
+
+	results := make([]Result, len(ordered))
+	indexes := map[string]int{}
+	finished := map[string]chan struct{}{}
+	for i, c := range ordered {
+		indexes[c.Name] = i
+		finished[c.Name] = make(chan struct{})
+	}
+	var doneMutex sync.Mutex
+
+	tasks := util.NewConcurrentTasks(len(ordered))
+	for i, c := range ordered {
+		index := i     // Needed to prevent the lambda from capturing the value
+		component := c // Needed to prevent the lambda from capturing the value
+		tasks.Add(util.ConcurrentTask{ToRun: func(errChannel chan error) {
+			// the dependent tasks read the result once the channel is closed
+			defer close(finished[component.Name])
+
+			result := Result{Name: component.Name, Context: component.Context}
+			defer func() {
+				results[index] = result
+				if options.OnDone != nil {
+					doneMutex.Lock()
+					options.OnDone(result)
+					doneMutex.Unlock()
+				}
+			}()
+
+			if !options.IgnoreDependencies {
+				for _, dependency := range component.DependsOn {
+					select {
+					case <-finished[dependency]:
+					case <-ctx.Done():
+					}
+					if ctx.Err() != nil {
+						result.Status = StatusCancelled
+						return
+					}
+					if results[indexes[dependency]].Status != StatusSucceeded {
+						result.Status = StatusSkipped
+						result.Error = fmt.Sprintf("the component %s it depends on didn't succeed", dependency)
+						return
+					}
+				}
+			}
+
+			select {
+			case slots <- struct{}{}:
+				defer func() { <-slots }()
+			case <-ctx.Done():
+				result.Status = StatusCancelled
+				return
+			}
+			if ctx.Err() != nil {
+				result.Status = StatusCancelled
+				return
+			}
+
+			start := time.Now()
+			output, err := task(ctx, component, w.GetContext(component))
+			result.Duration = metav1.Duration{Duration: time.Since(start).Round(time.Millisecond)}
+			result.Output = output
+			switch {
+			case err != nil && ctx.Err() != nil:
+				result.Status = StatusCancelled
+				result.Error = err.Error()
+			case err != nil:
+				result.Status = StatusFailed
+				result.Error = err.Error()
+				if options.FailFast {
+					cancel()
+				}
+			default:
+				result.Status = StatusSucceeded
+			}
+		}})
+	}
+	// the tasks never report errors, they are reported in the results
+	_ = tasks.Run()
+
+	return results
+}
+
+// Succeeded returns true if all the tasks succeeded
+func Succeeded(results []Result) bool {
+	for _, result := range results {
+		if result.Status != StatusSucceeded {
+			return false
+		}
+	}
+	return true
+}
+
+// ResultList is a list of results of a task run on the components of the workspace
+type ResultList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []Result `json:"items"`
+}
+
+// GetMachineReadableFormatForList returns the results in a machine readable format
+func GetMachineReadableFormatForList(results []Result) ResultList {
+	if results == nil {
+		results = []Result{}
+	}
+	return ResultList{
+		TypeMeta: metav1.TypeMeta{
+			Kind:       "List",
+			APIVersion: workspaceAPIVersion,
+		},
+		Items: results,
+	}
+}
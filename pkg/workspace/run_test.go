@@ -0,0 +1,141 @@
+package workspace
+
+import (
+	"context"
+	"errors"
+	"sync"
+	"testing"
+	"time"
+)
+
+func TestRun(t *testing.T) {
+	w := Workspace{Components: []Component{
+		{Name: "frontend", Context: "./frontend", DependsOn: []string{"backend"}},
+		{Name: "backend", Context: "./backend", DependsOn: []string{"db"}},
+		{Name: "db", Context: "./db"},
+		{Name: "docs", Context: "./docs"},
+	}}
+
+	tests := []struct {
+		name         string
+		failing      string
+		failFast     bool
+		wantStatuses map[string]Status
+	}{
+		{
+			name: "Case 1: all the tasks succeed",
+			wantStatuses: map[string]Status{
+				"frontend": StatusSucceeded,
+				"backend":  StatusSucceeded,
+				"db":       StatusSucceeded,
+				"docs":     StatusSucceeded,
+			},
+		},
+		{
+			name:    "Case 2: the dependents of a failed task are skipped",
+			failing: "db",
+			wantStatuses: map[string]Status{
+				"frontend": StatusSkipped,
+				"backend":  StatusSkipped,
+				"db":       StatusFailed,
+				"docs":     StatusSucceeded,
+			},
+		},
+		{
+			name:     "Case 3: all the tasks are cancelled when a task fails with fail fast",
+			failing:  "db",
+			failFast: true,
+			wantStatuses: map[string]Status{
+				"frontend": StatusCancelled,
+				"backend":  StatusCancelled,
+				"db":       StatusFailed,
+				"docs":     StatusCancelled,
+			},
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var mutex sync.Mutex
+			var started []string
+			task := func(ctx context.Context, c Component, contextDir string) (string, error) {
+				mutex.Lock()
+				started = append(started, c.Name)
+				mutex.Unlock()
+				if c.Name == tt.failing {
+					return "push failed", errors.New("exit status 1")
+				}
+				if c.Name == "docs" {
+					// docs is pushed at the same time as db, it is cancelled if db fails with fail fast
+					select {
+					case <-ctx.Done():
+						return "", ctx.Err()
+					case <-time.After(100 * time.Millisecond):
+					}
+				}
+				return "pushed " + c.Name, nil
+			}
+
+			var done []string
+			results := w.Run(context.Background(), task, RunOptions{
+				Concurrency: 2,
+				FailFast:    tt.failFast,
+				OnDone: func(result Result) {
+					done = append(done, result.Name)
+				},
+			})
+
+			if len(results) != len(w.Components) || len(done) != len(w.Components) {
+				t.Fatalf("Run() returned %d results and called OnDone %d times, want %d", len(results), len(done), len(w.Components))
+			}
+			for _, result := range results {
+				if result.Status != tt.wantStatuses[result.Name] {
+					t.Errorf("Run() status of %s = %s, want %s (%s)", result.Name, result.Status, tt.wantStatuses[result.Name], result.Error)
+				}
+			}
+			if Succeeded(results) != (tt.failing == "") {
+				t.Errorf("Succeeded() = %v, want %v", Succeeded(results), tt.failing == "")
+			}
+
+			// the tasks of the dependencies start before the tasks of their dependents
+			positions := map[string]int{}
+			for i, name := range started {
+				positions[name] = i + 1
+			}
+			for _, c := range w.Components {
+				for _, dependency := range c.DependsOn {
+					if positions[c.Name] != 0 && positions[c.Name] < positions[dependency] {
+						t.Errorf("Run() started %s before its dependency %s", c.Name, dependency)
+					}
+				}
+			}
+		})
+	}
+}
+
+func TestRunConcurrency(t *testing.T) {
+	w := Workspace{Components: []Component{{Name: "a"}, {Name: "b"}, {Name: "c"}, {Name: "d"}, {Name: "e"}}}
+
+	var mutex sync.Mutex
+	running, maxRunning := 0, 0
+	task := func(ctx context.Context, c Component, contextDir string) (string, error) {
+		mutex.Lock()
+		running++
+		if running > maxRunning {
+			maxRunning = running
+		}
+		mutex.Unlock()
+		time.Sleep(20 * time.Millisecond)
+		mutex.Lock()
+		running--
+		mutex.Unlock()
+		return "", nil
+	}
+
+	results := w.Run(context.Background(), task, RunOptions{Concurrency: 2})
+	if !Succeeded(results) {
+		t.Errorf("Run() results = %v, want all the tasks to succeed", results)
+	}
+	if maxRunning > 2 {
+		t.Errorf("Run() ran %d tasks at the same time, want at most 2", maxRunning)
+	}
+}
@@ -0,0 +1,191 @@
+package workspace
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/ghodss/yaml"
+	"github.com/openshift/odo/pkg/envinfo"
+	"github.com/pkg/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/klog"
+)
+
+const (
+	// FileName is the default name of the workspace file
+	FileName = "odo-workspace.yaml"
+
+	workspaceKind       = "Workspace"
+	workspaceAPIVersion = "odo.dev/v1alpha1"
+)
+
+// Workspace lists the contexts of the components of an application, and the dependencies between them
+type Workspace struct {
+	metav1.TypeMeta `json:",inline"`
+	Components      []Component `json:"components"`
+
+	// dir is the directory of the workspace file, the contexts are relative to it
+	dir string
+}
+
+// Component is a component of the workspace
+type Component struct {
+	// Name identifies the component in the dependencies, defaults to the name of the component of the context
+	Name string `json:"name,omitempty"`
+	// Context is the path of the context of the component, relative to the workspace file
+	Context string `json:"context"`
+	// Env is the environment of the component to use, defaults to the default environment
+	Env string `json:"env,omitempty"`
+	// DependsOn are the names of the components to push before this component
+	DependsOn []string `json:"dependsOn,omitempty"`
+}
+
+// Read reads and validates the workspace file at the given path.
+// The path can be a workspace file or a directory containing a workspace file named FileName
+func Read(path string) (*Workspace, error) {
+	if info, err := os.Stat(path); err == nil && info.IsDir() {
+		path = filepath.Join(path, FileName)
+	}
+	data, err := ioutil.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil, fmt.Errorf("the workspace file %s doesn't exist, please create it with the contexts of the components of the application", path)
+		}
+		return nil, errors.Wrapf(err, "unable to read the workspace file %s", path)
+	}
+
+	var w Workspace
+	if err := yaml.Unmarshal(data, &w); err != nil {
+		return nil, errors.Wrapf(err, "unable to parse the workspace file %s", path)
+	}
+	if w.Kind != "" && w.Kind != workspaceKind {
+		return nil, fmt.Errorf("the file %s is not a workspace file, its kind is %s", path, w.Kind)
+	}
+	absPath, err := filepath.Abs(path)
+	if err != nil {
+		return nil, err
+	}
+	w.dir = filepath.Dir(absPath)
+
+	for i := range w.Components {
+		if w.Components[i].Context == "" {
+			return nil, fmt.Errorf("the component %d of the workspace file %s has no context", i+1, path)
+		}
+		if w.Components[i].Name == "" {
+			w.Components[i].Name = w.componentName(w.Components[i])
+		}
+	}
+	if err := w.Validate(); err != nil {
+		return nil, errors.Wrapf(err, "invalid workspace file %s", path)
+	}
+	return &w, nil
+}
+
+// GetContext returns the absolute path of the context of the component
+func (w Workspace) GetContext(c Component) string {
+	if filepath.IsAbs(c.Context) {
+		return filepath.Clean(c.Context)
+	}
+	return filepath.Join(w.dir, c.Context)
+}
+
+// componentName returns the name of the component of the context, or the name of the context directory
+// when the context has no env file
+func (w Workspace) componentName(c Component) string {
+	envInfo, err := envinfo.NewEnvSpecificInfoForEnvironment(w.GetContext(c), c.Env)
+	if err == nil && envInfo.Exists() && envInfo.GetName() != "" {
+		return envInfo.GetName()
+	}
+	klog.V(4).Infof("unable to get the component name of the context %s, using the directory name", c.Context)
+	return filepath.Base(w.GetContext(c))
+}
+
+// Validate checks that the names of the components are unique, that their dependencies exist
+// and that there are no circular dependencies
+func (w Workspace) Validate() error {
+	if len(w.Components) == 0 {
+		return errors.New("the workspace has no components")
+	}
+	names := map[string]bool{}
+	for _, c := range w.Components {
+		if names[c.Name] {
+			return fmt.Errorf("the component %s is defined more than once", c.Name)
+		}
+		names[c.Name] = true
+	}
+	for _, c := range w.Components {
+		for _, dependency := range c.DependsOn {
+			if !names[dependency] {
+				return fmt.Errorf("the component %s depends on the component %s which doesn't exist", c.Name, dependency)
+			}
+			if dependency == c.Name {
+				return fmt.Errorf("the component %s depends on itself", c.Name)
+			}
+		}
+	}
+	_, err := w.Order()
+	return err
+}
+
+// Order returns the components sorted so that each component comes after its dependencies,
+// keeping the order of the workspace file otherwise
+func (w Workspace) Order() ([]Component, error) {
+	const (
+		unvisited = iota
+		visiting
+		visited
+	)
+	byName := map[string]Component{}
+	for _, c := range w.Components {
+		byName[c.Name] = c
+	}
+
+	state := map[string]int{}
+	var ordered []Component
+	var visit func(c Component, path []string) error
+	visit = func(c Component, path []string) error {
+		switch state[c.Name] {
+		case visited:
+			return nil
+		case visiting:
+			return fmt.Errorf("circular dependency between the components %s", strings.Join(append(path, c.Name), " -> "))
+		}
+		state[c.Name] = visiting
+		for _, dependency := range c.DependsOn {
+			if err := visit(byName[dependency], append(path, c.Name)); err != nil {
+				return err
+			}
+		}
+		state[c.Name] = visited
+		ordered = append(ordered, c)
+		return nil
+	}
+
+	for _, c := range w.Components {
+		if err := visit(c, nil); err != nil {
+			return nil, err
+		}
+	}
+	return ordered, nil
+}
+
+// Reversed returns the workspace with the dependencies reversed, for the operations like delete
+// which must handle a component before the components it depends on
+func (w Workspace) Reversed() Workspace {
+	reversed := w
+	reversed.Components = make([]Component, len(w.Components))
+	dependents := map[string][]string{}
+	for _, c := range w.Components {
+		for _, dependency := range c.DependsOn {
+			dependents[dependency] = append(dependents[dependency], c.Name)
+		}
+	}
+	for i, c := range w.Components {
+		reversed.Components[i] = c
+		reversed.Components[i].DependsOn = dependents[c.Name]
+	}
+	return reversed
+}
@@ -0,0 +1,151 @@
+package workspace
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+	"testing"
+)
+
+func TestRead(t *testing.T) {
+	tests := []struct {
+		name       string
+		content    string
+		wantNames  []string
+		wantErrMsg string
+	}{
+		{
+			name: "Case 1: valid workspace",
+			content: `kind: Workspace
+apiVersion: odo.dev/v1alpha1
+components:
+- context: ./backend
+  dependsOn: [db]
+- name: db
+  context: database
+`,
+			wantNames: []string{"backend", "db"},
+		},
+		{
+			name: "Case 2: unknown dependency",
+			content: `components:
+- context: ./backend
+  dependsOn: [db]
+`,
+			wantErrMsg: "the component backend depends on the component db which doesn't exist",
+		},
+		{
+			name: "Case 3: circular dependency",
+			content: `components:
+- context: ./backend
+  dependsOn: [frontend]
+- context: ./frontend
+  dependsOn: [backend]
+`,
+			wantErrMsg: "circular dependency between the components backend -> frontend -> backend",
+		},
+		{
+			name: "Case 4: duplicated component",
+			content: `components:
+- context: ./backend
+- context: ./other/backend
+`,
+			wantErrMsg: "the component backend is defined more than once",
+		},
+		{
+			name: "Case 5: component without context",
+			content: `components:
+- name: backend
+`,
+			wantErrMsg: "has no context",
+		},
+		{
+			name:       "Case 6: not a workspace",
+			content:    "kind: Devfile\n",
+			wantErrMsg: "is not a workspace file",
+		},
+		{
+			name:       "Case 7: no components",
+			content:    "kind: Workspace\n",
+			wantErrMsg: "the workspace has no components",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir, err := ioutil.TempDir("", "odo-workspace")
+			if err != nil {
+				t.Fatal(err)
+			}
+			defer os.RemoveAll(dir)
+			if err := ioutil.WriteFile(filepath.Join(dir, FileName), []byte(tt.content), 0600); err != nil {
+				t.Fatal(err)
+			}
+
+			w, err := Read(dir)
+			if tt.wantErrMsg != "" {
+				if err == nil || !strings.Contains(err.Error(), tt.wantErrMsg) {
+					t.Errorf("Read() error = %v, want an error containing %q", err, tt.wantErrMsg)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Read() unexpected error %v", err)
+			}
+			var names []string
+			for _, c := range w.Components {
+				names = append(names, c.Name)
+			}
+			if !reflect.DeepEqual(names, tt.wantNames) {
+				t.Errorf("Read() names = %v, want %v", names, tt.wantNames)
+			}
+			if got := w.GetContext(w.Components[0]); got != filepath.Join(dir, "backend") {
+				t.Errorf("GetContext() = %s, want %s", got, filepath.Join(dir, "backend"))
+			}
+		})
+	}
+}
+
+func TestReadMissingFile(t *testing.T) {
+	_, err := Read(filepath.Join(os.TempDir(), "odo-missing-workspace.yaml"))
+	if err == nil || !strings.Contains(err.Error(), "doesn't exist") {
+		t.Errorf("Read() error = %v, want an error about the missing file", err)
+	}
+}
+
+func TestOrder(t *testing.T) {
+	w := Workspace{Components: []Component{
+		{Name: "frontend", DependsOn: []string{"backend"}},
+		{Name: "backend", DependsOn: []string{"db", "cache"}},
+		{Name: "db"},
+		{Name: "cache"},
+		{Name: "docs"},
+	}}
+
+	ordered, err := w.Order()
+	if err != nil {
+		t.Fatalf("Order() unexpected error %v", err)
+	}
+	var names []string
+	for _, c := range ordered {
+		names = append(names, c.Name)
+	}
+	want := []string{"db", "cache", "backend", "frontend", "docs"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Order() = %v, want %v", names, want)
+	}
+
+	reversed, err := w.Reversed().Order()
+	if err != nil {
+		t.Fatalf("Order() of the reversed workspace unexpected error %v", err)
+	}
+	names = nil
+	for _, c := range reversed {
+		names = append(names, c.Name)
+	}
+	want = []string{"frontend", "backend", "db", "cache", "docs"}
+	if !reflect.DeepEqual(names, want) {
+		t.Errorf("Order() of the reversed workspace = %v, want %v", names, want)
+	}
+}